@@ -0,0 +1,48 @@
+// Package announcement package provides the interface for the announcement
+// service.
+// It provides the methods to interact with the sendbird API.
+// See https://sendbird.com/docs/chat/platform-api/v3/message/announcements/announcements-overview.
+package announcement
+
+import (
+	"context"
+
+	"github.com/yumi-ia/sendbird-go/pkg/client"
+)
+
+type Announcement interface {
+	// CreateAnnouncement creates an announcement, which sends a message to the
+	// targeted users or channels at once or at the scheduled time.
+	// See https://sendbird.com/docs/chat/platform-api/v3/message/announcements/create-an-announcement
+	CreateAnnouncement(ctx context.Context, createAnnouncementRequest CreateAnnouncementRequest) (*CreateAnnouncementResponse, error)
+	// ListAnnouncements lists the announcements of the application.
+	// See https://sendbird.com/docs/chat/platform-api/v3/message/announcements/list-announcements
+	ListAnnouncements(ctx context.Context, listAnnouncementsRequest ListAnnouncementsRequest) (*ListAnnouncementsResponse, error)
+	// GetAnnouncement retrieves an announcement.
+	// See https://sendbird.com/docs/chat/platform-api/v3/message/announcements/get-an-announcement
+	GetAnnouncement(ctx context.Context, uniqueID string) (*GetAnnouncementResponse, error)
+	// UpdateAnnouncement updates an announcement which hasn't started yet.
+	// See https://sendbird.com/docs/chat/platform-api/v3/message/announcements/update-an-announcement
+	UpdateAnnouncement(ctx context.Context, uniqueID string, updateAnnouncementRequest UpdateAnnouncementRequest) (*UpdateAnnouncementResponse, error)
+	// PauseAnnouncement pauses a running announcement.
+	// See https://sendbird.com/docs/chat/platform-api/v3/message/announcements/update-an-announcement
+	PauseAnnouncement(ctx context.Context, uniqueID string) (*UpdateAnnouncementResponse, error)
+	// ResumeAnnouncement resumes a paused announcement.
+	// See https://sendbird.com/docs/chat/platform-api/v3/message/announcements/update-an-announcement
+	ResumeAnnouncement(ctx context.Context, uniqueID string) (*UpdateAnnouncementResponse, error)
+	// CancelAnnouncement cancels an announcement which hasn't finished yet.
+	// See https://sendbird.com/docs/chat/platform-api/v3/message/announcements/update-an-announcement
+	CancelAnnouncement(ctx context.Context, uniqueID string) (*UpdateAnnouncementResponse, error)
+	// GetAnnouncementStatistics retrieves the delivery and open statistics of
+	// an announcement.
+	// See https://sendbird.com/docs/chat/platform-api/v3/message/announcements/get-announcement-open-rate
+	GetAnnouncementStatistics(ctx context.Context, uniqueID string) (*GetAnnouncementStatisticsResponse, error)
+}
+
+type announcement struct {
+	client client.Client
+}
+
+func NewAnnouncement(c client.Client) Announcement {
+	return &announcement{client: c}
+}
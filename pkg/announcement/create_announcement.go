@@ -0,0 +1,122 @@
+package announcement
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/yumi-ia/sendbird-go/pkg/message"
+)
+
+// timeOfDayRegexp matches a time of the day in the HHMM format.
+var timeOfDayRegexp = regexp.MustCompile(`^([01][0-9]|2[0-3])[0-5][0-9]$`)
+
+// CreateAnnouncementRequest is the request to create an announcement.
+type CreateAnnouncementRequest struct {
+	// Message specifies the message to send - required.
+	Message Message `json:"message"`
+	// TargetAt specifies the target of the announcement - required.
+	TargetAt TargetAt `json:"target_at"`
+
+	// TargetList specifies the URLs of the target channels when TargetAt is
+	// TargetAtTargetChannels, or the IDs of the target users when TargetAt is
+	// TargetAtTargetUsersIncludedChannels or TargetAtTargetUsersOnlyChannels.
+	// Optional.
+	TargetList []string `json:"target_list,omitempty"`
+	// TargetChannelType specifies the type of the target channels.
+	// Optional.
+	TargetChannelType message.ChannelType `json:"target_channel_type,omitempty"`
+	// TargetCustomType specifies the custom type of the target channels when
+	// TargetAt is TargetAtTargetChannels and TargetList is empty.
+	// Optional.
+	TargetCustomType string `json:"target_custom_type,omitempty"`
+	// UniqueID specifies the unique ID of the announcement. If not specified,
+	// a unique ID is generated.
+	// Optional.
+	UniqueID string `json:"unique_id,omitempty"`
+	// AnnouncementGroup specifies the group of the announcement, used to
+	// filter announcements.
+	// Optional.
+	AnnouncementGroup string `json:"announcement_group,omitempty"`
+	// EnablePush determines whether to send push notifications for the
+	// announcement.
+	// Optional. (Default: true)
+	EnablePush *bool `json:"enable_push,omitempty"`
+	// ScheduledAt specifies the time to start the announcement, in Unix
+	// milliseconds. If not specified, the announcement starts immediately.
+	// Optional.
+	ScheduledAt *int64 `json:"scheduled_at,omitempty"`
+	// CeaseAt specifies the time of the day, in the HHMM format in UTC, from
+	// which the announcement is paused every day. Must be specified with
+	// ResumeAt.
+	// Optional.
+	CeaseAt string `json:"cease_at,omitempty"`
+	// ResumeAt specifies the time of the day, in the HHMM format in UTC, from
+	// which the announcement is resumed every day. Must be specified with
+	// CeaseAt.
+	// Optional.
+	ResumeAt string `json:"resume_at,omitempty"`
+	// EndAt specifies the time to stop the announcement, in Unix milliseconds,
+	// even if it hasn't been sent to every target.
+	// Optional.
+	EndAt *int64 `json:"end_at,omitempty"`
+}
+
+func validateWindow(ceaseAt, resumeAt string) error {
+	switch {
+	case ceaseAt == "" && resumeAt == "":
+		return nil
+	case ceaseAt == "" || resumeAt == "":
+		return errors.New("cease_at and resume_at must be specified together")
+	case !timeOfDayRegexp.MatchString(ceaseAt):
+		return fmt.Errorf("invalid cease_at %q, expected HHMM", ceaseAt)
+	case !timeOfDayRegexp.MatchString(resumeAt):
+		return fmt.Errorf("invalid resume_at %q, expected HHMM", resumeAt)
+	}
+
+	return nil
+}
+
+func (car *CreateAnnouncementRequest) Validate() error {
+	switch {
+	case car.Message.Type == "":
+		return errors.New("message type is required")
+	case car.Message.Content == "":
+		return errors.New("message content is required")
+	case car.Message.Type == message.MessageTypeText && car.Message.UserID == "":
+		return errors.New("message user ID is required for text messages")
+	case car.TargetAt == "":
+		return errors.New("target_at is required")
+	case car.TargetAt == TargetAtTargetChannels && len(car.TargetList) == 0 && car.TargetCustomType == "":
+		return errors.New("target list or target custom type is required for target channels")
+	case (car.TargetAt == TargetAtTargetUsersIncludedChannels || car.TargetAt == TargetAtTargetUsersOnlyChannels) && len(car.TargetList) == 0:
+		return errors.New("target list is required for target users")
+	}
+
+	return validateWindow(car.CeaseAt, car.ResumeAt)
+}
+
+// CreateAnnouncementResponse is the response to create an announcement.
+type CreateAnnouncementResponse AnnouncementResource
+
+// CreateAnnouncement creates an announcement, which sends a message to the
+// targeted users or channels at once or at the scheduled time.
+// See https://sendbird.com/docs/chat/platform-api/v3/message/announcements/create-an-announcement
+func (a *announcement) CreateAnnouncement(ctx context.Context, createAnnouncementRequest CreateAnnouncementRequest) (*CreateAnnouncementResponse, error) {
+	if err := createAnnouncementRequest.Validate(); err != nil {
+		return nil, fmt.Errorf("failed to validate create announcement request: %w", err)
+	}
+
+	car, err := a.client.Post(ctx, "/announcements", createAnnouncementRequest, &CreateAnnouncementResponse{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create announcement: %w", err)
+	}
+
+	createAnnouncementResponse, ok := car.(*CreateAnnouncementResponse)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast body to CreateAnnouncementResponse: %+v", car)
+	}
+
+	return createAnnouncementResponse, nil
+}
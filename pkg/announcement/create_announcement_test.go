@@ -0,0 +1,128 @@
+package announcement
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yumi-ia/sendbird-go/pkg/client"
+	"github.com/yumi-ia/sendbird-go/pkg/message"
+)
+
+func TestCreateAnnouncement(t *testing.T) {
+	t.Parallel()
+
+	scheduledAt := int64(1700000000000)
+	createAnnouncementRequest := CreateAnnouncementRequest{
+		Message: Message{
+			Type:    message.MessageTypeText,
+			UserID:  "user-id",
+			Content: "Maintenance tonight",
+		},
+		TargetAt:         TargetAtTargetChannels,
+		TargetCustomType: "support",
+		ScheduledAt:      &scheduledAt,
+		CeaseAt:          "2200",
+		ResumeAt:         "0800",
+	}
+
+	createAnnouncementResponse := &CreateAnnouncementResponse{
+		UniqueID:         "unique-id",
+		Message:          createAnnouncementRequest.Message,
+		TargetAt:         TargetAtTargetChannels,
+		TargetCustomType: "support",
+		Status:           StatusScheduled,
+		ScheduledAt:      scheduledAt,
+		CeaseAt:          "2200",
+		ResumeAt:         "0800",
+	}
+
+	client := client.NewClientMock(t).
+		OnPost("/announcements", createAnnouncementRequest, &CreateAnnouncementResponse{}).TypedReturns(createAnnouncementResponse, nil).Once().
+		Parent
+	announcement := NewAnnouncement(client)
+
+	car, err := announcement.CreateAnnouncement(context.Background(), createAnnouncementRequest)
+	require.NoError(t, err)
+	assert.Equal(t, createAnnouncementResponse, car)
+}
+
+func TestCreateAnnouncementRequest_Validate(t *testing.T) {
+	t.Parallel()
+
+	msg := Message{Type: message.MessageTypeAdminMessage, Content: "content"}
+
+	testCases := []struct {
+		name    string
+		request CreateAnnouncementRequest
+		wantErr bool
+	}{
+		{
+			name:    "everyone",
+			request: CreateAnnouncementRequest{Message: msg, TargetAt: TargetAtEveryone},
+		},
+		{
+			name:    "sender all channels",
+			request: CreateAnnouncementRequest{Message: msg, TargetAt: TargetAtSenderAllChannels},
+		},
+		{
+			name:    "target users",
+			request: CreateAnnouncementRequest{Message: msg, TargetAt: TargetAtTargetUsersOnlyChannels, TargetList: []string{"user-id"}},
+		},
+		{
+			name:    "missing message type",
+			request: CreateAnnouncementRequest{Message: Message{Content: "content"}, TargetAt: TargetAtEveryone},
+			wantErr: true,
+		},
+		{
+			name:    "missing content",
+			request: CreateAnnouncementRequest{Message: Message{Type: message.MessageTypeAdminMessage}, TargetAt: TargetAtSenderAllChannels},
+			wantErr: true,
+		},
+		{
+			name:    "missing sender of text message",
+			request: CreateAnnouncementRequest{Message: Message{Type: message.MessageTypeText, Content: "content"}, TargetAt: TargetAtSenderAllChannels},
+			wantErr: true,
+		},
+		{
+			name:    "missing target at",
+			request: CreateAnnouncementRequest{Message: msg},
+			wantErr: true,
+		},
+		{
+			name:    "missing target channels",
+			request: CreateAnnouncementRequest{Message: msg, TargetAt: TargetAtTargetChannels},
+			wantErr: true,
+		},
+		{
+			name:    "missing target users",
+			request: CreateAnnouncementRequest{Message: msg, TargetAt: TargetAtTargetUsersIncludedChannels},
+			wantErr: true,
+		},
+		{
+			name:    "cease at without resume at",
+			request: CreateAnnouncementRequest{Message: msg, TargetAt: TargetAtSenderAllChannels, CeaseAt: "2200"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid resume at",
+			request: CreateAnnouncementRequest{Message: msg, TargetAt: TargetAtSenderAllChannels, CeaseAt: "2200", ResumeAt: "2460"},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tc.request.Validate()
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package announcement_test
+
+import (
+	"github.com/yumi-ia/sendbird-go/pkg/announcement"
+	"github.com/yumi-ia/sendbird-go/pkg/client"
+)
+
+func ExampleNewAnnouncement() {
+	// Initialize a client.
+	opts := []client.Option{}
+	c := client.NewClient(opts...)
+
+	// Initialize an announcement service.
+	a := announcement.NewAnnouncement(c)
+
+	// the announcement client is ready to be used.
+	_ = a
+	// a.DoWork()
+}
@@ -0,0 +1,105 @@
+package announcement
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// ListAnnouncementsRequest is the request to list announcements.
+type ListAnnouncementsRequest struct {
+	// Token specifies a page token that indicates the starting index of a chunk
+	// of results. If not specified, the index is set as 0.
+	// Optional.
+	Token string
+	// Limit specifies the number of results to return per page. Acceptable
+	// values are 1 to 100, inclusive. (Default: 20)
+	// Optional.
+	Limit *int
+	// Status restricts the results to the announcements with the specified
+	// status.
+	// Optional.
+	Status Status
+	// AnnouncementGroup restricts the results to the announcements of the
+	// specified group.
+	// Optional.
+	AnnouncementGroup string
+}
+
+// ListAnnouncementsResponse is the response to list announcements.
+type ListAnnouncementsResponse struct {
+	// Announcements is the list of announcements.
+	Announcements []AnnouncementResource `json:"announcements"`
+	// Next is the value for the token parameter to retrieve the next page in the
+	// result.
+	Next string `json:"next"`
+}
+
+func listAnnouncementsRequestToMap(lar ListAnnouncementsRequest) map[string]string {
+	m := make(map[string]string)
+
+	if lar.Token != "" {
+		m["token"] = lar.Token
+	}
+
+	if lar.Limit != nil {
+		m["limit"] = strconv.Itoa(*lar.Limit)
+	}
+
+	if lar.Status != "" {
+		m["status"] = string(lar.Status)
+	}
+
+	if lar.AnnouncementGroup != "" {
+		m["announcement_group"] = lar.AnnouncementGroup
+	}
+
+	return m
+}
+
+// ListAnnouncements lists the announcements of the application.
+// See https://sendbird.com/docs/chat/platform-api/v3/message/announcements/list-announcements
+func (a *announcement) ListAnnouncements(ctx context.Context, listAnnouncementsRequest ListAnnouncementsRequest) (*ListAnnouncementsResponse, error) {
+	u := &url.URL{
+		Path: "/announcements",
+	}
+
+	query := u.Query()
+	for k, v := range listAnnouncementsRequestToMap(listAnnouncementsRequest) {
+		query.Set(k, v)
+	}
+
+	u.RawQuery = query.Encode()
+
+	lar, err := a.client.Get(ctx, u.String(), nil, &ListAnnouncementsResponse{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list announcements: %w", err)
+	}
+
+	listAnnouncementsResponse, ok := lar.(*ListAnnouncementsResponse)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast body to ListAnnouncementsResponse: %+v", lar)
+	}
+
+	return listAnnouncementsResponse, nil
+}
+
+// GetAnnouncementResponse is the response to get an announcement.
+type GetAnnouncementResponse AnnouncementResource
+
+// GetAnnouncement retrieves an announcement.
+// See https://sendbird.com/docs/chat/platform-api/v3/message/announcements/get-an-announcement
+func (a *announcement) GetAnnouncement(ctx context.Context, uniqueID string) (*GetAnnouncementResponse, error) {
+	gar, err := a.client.Get(ctx, "/announcements/"+uniqueID, nil, &GetAnnouncementResponse{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get announcement: %w", err)
+	}
+
+	getAnnouncementResponse, ok := gar.(*GetAnnouncementResponse)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast body to GetAnnouncementResponse: %+v", gar)
+	}
+
+	return getAnnouncementResponse, nil
+}
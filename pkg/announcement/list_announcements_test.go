@@ -0,0 +1,51 @@
+package announcement
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yumi-ia/sendbird-go/pkg/client"
+)
+
+func TestListAnnouncements(t *testing.T) {
+	t.Parallel()
+
+	limit := 10
+	listAnnouncementsRequest := ListAnnouncementsRequest{
+		Token:             "token",
+		Limit:             &limit,
+		Status:            StatusRunning,
+		AnnouncementGroup: "maintenance",
+	}
+
+	listAnnouncementsResponse := &ListAnnouncementsResponse{
+		Announcements: []AnnouncementResource{{UniqueID: "unique-id", Status: StatusRunning}},
+		Next:          "next",
+	}
+
+	client := client.NewClientMock(t).
+		OnGet("/announcements?announcement_group=maintenance&limit=10&status=running&token=token", nil, &ListAnnouncementsResponse{}).TypedReturns(listAnnouncementsResponse, nil).Once().
+		Parent
+	announcement := NewAnnouncement(client)
+
+	lar, err := announcement.ListAnnouncements(context.Background(), listAnnouncementsRequest)
+	require.NoError(t, err)
+	assert.Equal(t, listAnnouncementsResponse, lar)
+}
+
+func TestGetAnnouncement(t *testing.T) {
+	t.Parallel()
+
+	getAnnouncementResponse := &GetAnnouncementResponse{UniqueID: "unique-id", Status: StatusDone}
+
+	client := client.NewClientMock(t).
+		OnGet("/announcements/unique-id", nil, &GetAnnouncementResponse{}).TypedReturns(getAnnouncementResponse, nil).Once().
+		Parent
+	announcement := NewAnnouncement(client)
+
+	gar, err := announcement.GetAnnouncement(context.Background(), "unique-id")
+	require.NoError(t, err)
+	assert.Equal(t, getAnnouncementResponse, gar)
+}
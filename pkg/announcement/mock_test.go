@@ -0,0 +1,4 @@
+package announcement
+
+// https://github.com/traefik/mocktail
+// mocktail:Announcement
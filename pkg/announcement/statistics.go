@@ -0,0 +1,38 @@
+package announcement
+
+import (
+	"context"
+	"fmt"
+)
+
+// GetAnnouncementStatisticsResponse is the response to get the statistics of
+// an announcement.
+type GetAnnouncementStatisticsResponse struct {
+	// UniqueID is the unique ID of the announcement.
+	UniqueID string `json:"unique_id"`
+	// TargetUserCount is the number of users targeted by the announcement.
+	TargetUserCount int `json:"target_user_count"`
+	// SentUserCount is the number of users the announcement was sent to.
+	SentUserCount int `json:"sent_user_count"`
+	// OpenCount is the number of users who read the announcement.
+	OpenCount int `json:"open_count"`
+	// OpenRate is the ratio of OpenCount to SentUserCount.
+	OpenRate float64 `json:"open_rate"`
+}
+
+// GetAnnouncementStatistics retrieves the delivery and open statistics of an
+// announcement.
+// See https://sendbird.com/docs/chat/platform-api/v3/message/announcements/get-announcement-open-rate
+func (a *announcement) GetAnnouncementStatistics(ctx context.Context, uniqueID string) (*GetAnnouncementStatisticsResponse, error) {
+	gasr, err := a.client.Get(ctx, "/announcement_open_rate/"+uniqueID, nil, &GetAnnouncementStatisticsResponse{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get announcement statistics: %w", err)
+	}
+
+	getAnnouncementStatisticsResponse, ok := gasr.(*GetAnnouncementStatisticsResponse)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast body to GetAnnouncementStatisticsResponse: %+v", gasr)
+	}
+
+	return getAnnouncementStatisticsResponse, nil
+}
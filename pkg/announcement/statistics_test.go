@@ -0,0 +1,31 @@
+package announcement
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yumi-ia/sendbird-go/pkg/client"
+)
+
+func TestGetAnnouncementStatistics(t *testing.T) {
+	t.Parallel()
+
+	getAnnouncementStatisticsResponse := &GetAnnouncementStatisticsResponse{
+		UniqueID:        "unique-id",
+		TargetUserCount: 100,
+		SentUserCount:   80,
+		OpenCount:       20,
+		OpenRate:        0.25,
+	}
+
+	client := client.NewClientMock(t).
+		OnGet("/announcement_open_rate/unique-id", nil, &GetAnnouncementStatisticsResponse{}).TypedReturns(getAnnouncementStatisticsResponse, nil).Once().
+		Parent
+	announcement := NewAnnouncement(client)
+
+	gasr, err := announcement.GetAnnouncementStatistics(context.Background(), "unique-id")
+	require.NoError(t, err)
+	assert.Equal(t, getAnnouncementStatisticsResponse, gasr)
+}
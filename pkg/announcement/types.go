@@ -0,0 +1,78 @@
+package announcement
+
+import (
+	"github.com/yumi-ia/sendbird-go/pkg/message"
+)
+
+// TargetAt is the target of an announcement.
+type TargetAt string
+
+const (
+	// TargetAtEveryone sends the announcement to all the users of the
+	// application.
+	TargetAtEveryone TargetAt = "everyone"
+	// TargetAtSenderAllChannels sends the announcement to all the group
+	// channels the sender is a member of.
+	TargetAtSenderAllChannels TargetAt = "sender_all_channels"
+	// TargetAtTargetChannels sends the announcement to the group channels of
+	// the target list, or to the group channels of the target custom type.
+	TargetAtTargetChannels TargetAt = "target_channels"
+	// TargetAtTargetUsersIncludedChannels sends the announcement to all the
+	// group channels shared by the sender and the users of the target list.
+	TargetAtTargetUsersIncludedChannels TargetAt = "target_users_included_channels"
+	// TargetAtTargetUsersOnlyChannels sends the announcement to the 1-to-1
+	// group channels between the sender and each user of the target list.
+	TargetAtTargetUsersOnlyChannels TargetAt = "target_users_only_channels"
+)
+
+// Status is the status of an announcement.
+type Status string
+
+const (
+	StatusScheduled Status = "scheduled"
+	StatusReady     Status = "ready"
+	StatusRunning   Status = "running"
+	StatusPaused    Status = "paused"
+	StatusCanceled  Status = "canceled"
+	StatusStopped   Status = "stopped"
+	StatusDone      Status = "done"
+)
+
+// Message is the message sent by an announcement.
+type Message struct {
+	// Type specifies the type of the message, required to create an
+	// announcement. Only message.MessageTypeText and
+	// message.MessageTypeAdminMessage are supported.
+	Type message.MessageType `json:"type"`
+	// UserID specifies the ID of the sender of the message. Required for text
+	// messages.
+	UserID string `json:"user_id,omitempty"`
+	// Content specifies the content of the message.
+	Content string `json:"content"`
+	// CustomType specifies a custom message type used for message grouping.
+	CustomType string `json:"custom_type,omitempty"`
+	// Data specifies additional message information.
+	Data string `json:"data,omitempty"`
+}
+
+// AnnouncementResource is the resource of an announcement.
+type AnnouncementResource struct {
+	UniqueID          string              `json:"unique_id"`
+	AnnouncementGroup string              `json:"announcement_group"`
+	Message           Message             `json:"message"`
+	EnablePush        bool                `json:"enable_push"`
+	TargetAt          TargetAt            `json:"target_at"`
+	TargetList        []string            `json:"target_list"`
+	TargetChannelType message.ChannelType `json:"target_channel_type"`
+	TargetCustomType  string              `json:"target_custom_type"`
+	Status            Status              `json:"status"`
+	ScheduledAt       int64               `json:"scheduled_at"`
+	CeaseAt           string              `json:"cease_at"`
+	ResumeAt          string              `json:"resume_at"`
+	EndAt             int64               `json:"end_at"`
+	CreatedAt         int64               `json:"created_at"`
+	CompletedAt       int64               `json:"completed_at"`
+	TargetUserCount   int                 `json:"target_user_count"`
+	SentUserCount     int                 `json:"sent_user_count"`
+	OpenCount         int                 `json:"open_count"`
+}
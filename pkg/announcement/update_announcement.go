@@ -0,0 +1,100 @@
+package announcement
+
+import (
+	"context"
+	"fmt"
+)
+
+// action is the action to change the status of an announcement.
+type action string
+
+const (
+	actionPause  action = "pause"
+	actionResume action = "resume"
+	actionCancel action = "cancel"
+)
+
+// UpdateAnnouncementRequest is the request to update an announcement. Only
+// the specified fields are updated.
+type UpdateAnnouncementRequest struct {
+	// Message specifies the new message to send.
+	// Optional.
+	Message *Message `json:"message,omitempty"`
+	// EnablePush determines whether to send push notifications for the
+	// announcement.
+	// Optional.
+	EnablePush *bool `json:"enable_push,omitempty"`
+	// ScheduledAt specifies the new time to start the announcement, in Unix
+	// milliseconds.
+	// Optional.
+	ScheduledAt *int64 `json:"scheduled_at,omitempty"`
+	// CeaseAt specifies the time of the day, in the HHMM format in UTC, from
+	// which the announcement is paused every day. Must be specified with
+	// ResumeAt.
+	// Optional.
+	CeaseAt string `json:"cease_at,omitempty"`
+	// ResumeAt specifies the time of the day, in the HHMM format in UTC, from
+	// which the announcement is resumed every day. Must be specified with
+	// CeaseAt.
+	// Optional.
+	ResumeAt string `json:"resume_at,omitempty"`
+	// EndAt specifies the new time to stop the announcement, in Unix
+	// milliseconds.
+	// Optional.
+	EndAt *int64 `json:"end_at,omitempty"`
+}
+
+func (uar *UpdateAnnouncementRequest) Validate() error {
+	return validateWindow(uar.CeaseAt, uar.ResumeAt)
+}
+
+// updateAnnouncementStatusRequest is the request to change the status of an
+// announcement.
+type updateAnnouncementStatusRequest struct {
+	Action action `json:"action"`
+}
+
+// UpdateAnnouncementResponse is the response to update an announcement.
+type UpdateAnnouncementResponse AnnouncementResource
+
+// UpdateAnnouncement updates an announcement which hasn't started yet.
+// See https://sendbird.com/docs/chat/platform-api/v3/message/announcements/update-an-announcement
+func (a *announcement) UpdateAnnouncement(ctx context.Context, uniqueID string, updateAnnouncementRequest UpdateAnnouncementRequest) (*UpdateAnnouncementResponse, error) {
+	if err := updateAnnouncementRequest.Validate(); err != nil {
+		return nil, fmt.Errorf("failed to validate update announcement request: %w", err)
+	}
+
+	return a.updateAnnouncement(ctx, uniqueID, updateAnnouncementRequest)
+}
+
+// PauseAnnouncement pauses a running announcement.
+// See https://sendbird.com/docs/chat/platform-api/v3/message/announcements/update-an-announcement
+func (a *announcement) PauseAnnouncement(ctx context.Context, uniqueID string) (*UpdateAnnouncementResponse, error) {
+	return a.updateAnnouncement(ctx, uniqueID, updateAnnouncementStatusRequest{Action: actionPause})
+}
+
+// ResumeAnnouncement resumes a paused announcement.
+// See https://sendbird.com/docs/chat/platform-api/v3/message/announcements/update-an-announcement
+func (a *announcement) ResumeAnnouncement(ctx context.Context, uniqueID string) (*UpdateAnnouncementResponse, error) {
+	return a.updateAnnouncement(ctx, uniqueID, updateAnnouncementStatusRequest{Action: actionResume})
+}
+
+// CancelAnnouncement cancels an announcement which hasn't finished yet.
+// See https://sendbird.com/docs/chat/platform-api/v3/message/announcements/update-an-announcement
+func (a *announcement) CancelAnnouncement(ctx context.Context, uniqueID string) (*UpdateAnnouncementResponse, error) {
+	return a.updateAnnouncement(ctx, uniqueID, updateAnnouncementStatusRequest{Action: actionCancel})
+}
+
+func (a *announcement) updateAnnouncement(ctx context.Context, uniqueID string, req any) (*UpdateAnnouncementResponse, error) {
+	uar, err := a.client.Put(ctx, "/announcements/"+uniqueID, req, &UpdateAnnouncementResponse{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update announcement: %w", err)
+	}
+
+	updateAnnouncementResponse, ok := uar.(*UpdateAnnouncementResponse)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast body to UpdateAnnouncementResponse: %+v", uar)
+	}
+
+	return updateAnnouncementResponse, nil
+}
@@ -0,0 +1,96 @@
+package announcement
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yumi-ia/sendbird-go/pkg/client"
+)
+
+func TestUpdateAnnouncement(t *testing.T) {
+	t.Parallel()
+
+	endAt := int64(1700000000000)
+	updateAnnouncementRequest := UpdateAnnouncementRequest{
+		Message: &Message{Content: "Maintenance postponed"},
+		EndAt:   &endAt,
+	}
+
+	updateAnnouncementResponse := &UpdateAnnouncementResponse{
+		UniqueID: "unique-id",
+		Message:  Message{Content: "Maintenance postponed"},
+		EndAt:    endAt,
+	}
+
+	client := client.NewClientMock(t).
+		OnPut("/announcements/unique-id", updateAnnouncementRequest, &UpdateAnnouncementResponse{}).TypedReturns(updateAnnouncementResponse, nil).Once().
+		Parent
+	announcement := NewAnnouncement(client)
+
+	uar, err := announcement.UpdateAnnouncement(context.Background(), "unique-id", updateAnnouncementRequest)
+	require.NoError(t, err)
+	assert.Equal(t, updateAnnouncementResponse, uar)
+}
+
+func TestUpdateAnnouncement_invalid(t *testing.T) {
+	t.Parallel()
+
+	announcement := NewAnnouncement(client.NewClientMock(t))
+
+	_, err := announcement.UpdateAnnouncement(context.Background(), "unique-id", UpdateAnnouncementRequest{ResumeAt: "0800"})
+	require.Error(t, err)
+}
+
+func TestUpdateAnnouncementStatus(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name   string
+		action action
+		status Status
+		call   func(Announcement) (*UpdateAnnouncementResponse, error)
+	}{
+		{
+			name:   "pause",
+			action: actionPause,
+			status: StatusPaused,
+			call: func(a Announcement) (*UpdateAnnouncementResponse, error) {
+				return a.PauseAnnouncement(context.Background(), "unique-id")
+			},
+		},
+		{
+			name:   "resume",
+			action: actionResume,
+			status: StatusRunning,
+			call: func(a Announcement) (*UpdateAnnouncementResponse, error) {
+				return a.ResumeAnnouncement(context.Background(), "unique-id")
+			},
+		},
+		{
+			name:   "cancel",
+			action: actionCancel,
+			status: StatusCanceled,
+			call: func(a Announcement) (*UpdateAnnouncementResponse, error) {
+				return a.CancelAnnouncement(context.Background(), "unique-id")
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			updateAnnouncementResponse := &UpdateAnnouncementResponse{UniqueID: "unique-id", Status: tc.status}
+
+			client := client.NewClientMock(t).
+				OnPut("/announcements/unique-id", updateAnnouncementStatusRequest{Action: tc.action}, &UpdateAnnouncementResponse{}).TypedReturns(updateAnnouncementResponse, nil).Once().
+				Parent
+
+			uar, err := tc.call(NewAnnouncement(client))
+			require.NoError(t, err)
+			assert.Equal(t, updateAnnouncementResponse, uar)
+		})
+	}
+}
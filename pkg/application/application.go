@@ -0,0 +1,49 @@
+// Package application package provides the interface for the application
+// settings service.
+// It provides the methods to interact with the sendbird API.
+// See https://sendbird.com/docs/chat/platform-api/v3/application/application-overview.
+package application
+
+import (
+	"context"
+
+	"github.com/yumi-ia/sendbird-go/pkg/client"
+)
+
+type Application interface {
+	// GetGlobalSettings retrieves the global settings of the application.
+	// See https://sendbird.com/docs/chat/platform-api/v3/application/global-application-settings/get-global-application-settings
+	GetGlobalSettings(ctx context.Context) (*GetGlobalSettingsResponse, error)
+	// UpdateGlobalSettings updates the global settings of the application. Only
+	// the specified settings are updated.
+	// See https://sendbird.com/docs/chat/platform-api/v3/application/global-application-settings/update-global-application-settings
+	UpdateGlobalSettings(ctx context.Context, updateGlobalSettingsRequest UpdateGlobalSettingsRequest) (*UpdateGlobalSettingsResponse, error)
+
+	// ListCustomTypeSettings lists the settings of the channels by custom type.
+	// See https://sendbird.com/docs/chat/platform-api/v3/application/settings-by-channel-custom-type/list-settings-by-channel-custom-type
+	ListCustomTypeSettings(ctx context.Context, listCustomTypeSettingsRequest ListCustomTypeSettingsRequest) (*ListCustomTypeSettingsResponse, error)
+	// CreateCustomTypeSettings creates the settings of the channels of a custom
+	// type.
+	// See https://sendbird.com/docs/chat/platform-api/v3/application/settings-by-channel-custom-type/create-settings-by-channel-custom-type
+	CreateCustomTypeSettings(ctx context.Context, createCustomTypeSettingsRequest CreateCustomTypeSettingsRequest) (*CreateCustomTypeSettingsResponse, error)
+	// GetCustomTypeSettings retrieves the settings of the channels of a custom
+	// type.
+	// See https://sendbird.com/docs/chat/platform-api/v3/application/settings-by-channel-custom-type/get-settings-by-channel-custom-type
+	GetCustomTypeSettings(ctx context.Context, customType string) (*GetCustomTypeSettingsResponse, error)
+	// UpdateCustomTypeSettings updates the settings of the channels of a custom
+	// type. Only the specified settings are updated.
+	// See https://sendbird.com/docs/chat/platform-api/v3/application/settings-by-channel-custom-type/update-settings-by-channel-custom-type
+	UpdateCustomTypeSettings(ctx context.Context, customType string, updateCustomTypeSettingsRequest UpdateCustomTypeSettingsRequest) (*UpdateCustomTypeSettingsResponse, error)
+	// DeleteCustomTypeSettings deletes the settings of the channels of a custom
+	// type. The channels of the custom type fall back to the global settings.
+	// See https://sendbird.com/docs/chat/platform-api/v3/application/settings-by-channel-custom-type/delete-settings-by-channel-custom-type
+	DeleteCustomTypeSettings(ctx context.Context, customType string) error
+}
+
+type application struct {
+	client client.Client
+}
+
+func NewApplication(c client.Client) Application {
+	return &application{client: c}
+}
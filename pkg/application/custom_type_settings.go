@@ -0,0 +1,168 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// ListCustomTypeSettingsRequest is the request to list the settings of the
+// channels by custom type.
+type ListCustomTypeSettingsRequest struct {
+	// Token specifies a page token that indicates the starting index of a chunk
+	// of results. If not specified, the index is set as 0.
+	// Optional.
+	Token string
+	// Limit specifies the number of results to return per page. Acceptable
+	// values are 1 to 100, inclusive. (Default: 10)
+	// Optional.
+	Limit *int
+}
+
+// ListCustomTypeSettingsResponse is the response to list the settings of the
+// channels by custom type.
+type ListCustomTypeSettingsResponse struct {
+	// ChannelCustomTypeSettings is the list of settings by custom type.
+	ChannelCustomTypeSettings []CustomTypeSettings `json:"channel_custom_type_settings"`
+	// Next is the value for the token parameter to retrieve the next page in the
+	// result.
+	Next string `json:"next"`
+}
+
+// CreateCustomTypeSettingsRequest is the request to create the settings of the
+// channels of a custom type.
+type CreateCustomTypeSettingsRequest CustomTypeSettings
+
+func (cctsr *CreateCustomTypeSettingsRequest) Validate() error {
+	if cctsr.CustomType == "" {
+		return errors.New("custom type is required")
+	}
+
+	return nil
+}
+
+// CreateCustomTypeSettingsResponse is the response to create the settings of
+// the channels of a custom type.
+type CreateCustomTypeSettingsResponse CustomTypeSettings
+
+// GetCustomTypeSettingsResponse is the response to get the settings of the
+// channels of a custom type.
+type GetCustomTypeSettingsResponse CustomTypeSettings
+
+// UpdateCustomTypeSettingsRequest is the request to update the settings of the
+// channels of a custom type.
+type UpdateCustomTypeSettingsRequest Settings
+
+// UpdateCustomTypeSettingsResponse is the response to update the settings of
+// the channels of a custom type.
+type UpdateCustomTypeSettingsResponse CustomTypeSettings
+
+func listCustomTypeSettingsRequestToMap(lctsr ListCustomTypeSettingsRequest) map[string]string {
+	m := make(map[string]string)
+
+	if lctsr.Token != "" {
+		m["token"] = lctsr.Token
+	}
+
+	if lctsr.Limit != nil {
+		m["limit"] = strconv.Itoa(*lctsr.Limit)
+	}
+
+	return m
+}
+
+// ListCustomTypeSettings lists the settings of the channels by custom type.
+// See https://sendbird.com/docs/chat/platform-api/v3/application/settings-by-channel-custom-type/list-settings-by-channel-custom-type
+func (a *application) ListCustomTypeSettings(ctx context.Context, listCustomTypeSettingsRequest ListCustomTypeSettingsRequest) (*ListCustomTypeSettingsResponse, error) {
+	u := &url.URL{
+		Path: "/applications/settings_by_channel_custom_type",
+	}
+
+	query := u.Query()
+	for k, v := range listCustomTypeSettingsRequestToMap(listCustomTypeSettingsRequest) {
+		query.Set(k, v)
+	}
+
+	u.RawQuery = query.Encode()
+
+	lctsr, err := a.client.Get(ctx, u.String(), nil, &ListCustomTypeSettingsResponse{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list custom type settings: %w", err)
+	}
+
+	listCustomTypeSettingsResponse, ok := lctsr.(*ListCustomTypeSettingsResponse)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast body to ListCustomTypeSettingsResponse: %+v", lctsr)
+	}
+
+	return listCustomTypeSettingsResponse, nil
+}
+
+// CreateCustomTypeSettings creates the settings of the channels of a custom
+// type.
+// See https://sendbird.com/docs/chat/platform-api/v3/application/settings-by-channel-custom-type/create-settings-by-channel-custom-type
+func (a *application) CreateCustomTypeSettings(ctx context.Context, createCustomTypeSettingsRequest CreateCustomTypeSettingsRequest) (*CreateCustomTypeSettingsResponse, error) {
+	if err := createCustomTypeSettingsRequest.Validate(); err != nil {
+		return nil, fmt.Errorf("failed to validate create custom type settings request: %w", err)
+	}
+
+	cctsr, err := a.client.Post(ctx, "/applications/settings_by_channel_custom_type", createCustomTypeSettingsRequest, &CreateCustomTypeSettingsResponse{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create custom type settings: %w", err)
+	}
+
+	createCustomTypeSettingsResponse, ok := cctsr.(*CreateCustomTypeSettingsResponse)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast body to CreateCustomTypeSettingsResponse: %+v", cctsr)
+	}
+
+	return createCustomTypeSettingsResponse, nil
+}
+
+// GetCustomTypeSettings retrieves the settings of the channels of a custom
+// type.
+// See https://sendbird.com/docs/chat/platform-api/v3/application/settings-by-channel-custom-type/get-settings-by-channel-custom-type
+func (a *application) GetCustomTypeSettings(ctx context.Context, customType string) (*GetCustomTypeSettingsResponse, error) {
+	gctsr, err := a.client.Get(ctx, "/applications/settings_by_channel_custom_type/"+customType, nil, &GetCustomTypeSettingsResponse{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get custom type settings: %w", err)
+	}
+
+	getCustomTypeSettingsResponse, ok := gctsr.(*GetCustomTypeSettingsResponse)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast body to GetCustomTypeSettingsResponse: %+v", gctsr)
+	}
+
+	return getCustomTypeSettingsResponse, nil
+}
+
+// UpdateCustomTypeSettings updates the settings of the channels of a custom
+// type. Only the specified settings are updated.
+// See https://sendbird.com/docs/chat/platform-api/v3/application/settings-by-channel-custom-type/update-settings-by-channel-custom-type
+func (a *application) UpdateCustomTypeSettings(ctx context.Context, customType string, updateCustomTypeSettingsRequest UpdateCustomTypeSettingsRequest) (*UpdateCustomTypeSettingsResponse, error) {
+	uctsr, err := a.client.Put(ctx, "/applications/settings_by_channel_custom_type/"+customType, updateCustomTypeSettingsRequest, &UpdateCustomTypeSettingsResponse{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update custom type settings: %w", err)
+	}
+
+	updateCustomTypeSettingsResponse, ok := uctsr.(*UpdateCustomTypeSettingsResponse)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast body to UpdateCustomTypeSettingsResponse: %+v", uctsr)
+	}
+
+	return updateCustomTypeSettingsResponse, nil
+}
+
+// DeleteCustomTypeSettings deletes the settings of the channels of a custom
+// type. The channels of the custom type fall back to the global settings.
+// See https://sendbird.com/docs/chat/platform-api/v3/application/settings-by-channel-custom-type/delete-settings-by-channel-custom-type
+func (a *application) DeleteCustomTypeSettings(ctx context.Context, customType string) error {
+	_, err := a.client.Delete(ctx, "/applications/settings_by_channel_custom_type/"+customType, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to delete custom type settings: %w", err)
+	}
+
+	return nil
+}
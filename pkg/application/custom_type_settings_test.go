@@ -0,0 +1,122 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yumi-ia/sendbird-go/pkg/client"
+)
+
+func TestListCustomTypeSettings(t *testing.T) {
+	t.Parallel()
+
+	limit := 5
+	listCustomTypeSettingsRequest := ListCustomTypeSettingsRequest{
+		Token: "token",
+		Limit: &limit,
+	}
+
+	listCustomTypeSettingsResponse := &ListCustomTypeSettingsResponse{
+		ChannelCustomTypeSettings: []CustomTypeSettings{{CustomType: "custom-type"}},
+		Next:                      "next",
+	}
+
+	client := client.NewClientMock(t).
+		OnGet("/applications/settings_by_channel_custom_type?limit=5&token=token", nil, &ListCustomTypeSettingsResponse{}).TypedReturns(listCustomTypeSettingsResponse, nil).Once().
+		Parent
+	application := NewApplication(client)
+
+	lctsr, err := application.ListCustomTypeSettings(context.Background(), listCustomTypeSettingsRequest)
+	require.NoError(t, err)
+	assert.Equal(t, listCustomTypeSettingsResponse, lctsr)
+}
+
+func TestCreateCustomTypeSettings(t *testing.T) {
+	t.Parallel()
+
+	createCustomTypeSettingsRequest := CreateCustomTypeSettingsRequest{
+		CustomType: "custom-type",
+		Settings: Settings{
+			ProfanityTriggeredModeration: &ProfanityTriggeredModeration{
+				Count:    3,
+				Duration: 60,
+				Action:   ModerationActionMute,
+			},
+		},
+	}
+
+	createCustomTypeSettingsResponse := &CreateCustomTypeSettingsResponse{
+		CustomType: "custom-type",
+		Settings:   createCustomTypeSettingsRequest.Settings,
+	}
+
+	client := client.NewClientMock(t).
+		OnPost("/applications/settings_by_channel_custom_type", createCustomTypeSettingsRequest, &CreateCustomTypeSettingsResponse{}).TypedReturns(createCustomTypeSettingsResponse, nil).Once().
+		Parent
+	application := NewApplication(client)
+
+	cctsr, err := application.CreateCustomTypeSettings(context.Background(), createCustomTypeSettingsRequest)
+	require.NoError(t, err)
+	assert.Equal(t, createCustomTypeSettingsResponse, cctsr)
+}
+
+func TestCreateCustomTypeSettings_invalid(t *testing.T) {
+	t.Parallel()
+
+	application := NewApplication(client.NewClientMock(t))
+
+	_, err := application.CreateCustomTypeSettings(context.Background(), CreateCustomTypeSettingsRequest{})
+	require.Error(t, err)
+}
+
+func TestGetCustomTypeSettings(t *testing.T) {
+	t.Parallel()
+
+	getCustomTypeSettingsResponse := &GetCustomTypeSettingsResponse{CustomType: "custom-type"}
+
+	client := client.NewClientMock(t).
+		OnGet("/applications/settings_by_channel_custom_type/custom-type", nil, &GetCustomTypeSettingsResponse{}).TypedReturns(getCustomTypeSettingsResponse, nil).Once().
+		Parent
+	application := NewApplication(client)
+
+	gctsr, err := application.GetCustomTypeSettings(context.Background(), "custom-type")
+	require.NoError(t, err)
+	assert.Equal(t, getCustomTypeSettingsResponse, gctsr)
+}
+
+func TestUpdateCustomTypeSettings(t *testing.T) {
+	t.Parallel()
+
+	maxMessageLength := 1000
+	updateCustomTypeSettingsRequest := UpdateCustomTypeSettingsRequest{
+		MaxMessageLength: &maxMessageLength,
+	}
+
+	updateCustomTypeSettingsResponse := &UpdateCustomTypeSettingsResponse{
+		CustomType: "custom-type",
+		Settings:   Settings{MaxMessageLength: &maxMessageLength},
+	}
+
+	client := client.NewClientMock(t).
+		OnPut("/applications/settings_by_channel_custom_type/custom-type", updateCustomTypeSettingsRequest, &UpdateCustomTypeSettingsResponse{}).TypedReturns(updateCustomTypeSettingsResponse, nil).Once().
+		Parent
+	application := NewApplication(client)
+
+	uctsr, err := application.UpdateCustomTypeSettings(context.Background(), "custom-type", updateCustomTypeSettingsRequest)
+	require.NoError(t, err)
+	assert.Equal(t, updateCustomTypeSettingsResponse, uctsr)
+}
+
+func TestDeleteCustomTypeSettings(t *testing.T) {
+	t.Parallel()
+
+	client := client.NewClientMock(t).
+		OnDelete("/applications/settings_by_channel_custom_type/custom-type", nil, nil).TypedReturns(nil, nil).Once().
+		Parent
+	application := NewApplication(client)
+
+	err := application.DeleteCustomTypeSettings(context.Background(), "custom-type")
+	require.NoError(t, err)
+}
@@ -0,0 +1,19 @@
+package application_test
+
+import (
+	"github.com/yumi-ia/sendbird-go/pkg/application"
+	"github.com/yumi-ia/sendbird-go/pkg/client"
+)
+
+func ExampleNewApplication() {
+	// Initialize a client.
+	opts := []client.Option{}
+	c := client.NewClient(opts...)
+
+	// Initialize an application service.
+	a := application.NewApplication(c)
+
+	// the application client is ready to be used.
+	_ = a
+	// a.DoWork()
+}
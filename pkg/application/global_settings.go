@@ -0,0 +1,51 @@
+package application
+
+import (
+	"context"
+	"fmt"
+)
+
+// GetGlobalSettingsResponse is the response to get the global settings of the
+// application.
+type GetGlobalSettingsResponse Settings
+
+// UpdateGlobalSettingsRequest is the request to update the global settings of
+// the application.
+type UpdateGlobalSettingsRequest Settings
+
+// UpdateGlobalSettingsResponse is the response to update the global settings
+// of the application.
+type UpdateGlobalSettingsResponse Settings
+
+// GetGlobalSettings retrieves the global settings of the application.
+// See https://sendbird.com/docs/chat/platform-api/v3/application/global-application-settings/get-global-application-settings
+func (a *application) GetGlobalSettings(ctx context.Context) (*GetGlobalSettingsResponse, error) {
+	ggsr, err := a.client.Get(ctx, "/applications/settings_global", nil, &GetGlobalSettingsResponse{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get global settings: %w", err)
+	}
+
+	getGlobalSettingsResponse, ok := ggsr.(*GetGlobalSettingsResponse)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast body to GetGlobalSettingsResponse: %+v", ggsr)
+	}
+
+	return getGlobalSettingsResponse, nil
+}
+
+// UpdateGlobalSettings updates the global settings of the application. Only
+// the specified settings are updated.
+// See https://sendbird.com/docs/chat/platform-api/v3/application/global-application-settings/update-global-application-settings
+func (a *application) UpdateGlobalSettings(ctx context.Context, updateGlobalSettingsRequest UpdateGlobalSettingsRequest) (*UpdateGlobalSettingsResponse, error) {
+	ugsr, err := a.client.Put(ctx, "/applications/settings_global", updateGlobalSettingsRequest, &UpdateGlobalSettingsResponse{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update global settings: %w", err)
+	}
+
+	updateGlobalSettingsResponse, ok := ugsr.(*UpdateGlobalSettingsResponse)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast body to UpdateGlobalSettingsResponse: %+v", ugsr)
+	}
+
+	return updateGlobalSettingsResponse, nil
+}
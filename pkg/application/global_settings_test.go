@@ -0,0 +1,68 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yumi-ia/sendbird-go/pkg/client"
+)
+
+func TestGetGlobalSettings(t *testing.T) {
+	t.Parallel()
+
+	displayPastMessage := true
+	getGlobalSettingsResponse := &GetGlobalSettingsResponse{
+		DisplayPastMessage: &displayPastMessage,
+		ProfanityFilter: &ProfanityFilter{
+			Keywords:     []string{"bad*"},
+			RegexFilters: []RegexFilter{{Regex: "^[0-9]+$"}},
+			Type:         ProfanityFilterTypeReplace,
+		},
+	}
+
+	client := client.NewClientMock(t).
+		OnGet("/applications/settings_global", nil, &GetGlobalSettingsResponse{}).TypedReturns(getGlobalSettingsResponse, nil).Once().
+		Parent
+	application := NewApplication(client)
+
+	ggsr, err := application.GetGlobalSettings(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, getGlobalSettingsResponse, ggsr)
+}
+
+func TestUpdateGlobalSettings(t *testing.T) {
+	t.Parallel()
+
+	updateGlobalSettingsRequest := UpdateGlobalSettingsRequest{
+		DomainFilter: &DomainFilter{
+			Domains: []string{"example.com"},
+			Type:    DomainFilterTypeBlock,
+		},
+		ImageModeration: &ImageModeration{
+			Type: ImageModerationTypeModerate,
+			Limits: ImageModerationLimits{
+				Adult:    ImageModerationLevelLikely,
+				Spoof:    ImageModerationLevelVeryLikely,
+				Medical:  ImageModerationLevelVeryLikely,
+				Violence: ImageModerationLevelPossible,
+				Racy:     ImageModerationLevelLikely,
+			},
+		},
+	}
+
+	updateGlobalSettingsResponse := &UpdateGlobalSettingsResponse{
+		DomainFilter:    updateGlobalSettingsRequest.DomainFilter,
+		ImageModeration: updateGlobalSettingsRequest.ImageModeration,
+	}
+
+	client := client.NewClientMock(t).
+		OnPut("/applications/settings_global", updateGlobalSettingsRequest, &UpdateGlobalSettingsResponse{}).TypedReturns(updateGlobalSettingsResponse, nil).Once().
+		Parent
+	application := NewApplication(client)
+
+	ugsr, err := application.UpdateGlobalSettings(context.Background(), updateGlobalSettingsRequest)
+	require.NoError(t, err)
+	assert.Equal(t, updateGlobalSettingsResponse, ugsr)
+}
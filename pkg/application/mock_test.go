@@ -0,0 +1,4 @@
+package application
+
+// https://github.com/traefik/mocktail
+// mocktail:Application
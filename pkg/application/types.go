@@ -0,0 +1,153 @@
+package application
+
+// ProfanityFilterType is the way messages containing profanities are handled.
+type ProfanityFilterType int
+
+const (
+	// ProfanityFilterTypeNone doesn't filter messages.
+	ProfanityFilterTypeNone ProfanityFilterType = 0
+	// ProfanityFilterTypeReplace replaces the profanities with asterisks.
+	ProfanityFilterTypeReplace ProfanityFilterType = 1
+	// ProfanityFilterTypeBlock blocks the messages containing profanities.
+	ProfanityFilterTypeBlock ProfanityFilterType = 2
+)
+
+// DomainFilterType is the way messages containing filtered domains are
+// handled.
+type DomainFilterType int
+
+const (
+	// DomainFilterTypeNone doesn't filter messages.
+	DomainFilterTypeNone DomainFilterType = 0
+	// DomainFilterTypeReplace replaces the filtered domains with asterisks.
+	DomainFilterTypeReplace DomainFilterType = 1
+	// DomainFilterTypeBlock blocks the messages containing filtered domains.
+	DomainFilterTypeBlock DomainFilterType = 2
+)
+
+// ModerationAction is the action taken against a user who repeatedly sends
+// profanities.
+type ModerationAction int
+
+const (
+	ModerationActionNone ModerationAction = 0
+	ModerationActionMute ModerationAction = 1
+	ModerationActionKick ModerationAction = 2
+	ModerationActionBan  ModerationAction = 3
+)
+
+// ImageModerationType is the way images are moderated.
+type ImageModerationType int
+
+const (
+	// ImageModerationTypeNone doesn't moderate images.
+	ImageModerationTypeNone ImageModerationType = 0
+	// ImageModerationTypeModerate moderates images against the limits.
+	ImageModerationTypeModerate ImageModerationType = 1
+	// ImageModerationTypeModerateAndCheckThumbnails moderates images and their
+	// thumbnails against the limits.
+	ImageModerationTypeModerateAndCheckThumbnails ImageModerationType = 2
+)
+
+// ImageModerationLevel is the likelihood from which an image is considered
+// inappropriate for a category.
+type ImageModerationLevel int
+
+const (
+	ImageModerationLevelVeryUnlikely ImageModerationLevel = 1
+	ImageModerationLevelUnlikely     ImageModerationLevel = 2
+	ImageModerationLevelPossible     ImageModerationLevel = 3
+	ImageModerationLevelLikely       ImageModerationLevel = 4
+	ImageModerationLevelVeryLikely   ImageModerationLevel = 5
+)
+
+// RegexFilter is a regular expression used by the profanity filter.
+type RegexFilter struct {
+	// Regex is the regular expression to filter, such as "^[a-z]*$".
+	Regex string `json:"regex"`
+}
+
+// ProfanityFilter is the settings of the profanity filter.
+type ProfanityFilter struct {
+	// Keywords is the list of words to filter. A wildcard (*) can be used at
+	// the start or the end of a keyword, such as "*bad" or "bad*".
+	Keywords []string `json:"keywords"`
+	// RegexFilters is the list of regular expressions to filter.
+	RegexFilters []RegexFilter `json:"regex_filters"`
+	// Type is the way messages containing profanities are handled.
+	Type ProfanityFilterType `json:"type"`
+}
+
+// ProfanityTriggeredModeration is the settings of the moderation of users who
+// repeatedly send profanities.
+type ProfanityTriggeredModeration struct {
+	// Count is the number of profanities from which the action is taken.
+	Count int `json:"count"`
+	// Duration is the time window in seconds in which the profanities are
+	// counted.
+	Duration int `json:"duration"`
+	// Action is the action taken against the user.
+	Action ModerationAction `json:"action"`
+}
+
+// DomainFilter is the settings of the domain filter.
+type DomainFilter struct {
+	// Domains is the list of domains to filter, such as "sendbird.com".
+	Domains []string `json:"domains"`
+	// Type is the way messages containing filtered domains are handled.
+	Type DomainFilterType `json:"type"`
+}
+
+// ImageModerationLimits is the likelihood from which an image is blocked for
+// each category.
+type ImageModerationLimits struct {
+	Adult    ImageModerationLevel `json:"adult"`
+	Spoof    ImageModerationLevel `json:"spoof"`
+	Medical  ImageModerationLevel `json:"medical"`
+	Violence ImageModerationLevel `json:"violence"`
+	Racy     ImageModerationLevel `json:"racy"`
+}
+
+// ImageModeration is the settings of the image moderation.
+type ImageModeration struct {
+	// Type is the way images are moderated.
+	Type ImageModerationType `json:"type"`
+	// SoftBlock determines whether to only flag inappropriate images instead of
+	// blocking them.
+	SoftBlock bool `json:"soft_block"`
+	// Limits is the likelihood from which an image is blocked for each
+	// category.
+	Limits ImageModerationLimits `json:"limits"`
+	// CheckURLs determines whether to moderate the images linked by URL in the
+	// messages.
+	CheckURLs bool `json:"check_urls"`
+}
+
+// Settings is the settings of the application or of the channels of a custom
+// type. Unset fields are left unchanged on update.
+type Settings struct {
+	// DisplayPastMessage determines whether to display the messages sent before
+	// a user joined a channel.
+	DisplayPastMessage *bool `json:"display_past_message,omitempty"`
+	// AllowLinks determines whether to allow messages containing URLs.
+	AllowLinks *bool `json:"allow_links,omitempty"`
+	// MaxMessageLength is the maximum length of a message.
+	MaxMessageLength *int `json:"max_message_length,omitempty"`
+	// ProfanityFilter is the settings of the profanity filter.
+	ProfanityFilter *ProfanityFilter `json:"profanity_filter,omitempty"`
+	// ProfanityTriggeredModeration is the settings of the moderation of users
+	// who repeatedly send profanities.
+	ProfanityTriggeredModeration *ProfanityTriggeredModeration `json:"profanity_triggered_moderation,omitempty"`
+	// DomainFilter is the settings of the domain filter.
+	DomainFilter *DomainFilter `json:"domain_filter,omitempty"`
+	// ImageModeration is the settings of the image moderation.
+	ImageModeration *ImageModeration `json:"image_moderation,omitempty"`
+}
+
+// CustomTypeSettings is the settings of the channels of a custom type.
+type CustomTypeSettings struct {
+	// CustomType is the custom type of the channels.
+	CustomType string `json:"custom_type"`
+
+	Settings
+}
@@ -0,0 +1,51 @@
+// Package bot package provides the interface for the bot service.
+// It provides the methods to interact with the sendbird API.
+// See https://sendbird.com/docs/chat/platform-api/v3/bot/bot-overview.
+package bot
+
+import (
+	"context"
+
+	"github.com/yumi-ia/sendbird-go/pkg/client"
+)
+
+type Bot interface {
+	// CreateBot creates a bot.
+	// See https://sendbird.com/docs/chat/platform-api/v3/bot/creating-a-bot/create-a-bot
+	CreateBot(ctx context.Context, createBotRequest CreateBotRequest) (*CreateBotResponse, error)
+	// ListBots lists the bots of the application.
+	// See https://sendbird.com/docs/chat/platform-api/v3/bot/listing-bots/list-bots
+	ListBots(ctx context.Context, listBotsRequest ListBotsRequest) (*ListBotsResponse, error)
+	// GetBot retrieves a bot.
+	// See https://sendbird.com/docs/chat/platform-api/v3/bot/managing-a-bot/get-a-bot
+	GetBot(ctx context.Context, botUserID string) (*GetBotResponse, error)
+	// UpdateBot updates a bot. Only the specified fields are updated.
+	// See https://sendbird.com/docs/chat/platform-api/v3/bot/managing-a-bot/update-a-bot
+	UpdateBot(ctx context.Context, botUserID string, updateBotRequest UpdateBotRequest) (*UpdateBotResponse, error)
+	// DeleteBot deletes a bot.
+	// See https://sendbird.com/docs/chat/platform-api/v3/bot/managing-a-bot/delete-a-bot
+	DeleteBot(ctx context.Context, botUserID string) error
+
+	// JoinChannels makes a bot join one or more group channels.
+	// See https://sendbird.com/docs/chat/platform-api/v3/bot/managing-a-bot/join-channels
+	JoinChannels(ctx context.Context, botUserID string, channelURLs []string) (*JoinChannelsResponse, error)
+	// LeaveChannel makes a bot leave a group channel.
+	// See https://sendbird.com/docs/chat/platform-api/v3/bot/managing-a-bot/leave-channels
+	LeaveChannel(ctx context.Context, botUserID, channelURL string) error
+	// LeaveAllChannels makes a bot leave all the group channels it has joined.
+	// See https://sendbird.com/docs/chat/platform-api/v3/bot/managing-a-bot/leave-channels
+	LeaveAllChannels(ctx context.Context, botUserID string) error
+
+	// SendBotMessage sends a message from a bot to a group channel the bot has
+	// joined.
+	// See https://sendbird.com/docs/chat/platform-api/v3/bot/sending-a-bot-message/send-a-bot-message
+	SendBotMessage(ctx context.Context, botUserID string, sendBotMessageRequest SendBotMessageRequest) (*SendBotMessageResponse, error)
+}
+
+type bot struct {
+	client client.Client
+}
+
+func NewBot(c client.Client) Bot {
+	return &bot{client: c}
+}
@@ -0,0 +1,164 @@
+package bot
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/yumi-ia/sendbird-go/pkg/message"
+)
+
+// SignatureHeader is the header holding the signature of a callback payload.
+const SignatureHeader = "X-Sendbird-Signature"
+
+// maxCallbackBodySize is the maximum size of a callback payload.
+const maxCallbackBodySize = 1 << 20
+
+// CallbackCategory is the category of a bot callback.
+type CallbackCategory string
+
+const (
+	CallbackCategoryBotMessageNotification CallbackCategory = "bot_message_notification"
+)
+
+// CallbackBot is the bot receiving a callback.
+type CallbackBot struct {
+	BotUserID   string `json:"bot_userid"`
+	BotNickname string `json:"bot_nickname"`
+	BotToken    string `json:"bot_token"`
+}
+
+// CallbackChannel is the channel a callback message was sent to.
+type CallbackChannel struct {
+	Name       string `json:"name"`
+	ChannelURL string `json:"channel_url"`
+	CustomType string `json:"custom_type"`
+	Data       string `json:"data"`
+}
+
+// CallbackMessage is the message forwarded by a callback.
+type CallbackMessage struct {
+	MessageID  int    `json:"message_id"`
+	Text       string `json:"text"`
+	CustomType string `json:"custom_type"`
+	Data       string `json:"data"`
+}
+
+// CallbackPayload is the payload sent to the callback URL of a bot when a
+// message is sent to one of its channels.
+// See https://sendbird.com/docs/chat/platform-api/v3/bot/bot-overview#2-bot-callback
+type CallbackPayload struct {
+	Category  CallbackCategory `json:"category"`
+	Bot       CallbackBot      `json:"bot"`
+	Sender    message.User     `json:"sender"`
+	Members   []message.User   `json:"members"`
+	Channel   CallbackChannel  `json:"channel"`
+	Message   CallbackMessage  `json:"message"`
+	Mentioned []string         `json:"mentioned"`
+	CreatedAt int64            `json:"created_at"`
+	AppID     string           `json:"app_id"`
+}
+
+// CallbackHandlerFunc handles a callback payload. Returning an error makes
+// the handler respond with an internal server error.
+type CallbackHandlerFunc func(ctx context.Context, payload *CallbackPayload) error
+
+// callbackHandler is the http.Handler receiving the callbacks of a bot.
+type callbackHandler struct {
+	// logger is the logger of the handler.
+	logger *slog.Logger
+	// fn is the function handling the callback payloads.
+	fn CallbackHandlerFunc
+	// apiToken is the API token used to verify the signature of the payloads.
+	apiToken string
+}
+
+// HandlerOption is the interface for the options of the callback handler.
+type HandlerOption func(handler *callbackHandler) *callbackHandler
+
+// WithHandlerLogger is the option for the logger of the callback handler.
+func WithHandlerLogger(l *slog.Logger) HandlerOption {
+	return func(handler *callbackHandler) *callbackHandler {
+		handler.logger = l
+
+		return handler
+	}
+}
+
+// NewCallbackHandler returns an http.Handler decoding the callbacks of a bot
+// and passing them to fn. The signature of the payloads is verified with
+// apiToken, the API token of the application; payloads with a missing or
+// invalid signature are rejected, and so are all payloads if apiToken is
+// empty.
+func NewCallbackHandler(apiToken string, fn CallbackHandlerFunc, opts ...HandlerOption) http.Handler {
+	handler := &callbackHandler{
+		logger:   slog.Default(),
+		fn:       fn,
+		apiToken: apiToken,
+	}
+
+	for _, opt := range opts {
+		handler = opt(handler)
+	}
+
+	return handler
+}
+
+func (h *callbackHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxCallbackBodySize))
+	if err != nil {
+		h.logger.Error("failed to read callback body", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+
+		return
+	}
+
+	if h.apiToken == "" || !ValidSignature(body, r.Header.Get(SignatureHeader), h.apiToken) {
+		h.logger.Warn("invalid callback signature")
+		w.WriteHeader(http.StatusUnauthorized)
+
+		return
+	}
+
+	payload := &CallbackPayload{}
+	if err := json.Unmarshal(body, payload); err != nil {
+		h.logger.Error("failed to decode callback payload", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+
+		return
+	}
+
+	if err := h.fn(r.Context(), payload); err != nil {
+		h.logger.Error("failed to handle callback payload", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// ValidSignature reports whether signature is the hex-encoded HMAC-SHA256 of
+// body keyed with the API token of the application.
+func ValidSignature(body []byte, signature, apiToken string) bool {
+	expected, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(apiToken))
+	mac.Write(body)
+
+	return hmac.Equal(mac.Sum(nil), expected)
+}
@@ -0,0 +1,158 @@
+package bot
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const callbackBody = `{
+	"category": "bot_message_notification",
+	"bot": {"bot_userid": "bot-user-id", "bot_nickname": "Assistant"},
+	"sender": {"user_id": "user-id", "nickname": "User"},
+	"channel": {"name": "Support", "channel_url": "channel-url"},
+	"message": {"message_id": 1, "text": "/help"},
+	"mentioned": ["bot-user-id"],
+	"created_at": 1700000000000,
+	"app_id": "app-id"
+}`
+
+func sign(body, apiToken string) string {
+	mac := hmac.New(sha256.New, []byte(apiToken))
+	mac.Write([]byte(body))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestCallbackHandler(t *testing.T) {
+	t.Parallel()
+
+	var got *CallbackPayload
+	handler := NewCallbackHandler("api-token", func(_ context.Context, payload *CallbackPayload) error {
+		got = payload
+
+		return nil
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/callback", strings.NewReader(callbackBody))
+	req.Header.Set(SignatureHeader, sign(callbackBody, "api-token"))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	require.NotNil(t, got)
+	assert.Equal(t, CallbackCategoryBotMessageNotification, got.Category)
+	assert.Equal(t, "bot-user-id", got.Bot.BotUserID)
+	assert.Equal(t, "user-id", got.Sender.UserID)
+	assert.Equal(t, "channel-url", got.Channel.ChannelURL)
+	assert.Equal(t, 1, got.Message.MessageID)
+	assert.Equal(t, "/help", got.Message.Text)
+	assert.Equal(t, []string{"bot-user-id"}, got.Mentioned)
+}
+
+func TestCallbackHandler_errors(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		method    string
+		body      string
+		signature string
+		fnErr     error
+		want      int
+	}{
+		{
+			name:      "valid signature",
+			method:    http.MethodPost,
+			body:      callbackBody,
+			signature: sign(callbackBody, "api-token"),
+			want:      http.StatusOK,
+		},
+		{
+			name:   "method not allowed",
+			method: http.MethodGet,
+			want:   http.StatusMethodNotAllowed,
+		},
+		{
+			name:      "missing signature",
+			method:    http.MethodPost,
+			body:      callbackBody,
+			signature: "",
+			want:      http.StatusUnauthorized,
+		},
+		{
+			name:      "invalid signature",
+			method:    http.MethodPost,
+			body:      callbackBody,
+			signature: sign(callbackBody, "other-token"),
+			want:      http.StatusUnauthorized,
+		},
+		{
+			name:      "invalid payload",
+			method:    http.MethodPost,
+			body:      "{",
+			signature: sign("{", "api-token"),
+			want:      http.StatusBadRequest,
+		},
+		{
+			name:      "handler error",
+			method:    http.MethodPost,
+			body:      callbackBody,
+			signature: sign(callbackBody, "api-token"),
+			fnErr:     errors.New("boom"),
+			want:      http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			called := false
+			handler := NewCallbackHandler("api-token", func(context.Context, *CallbackPayload) error {
+				called = true
+
+				return tc.fnErr
+			})
+
+			req := httptest.NewRequest(tc.method, "/callback", strings.NewReader(tc.body))
+			req.Header.Set(SignatureHeader, tc.signature)
+
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.want, rec.Code)
+			assert.Equal(t, tc.want == http.StatusOK || tc.fnErr != nil, called)
+		})
+	}
+}
+
+func TestCallbackHandler_noAPIToken(t *testing.T) {
+	t.Parallel()
+
+	handler := NewCallbackHandler("", func(context.Context, *CallbackPayload) error {
+		t.Fatal("unexpected callback")
+
+		return nil
+	})
+
+	for _, signature := range []string{"", sign(callbackBody, "")} {
+		req := httptest.NewRequest(http.MethodPost, "/callback", strings.NewReader(callbackBody))
+		req.Header.Set(SignatureHeader, signature)
+
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	}
+}
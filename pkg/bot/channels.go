@@ -0,0 +1,73 @@
+package bot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/yumi-ia/sendbird-go/pkg/channel"
+)
+
+// joinChannelsRequest is the request to make a bot join group channels.
+type joinChannelsRequest struct {
+	// ChannelURLs specifies the URLs of the group channels to join.
+	ChannelURLs []string `json:"channel_urls"`
+}
+
+// JoinChannelsResponse is the response to make a bot join group channels.
+type JoinChannelsResponse struct {
+	// Channels is the list of group channels the bot joined.
+	Channels []channel.ChannelResource `json:"channels"`
+}
+
+// JoinChannels makes a bot join one or more group channels.
+// See https://sendbird.com/docs/chat/platform-api/v3/bot/managing-a-bot/join-channels
+func (b *bot) JoinChannels(ctx context.Context, botUserID string, channelURLs []string) (*JoinChannelsResponse, error) {
+	if len(channelURLs) == 0 {
+		return nil, errors.New("channel URLs are required")
+	}
+
+	path := fmt.Sprintf("/bots/%s/channels", botUserID)
+
+	req := joinChannelsRequest{
+		ChannelURLs: channelURLs,
+	}
+
+	jcr, err := b.client.Post(ctx, path, req, &JoinChannelsResponse{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to join channels: %w", err)
+	}
+
+	joinChannelsResponse, ok := jcr.(*JoinChannelsResponse)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast body to JoinChannelsResponse: %+v", jcr)
+	}
+
+	return joinChannelsResponse, nil
+}
+
+// LeaveChannel makes a bot leave a group channel.
+// See https://sendbird.com/docs/chat/platform-api/v3/bot/managing-a-bot/leave-channels
+func (b *bot) LeaveChannel(ctx context.Context, botUserID, channelURL string) error {
+	path := fmt.Sprintf("/bots/%s/channels/%s", botUserID, channelURL)
+
+	_, err := b.client.Delete(ctx, path, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to leave channel: %w", err)
+	}
+
+	return nil
+}
+
+// LeaveAllChannels makes a bot leave all the group channels it has joined.
+// See https://sendbird.com/docs/chat/platform-api/v3/bot/managing-a-bot/leave-channels
+func (b *bot) LeaveAllChannels(ctx context.Context, botUserID string) error {
+	path := fmt.Sprintf("/bots/%s/channels", botUserID)
+
+	_, err := b.client.Delete(ctx, path, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to leave all channels: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package bot
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yumi-ia/sendbird-go/pkg/channel"
+	"github.com/yumi-ia/sendbird-go/pkg/client"
+)
+
+func TestJoinChannels(t *testing.T) {
+	t.Parallel()
+
+	joinChannelsResponse := &JoinChannelsResponse{
+		Channels: []channel.ChannelResource{{ChannelURL: "channel-url"}},
+	}
+
+	client := client.NewClientMock(t).
+		OnPost("/bots/bot-user-id/channels", joinChannelsRequest{ChannelURLs: []string{"channel-url"}}, &JoinChannelsResponse{}).TypedReturns(joinChannelsResponse, nil).Once().
+		Parent
+	bot := NewBot(client)
+
+	jcr, err := bot.JoinChannels(context.Background(), "bot-user-id", []string{"channel-url"})
+	require.NoError(t, err)
+	assert.Equal(t, joinChannelsResponse, jcr)
+}
+
+func TestJoinChannels_noChannel(t *testing.T) {
+	t.Parallel()
+
+	bot := NewBot(client.NewClientMock(t))
+
+	_, err := bot.JoinChannels(context.Background(), "bot-user-id", nil)
+	require.Error(t, err)
+}
+
+func TestLeaveChannel(t *testing.T) {
+	t.Parallel()
+
+	client := client.NewClientMock(t).
+		OnDelete("/bots/bot-user-id/channels/channel-url", nil, nil).TypedReturns(nil, nil).Once().
+		Parent
+	bot := NewBot(client)
+
+	err := bot.LeaveChannel(context.Background(), "bot-user-id", "channel-url")
+	require.NoError(t, err)
+}
+
+func TestLeaveAllChannels(t *testing.T) {
+	t.Parallel()
+
+	client := client.NewClientMock(t).
+		OnDelete("/bots/bot-user-id/channels", nil, nil).TypedReturns(nil, nil).Once().
+		Parent
+	bot := NewBot(client)
+
+	err := bot.LeaveAllChannels(context.Background(), "bot-user-id")
+	require.NoError(t, err)
+}
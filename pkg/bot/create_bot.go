@@ -0,0 +1,79 @@
+package bot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// CreateBotRequest is the request to create a bot.
+type CreateBotRequest struct {
+	// BotUserID specifies the unique ID of the bot - required.
+	BotUserID string `json:"bot_userid"`
+	// BotNickname specifies the nickname of the bot - required.
+	BotNickname string `json:"bot_nickname"`
+	// BotProfileURL specifies the URL of the profile image of the bot -
+	// required.
+	BotProfileURL string `json:"bot_profile_url"`
+	// BotType specifies the type of the bot, used to group bots - required.
+	BotType string `json:"bot_type"`
+	// BotCallbackURL specifies the URL the messages sent to the channels of the
+	// bot are forwarded to - required.
+	BotCallbackURL string `json:"bot_callback_url"`
+	// IsPrivacyMode determines whether to forward only the messages mentioning
+	// the bot, or starting with a slash (/), to the callback URL - required.
+	IsPrivacyMode bool `json:"is_privacy_mode"`
+
+	// EnableMarkAsRead determines whether to mark the messages as read for the
+	// bot once forwarded to the callback URL.
+	// Optional. (Default: true)
+	EnableMarkAsRead *bool `json:"enable_mark_as_read,omitempty"`
+	// ShowMember determines whether to include the members of the channel in
+	// the callback payloads.
+	// Optional. (Default: false)
+	ShowMember *bool `json:"show_member,omitempty"`
+	// ChannelInvitationPreference specifies the way the bot handles
+	// invitations to group channels.
+	// Optional. (Default: ChannelInvitationPreferenceAutoAccept)
+	ChannelInvitationPreference *ChannelInvitationPreference `json:"channel_invitation_preference,omitempty"`
+}
+
+func (cbr *CreateBotRequest) Validate() error {
+	switch {
+	case cbr.BotUserID == "":
+		return errors.New("bot user ID is required")
+	case cbr.BotNickname == "":
+		return errors.New("bot nickname is required")
+	case cbr.BotProfileURL == "":
+		return errors.New("bot profile URL is required")
+	case cbr.BotType == "":
+		return errors.New("bot type is required")
+	case cbr.BotCallbackURL == "":
+		return errors.New("bot callback URL is required")
+	}
+
+	return nil
+}
+
+// CreateBotResponse is the response to create a bot.
+type CreateBotResponse BotResource
+
+// CreateBot creates a bot.
+// See https://sendbird.com/docs/chat/platform-api/v3/bot/creating-a-bot/create-a-bot
+func (b *bot) CreateBot(ctx context.Context, createBotRequest CreateBotRequest) (*CreateBotResponse, error) {
+	if err := createBotRequest.Validate(); err != nil {
+		return nil, fmt.Errorf("failed to validate create bot request: %w", err)
+	}
+
+	cbr, err := b.client.Post(ctx, "/bots", createBotRequest, &CreateBotResponse{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create bot: %w", err)
+	}
+
+	createBotResponse, ok := cbr.(*CreateBotResponse)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast body to CreateBotResponse: %+v", cbr)
+	}
+
+	return createBotResponse, nil
+}
@@ -0,0 +1,53 @@
+package bot
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yumi-ia/sendbird-go/pkg/client"
+)
+
+func TestCreateBot(t *testing.T) {
+	t.Parallel()
+
+	createBotRequest := CreateBotRequest{
+		BotUserID:      "bot-user-id",
+		BotNickname:    "Assistant",
+		BotProfileURL:  "https://example.com/bot.png",
+		BotType:        "assistant",
+		BotCallbackURL: "https://example.com/callback",
+		IsPrivacyMode:  true,
+	}
+
+	createBotResponse := &CreateBotResponse{
+		Bot: BotInfo{
+			BotUserID:     "bot-user-id",
+			BotNickname:   "Assistant",
+			BotProfileURL: "https://example.com/bot.png",
+			BotType:       "assistant",
+			BotToken:      "bot-token",
+		},
+		BotCallbackURL: "https://example.com/callback",
+		IsPrivacyMode:  true,
+	}
+
+	client := client.NewClientMock(t).
+		OnPost("/bots", createBotRequest, &CreateBotResponse{}).TypedReturns(createBotResponse, nil).Once().
+		Parent
+	bot := NewBot(client)
+
+	cbr, err := bot.CreateBot(context.Background(), createBotRequest)
+	require.NoError(t, err)
+	assert.Equal(t, createBotResponse, cbr)
+}
+
+func TestCreateBot_invalid(t *testing.T) {
+	t.Parallel()
+
+	bot := NewBot(client.NewClientMock(t))
+
+	_, err := bot.CreateBot(context.Background(), CreateBotRequest{BotUserID: "bot-user-id"})
+	require.Error(t, err)
+}
@@ -0,0 +1,19 @@
+package bot_test
+
+import (
+	"github.com/yumi-ia/sendbird-go/pkg/bot"
+	"github.com/yumi-ia/sendbird-go/pkg/client"
+)
+
+func ExampleNewBot() {
+	// Initialize a client.
+	opts := []client.Option{}
+	c := client.NewClient(opts...)
+
+	// Initialize a bot service.
+	b := bot.NewBot(c)
+
+	// the bot client is ready to be used.
+	_ = b
+	// b.DoWork()
+}
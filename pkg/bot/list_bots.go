@@ -0,0 +1,89 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// ListBotsRequest is the request to list bots.
+type ListBotsRequest struct {
+	// Token specifies a page token that indicates the starting index of a chunk
+	// of results. If not specified, the index is set as 0.
+	// Optional.
+	Token string
+	// Limit specifies the number of results to return per page. Acceptable
+	// values are 1 to 100, inclusive. (Default: 10)
+	// Optional.
+	Limit *int
+}
+
+// ListBotsResponse is the response to list bots.
+type ListBotsResponse struct {
+	// Bots is the list of bots.
+	Bots []BotResource `json:"bots"`
+	// Next is the value for the token parameter to retrieve the next page in the
+	// result.
+	Next string `json:"next"`
+}
+
+func listBotsRequestToMap(lbr ListBotsRequest) map[string]string {
+	m := make(map[string]string)
+
+	if lbr.Token != "" {
+		m["token"] = lbr.Token
+	}
+
+	if lbr.Limit != nil {
+		m["limit"] = strconv.Itoa(*lbr.Limit)
+	}
+
+	return m
+}
+
+// ListBots lists the bots of the application.
+// See https://sendbird.com/docs/chat/platform-api/v3/bot/listing-bots/list-bots
+func (b *bot) ListBots(ctx context.Context, listBotsRequest ListBotsRequest) (*ListBotsResponse, error) {
+	u := &url.URL{
+		Path: "/bots",
+	}
+
+	query := u.Query()
+	for k, v := range listBotsRequestToMap(listBotsRequest) {
+		query.Set(k, v)
+	}
+
+	u.RawQuery = query.Encode()
+
+	lbr, err := b.client.Get(ctx, u.String(), nil, &ListBotsResponse{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list bots: %w", err)
+	}
+
+	listBotsResponse, ok := lbr.(*ListBotsResponse)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast body to ListBotsResponse: %+v", lbr)
+	}
+
+	return listBotsResponse, nil
+}
+
+// GetBotResponse is the response to get a bot.
+type GetBotResponse BotResource
+
+// GetBot retrieves a bot.
+// See https://sendbird.com/docs/chat/platform-api/v3/bot/managing-a-bot/get-a-bot
+func (b *bot) GetBot(ctx context.Context, botUserID string) (*GetBotResponse, error) {
+	gbr, err := b.client.Get(ctx, "/bots/"+botUserID, nil, &GetBotResponse{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bot: %w", err)
+	}
+
+	getBotResponse, ok := gbr.(*GetBotResponse)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast body to GetBotResponse: %+v", gbr)
+	}
+
+	return getBotResponse, nil
+}
@@ -0,0 +1,44 @@
+package bot
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yumi-ia/sendbird-go/pkg/client"
+)
+
+func TestListBots(t *testing.T) {
+	t.Parallel()
+
+	limit := 5
+	listBotsResponse := &ListBotsResponse{
+		Bots: []BotResource{{Bot: BotInfo{BotUserID: "bot-user-id"}}},
+		Next: "next",
+	}
+
+	client := client.NewClientMock(t).
+		OnGet("/bots?limit=5&token=token", nil, &ListBotsResponse{}).TypedReturns(listBotsResponse, nil).Once().
+		Parent
+	bot := NewBot(client)
+
+	lbr, err := bot.ListBots(context.Background(), ListBotsRequest{Token: "token", Limit: &limit})
+	require.NoError(t, err)
+	assert.Equal(t, listBotsResponse, lbr)
+}
+
+func TestGetBot(t *testing.T) {
+	t.Parallel()
+
+	getBotResponse := &GetBotResponse{Bot: BotInfo{BotUserID: "bot-user-id"}}
+
+	client := client.NewClientMock(t).
+		OnGet("/bots/bot-user-id", nil, &GetBotResponse{}).TypedReturns(getBotResponse, nil).Once().
+		Parent
+	bot := NewBot(client)
+
+	gbr, err := bot.GetBot(context.Background(), "bot-user-id")
+	require.NoError(t, err)
+	assert.Equal(t, getBotResponse, gbr)
+}
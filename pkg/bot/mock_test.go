@@ -0,0 +1,4 @@
+package bot
+
+// https://github.com/traefik/mocktail
+// mocktail:Bot
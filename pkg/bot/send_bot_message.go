@@ -0,0 +1,81 @@
+package bot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/yumi-ia/sendbird-go/pkg/message"
+)
+
+// SendBotMessageRequest is the request to send a bot message.
+type SendBotMessageRequest struct {
+	// ChannelURL specifies the URL of the group channel to send the message
+	// to - required.
+	ChannelURL string `json:"channel_url"`
+	// Message specifies the content of the message - required.
+	Message string `json:"message"`
+
+	// CustomType specifies a custom message type used for message grouping.
+	// Optional.
+	CustomType string `json:"custom_type,omitempty"`
+	// Data specifies additional message information.
+	// Optional.
+	Data string `json:"data,omitempty"`
+	// SendPush determines whether to send a push notification of the message to
+	// the channel members.
+	// Optional. (Default: true)
+	SendPush *bool `json:"send_push,omitempty"`
+	// MentionUserIDs specifies an array of IDs of the users to mention in the
+	// message.
+	// Optional.
+	MentionUserIDs []string `json:"mentioned,omitempty"`
+	// MarkAsRead determines whether to mark the message as read for the bot.
+	// Optional. (Default: true)
+	MarkAsRead *bool `json:"mark_as_read,omitempty"`
+	// DedupID specifies a unique ID for the message, used by the server to
+	// prevent the same message from being sent twice.
+	// Optional.
+	DedupID string `json:"dedup_id,omitempty"`
+	// CreatedAt specifies the time when the message was sent in Unix
+	// milliseconds format.
+	// Optional.
+	CreatedAt int64 `json:"created_at,omitempty"`
+}
+
+func (sbmr *SendBotMessageRequest) Validate() error {
+	switch {
+	case sbmr.ChannelURL == "":
+		return errors.New("channel URL is required")
+	case sbmr.Message == "":
+		return errors.New("message is required")
+	}
+
+	return nil
+}
+
+// SendBotMessageResponse is the response to send a bot message.
+type SendBotMessageResponse message.MessageResource
+
+// SendBotMessage sends a message from a bot to a group channel the bot has
+// joined.
+// See https://sendbird.com/docs/chat/platform-api/v3/bot/sending-a-bot-message/send-a-bot-message
+func (b *bot) SendBotMessage(ctx context.Context, botUserID string, sendBotMessageRequest SendBotMessageRequest) (*SendBotMessageResponse, error) {
+	if err := sendBotMessageRequest.Validate(); err != nil {
+		return nil, fmt.Errorf("failed to validate send bot message request: %w", err)
+	}
+
+	path := fmt.Sprintf("/bots/%s/send", botUserID)
+
+	sbmr, err := b.client.Post(ctx, path, sendBotMessageRequest, &SendBotMessageResponse{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to send bot message: %w", err)
+	}
+
+	sendBotMessageResponse, ok := sbmr.(*SendBotMessageResponse)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast body to SendBotMessageResponse: %+v", sbmr)
+	}
+
+	return sendBotMessageResponse, nil
+}
@@ -0,0 +1,44 @@
+package bot
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yumi-ia/sendbird-go/pkg/client"
+)
+
+func TestSendBotMessage(t *testing.T) {
+	t.Parallel()
+
+	sendBotMessageRequest := SendBotMessageRequest{
+		ChannelURL:     "channel-url",
+		Message:        "Hello!",
+		MentionUserIDs: []string{"user-id"},
+	}
+
+	sendBotMessageResponse := &SendBotMessageResponse{
+		MessageID:  1,
+		ChannelURL: "channel-url",
+		Message:    "Hello!",
+	}
+
+	client := client.NewClientMock(t).
+		OnPost("/bots/bot-user-id/send", sendBotMessageRequest, &SendBotMessageResponse{}).TypedReturns(sendBotMessageResponse, nil).Once().
+		Parent
+	bot := NewBot(client)
+
+	sbmr, err := bot.SendBotMessage(context.Background(), "bot-user-id", sendBotMessageRequest)
+	require.NoError(t, err)
+	assert.Equal(t, sendBotMessageResponse, sbmr)
+}
+
+func TestSendBotMessage_invalid(t *testing.T) {
+	t.Parallel()
+
+	bot := NewBot(client.NewClientMock(t))
+
+	_, err := bot.SendBotMessage(context.Background(), "bot-user-id", SendBotMessageRequest{ChannelURL: "channel-url"})
+	require.Error(t, err)
+}
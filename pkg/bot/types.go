@@ -0,0 +1,35 @@
+package bot
+
+// ChannelInvitationPreference is the way a bot handles invitations to group
+// channels.
+type ChannelInvitationPreference int
+
+const (
+	// ChannelInvitationPreferenceAutoAccept makes the bot join the group
+	// channels it is invited to.
+	ChannelInvitationPreferenceAutoAccept ChannelInvitationPreference = 0
+	// ChannelInvitationPreferenceManual keeps the invitations of the bot
+	// pending until accepted.
+	ChannelInvitationPreferenceManual ChannelInvitationPreference = 1
+)
+
+// BotInfo is the information of a bot.
+type BotInfo struct {
+	BotUserID     string            `json:"bot_userid"`
+	BotNickname   string            `json:"bot_nickname"`
+	BotProfileURL string            `json:"bot_profile_url"`
+	BotType       string            `json:"bot_type"`
+	BotToken      string            `json:"bot_token"`
+	BotMetadata   map[string]string `json:"bot_metadata"`
+	CreatedAt     int64             `json:"created_at"`
+}
+
+// BotResource is the resource of a bot.
+type BotResource struct {
+	Bot                         BotInfo                     `json:"bot"`
+	BotCallbackURL              string                      `json:"bot_callback_url"`
+	EnableMarkAsRead            bool                        `json:"enable_mark_as_read"`
+	IsPrivacyMode               bool                        `json:"is_privacy_mode"`
+	ShowMember                  bool                        `json:"show_member"`
+	ChannelInvitationPreference ChannelInvitationPreference `json:"channel_invitation_preference"`
+}
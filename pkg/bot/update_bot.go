@@ -0,0 +1,69 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+)
+
+// UpdateBotRequest is the request to update a bot. Only the specified fields
+// are updated.
+type UpdateBotRequest struct {
+	// BotUserID specifies the new unique ID of the bot.
+	// Optional.
+	BotUserID string `json:"bot_userid,omitempty"`
+	// BotNickname specifies the new nickname of the bot.
+	// Optional.
+	BotNickname string `json:"bot_nickname,omitempty"`
+	// BotProfileURL specifies the URL of the new profile image of the bot.
+	// Optional.
+	BotProfileURL string `json:"bot_profile_url,omitempty"`
+	// BotCallbackURL specifies the new URL the messages are forwarded to.
+	// Optional.
+	BotCallbackURL string `json:"bot_callback_url,omitempty"`
+	// IsPrivacyMode determines whether to forward only the messages mentioning
+	// the bot, or starting with a slash (/), to the callback URL.
+	// Optional.
+	IsPrivacyMode *bool `json:"is_privacy_mode,omitempty"`
+	// EnableMarkAsRead determines whether to mark the messages as read for the
+	// bot once forwarded to the callback URL.
+	// Optional.
+	EnableMarkAsRead *bool `json:"enable_mark_as_read,omitempty"`
+	// ShowMember determines whether to include the members of the channel in
+	// the callback payloads.
+	// Optional.
+	ShowMember *bool `json:"show_member,omitempty"`
+	// ChannelInvitationPreference specifies the way the bot handles
+	// invitations to group channels.
+	// Optional.
+	ChannelInvitationPreference *ChannelInvitationPreference `json:"channel_invitation_preference,omitempty"`
+}
+
+// UpdateBotResponse is the response to update a bot.
+type UpdateBotResponse BotResource
+
+// UpdateBot updates a bot. Only the specified fields are updated.
+// See https://sendbird.com/docs/chat/platform-api/v3/bot/managing-a-bot/update-a-bot
+func (b *bot) UpdateBot(ctx context.Context, botUserID string, updateBotRequest UpdateBotRequest) (*UpdateBotResponse, error) {
+	ubr, err := b.client.Put(ctx, "/bots/"+botUserID, updateBotRequest, &UpdateBotResponse{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update bot: %w", err)
+	}
+
+	updateBotResponse, ok := ubr.(*UpdateBotResponse)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast body to UpdateBotResponse: %+v", ubr)
+	}
+
+	return updateBotResponse, nil
+}
+
+// DeleteBot deletes a bot.
+// See https://sendbird.com/docs/chat/platform-api/v3/bot/managing-a-bot/delete-a-bot
+func (b *bot) DeleteBot(ctx context.Context, botUserID string) error {
+	_, err := b.client.Delete(ctx, "/bots/"+botUserID, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to delete bot: %w", err)
+	}
+
+	return nil
+}
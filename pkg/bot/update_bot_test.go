@@ -0,0 +1,46 @@
+package bot
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yumi-ia/sendbird-go/pkg/client"
+)
+
+func TestUpdateBot(t *testing.T) {
+	t.Parallel()
+
+	showMember := true
+	updateBotRequest := UpdateBotRequest{
+		BotNickname: "New Assistant",
+		ShowMember:  &showMember,
+	}
+
+	updateBotResponse := &UpdateBotResponse{
+		Bot:        BotInfo{BotUserID: "bot-user-id", BotNickname: "New Assistant"},
+		ShowMember: true,
+	}
+
+	client := client.NewClientMock(t).
+		OnPut("/bots/bot-user-id", updateBotRequest, &UpdateBotResponse{}).TypedReturns(updateBotResponse, nil).Once().
+		Parent
+	bot := NewBot(client)
+
+	ubr, err := bot.UpdateBot(context.Background(), "bot-user-id", updateBotRequest)
+	require.NoError(t, err)
+	assert.Equal(t, updateBotResponse, ubr)
+}
+
+func TestDeleteBot(t *testing.T) {
+	t.Parallel()
+
+	client := client.NewClientMock(t).
+		OnDelete("/bots/bot-user-id", nil, nil).TypedReturns(nil, nil).Once().
+		Parent
+	bot := NewBot(client)
+
+	err := bot.DeleteBot(context.Background(), "bot-user-id")
+	require.NoError(t, err)
+}
@@ -0,0 +1,380 @@
+// Package cassette provides an http.RoundTripper recording the interactions
+// with the sendbird API to a file and replaying them, to write deterministic
+// tests against client.Client without hand-written JSON.
+//
+// The recorder is plugged with client.WithHTTPClient:
+//
+//	rec, err := cassette.New("testdata/create_user.json", cassette.ModeAuto)
+//	if err != nil {
+//		t.Fatal(err)
+//	}
+//	defer rec.Stop()
+//
+//	c := client.NewClient(client.WithHTTPClient(rec.Client()), ...)
+//
+// The Api-Token header and the values of client.DefaultRedactedFields in JSON
+// bodies, such as session tokens, are redacted before being recorded, and are
+// replayed redacted.
+package cassette
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/yumi-ia/sendbird-go/pkg/client"
+	"github.com/yumi-ia/sendbird-go/pkg/utils/peek"
+	"github.com/yumi-ia/sendbird-go/pkg/utils/redact"
+)
+
+// Mode is the mode of a recorder.
+type Mode int
+
+const (
+	// ModeReplay replays the interactions of the cassette and never sends any
+	// request.
+	ModeReplay Mode = iota
+	// ModeRecord sends the requests and records the interactions, overwriting
+	// the cassette when the recorder is stopped.
+	ModeRecord
+	// ModeAuto replays the cassette if it exists, and records it otherwise.
+	ModeAuto
+)
+
+// Redacted replaces the values of the redacted headers and JSON fields.
+const Redacted = redact.Redacted
+
+// ErrInteractionNotFound is returned when replaying a request matching no
+// interaction of the cassette.
+var ErrInteractionNotFound = errors.New("interaction not found in cassette")
+
+// Request is a recorded request.
+type Request struct {
+	Method string      `json:"method"`
+	Path   string      `json:"path"`
+	Query  string      `json:"query,omitempty"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+}
+
+// Response is a recorded response.
+type Response struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// Interaction is a recorded request along with its response.
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Cassette is the content of a cassette file.
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Recorder is an http.RoundTripper recording or replaying a cassette.
+type Recorder struct {
+	// path is the path of the cassette file.
+	path string
+	// mode is the mode of the recorder, ModeAuto being resolved on creation.
+	mode Mode
+	// transport sends the requests in ModeRecord.
+	transport http.RoundTripper
+	// redactedHeaders are the headers redacted before recording.
+	redactedHeaders []string
+	// redactedFields are the JSON fields of the bodies redacted before
+	// recording.
+	redactedFields map[string]struct{}
+
+	mu       sync.Mutex
+	cassette Cassette
+	// replayed marks the interactions already replayed.
+	replayed []bool
+}
+
+// Option is the interface for the options of the recorder.
+type Option func(recorder *Recorder) *Recorder
+
+// WithTransport is the option for the transport sending the requests in
+// ModeRecord. (Default: http.DefaultTransport)
+func WithTransport(rt http.RoundTripper) Option {
+	return func(recorder *Recorder) *Recorder {
+		recorder.transport = rt
+
+		return recorder
+	}
+}
+
+// WithRedactedHeaders is the option for the headers redacted in addition to
+// the Api-Token header, in both the requests and the responses.
+func WithRedactedHeaders(headers ...string) Option {
+	return func(recorder *Recorder) *Recorder {
+		recorder.redactedHeaders = append(recorder.redactedHeaders, headers...)
+
+		return recorder
+	}
+}
+
+// WithRedactedFields is the option for the JSON fields redacted at any depth in
+// addition to client.DefaultRedactedFields, in both the request and the
+// response bodies.
+func WithRedactedFields(fields ...string) Option {
+	return func(recorder *Recorder) *Recorder {
+		for _, field := range fields {
+			recorder.redactedFields[field] = struct{}{}
+		}
+
+		return recorder
+	}
+}
+
+// New creates a recorder of the cassette at path. In ModeReplay, and in
+// ModeAuto when the file exists, the cassette is loaded right away.
+func New(path string, mode Mode, opts ...Option) (*Recorder, error) {
+	recorder := &Recorder{
+		path:            path,
+		mode:            mode,
+		transport:       http.DefaultTransport,
+		redactedHeaders: []string{"Api-Token"},
+		redactedFields:  make(map[string]struct{}, len(client.DefaultRedactedFields)),
+	}
+
+	for _, field := range client.DefaultRedactedFields {
+		recorder.redactedFields[field] = struct{}{}
+	}
+
+	for _, opt := range opts {
+		recorder = opt(recorder)
+	}
+
+	if recorder.mode == ModeAuto {
+		recorder.mode = ModeReplay
+		if _, err := os.Stat(path); errors.Is(err, fs.ErrNotExist) {
+			recorder.mode = ModeRecord
+		}
+	}
+
+	if recorder.mode == ModeReplay {
+		if err := recorder.load(); err != nil {
+			return nil, err
+		}
+	}
+
+	return recorder, nil
+}
+
+// Client returns an http client using the recorder as transport.
+func (r *Recorder) Client() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// Mode returns the mode of the recorder, ModeAuto being resolved to either
+// ModeReplay or ModeRecord.
+func (r *Recorder) Mode() Mode {
+	return r.mode
+}
+
+// Stop writes the cassette in ModeRecord. It does nothing in ModeReplay.
+func (r *Recorder) Stop() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	b, err := json.MarshalIndent(r.cassette, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal cassette: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil {
+		return fmt.Errorf("failed to create cassette directory: %w", err)
+	}
+
+	if err := os.WriteFile(r.path, append(b, '\n'), 0o600); err != nil {
+		return fmt.Errorf("failed to write cassette: %w", err)
+	}
+
+	return nil
+}
+
+// RoundTrip records or replays the request. As required from a transport, the
+// body of the request is closed, including when it is replayed.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, _, err := peek.Body(&req.Body, -1)
+	if err != nil {
+		closeBody(req.Body)
+
+		return nil, fmt.Errorf("failed to read request body: %w", err)
+	}
+
+	recorded := Request{
+		Method: req.Method,
+		Path:   req.URL.Path,
+		Query:  req.URL.Query().Encode(),
+		Header: r.redact(req.Header),
+		Body:   r.redactBody(body),
+	}
+
+	if r.mode == ModeReplay {
+		closeBody(req.Body)
+
+		return r.replay(req, recorded)
+	}
+
+	return r.record(req, recorded)
+}
+
+func (r *Recorder) record(req *http.Request, recorded Request) (*http.Response, error) {
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, _, err := peek.Body(&resp.Body, -1)
+	if err != nil {
+		closeBody(resp.Body)
+
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.cassette.Interactions = append(r.cassette.Interactions, Interaction{
+		Request: recorded,
+		Response: Response{
+			StatusCode: resp.StatusCode,
+			Header:     r.redact(resp.Header),
+			Body:       r.redactBody(body),
+		},
+	})
+
+	return resp, nil
+}
+
+// replay returns the response of the first interaction matching the request
+// not replayed yet, so that identical requests are replayed in order.
+func (r *Recorder) replay(req *http.Request, recorded Request) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, interaction := range r.cassette.Interactions {
+		if r.replayed[i] || !match(interaction.Request, recorded) {
+			continue
+		}
+
+		r.replayed[i] = true
+
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+			StatusCode:    interaction.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        interaction.Response.Header.Clone(),
+			Body:          io.NopCloser(bytes.NewReader([]byte(interaction.Response.Body))),
+			ContentLength: int64(len(interaction.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("%w: %s %s?%s", ErrInteractionNotFound, recorded.Method, recorded.Path, recorded.Query)
+}
+
+func (r *Recorder) load() error {
+	b, err := os.ReadFile(r.path)
+	if err != nil {
+		return fmt.Errorf("failed to read cassette: %w", err)
+	}
+
+	if err := json.Unmarshal(b, &r.cassette); err != nil {
+		return fmt.Errorf("failed to decode cassette: %w", err)
+	}
+
+	r.replayed = make([]bool, len(r.cassette.Interactions))
+
+	return nil
+}
+
+// redact returns a copy of header with the redacted headers replaced.
+func (r *Recorder) redact(header http.Header) http.Header {
+	header = header.Clone()
+
+	for _, h := range r.redactedHeaders {
+		if header.Get(h) != "" {
+			header.Set(h, Redacted)
+		}
+	}
+
+	return header
+}
+
+// closeBody closes body, if any.
+func closeBody(body io.ReadCloser) {
+	if body != nil {
+		_ = body.Close()
+	}
+}
+
+// redactBody returns the body with the values of the redacted fields replaced
+// if it is JSON, and as is otherwise.
+func (r *Recorder) redactBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	b, err := redact.JSON(body, r.redactedFields)
+	if err != nil {
+		return string(body)
+	}
+
+	return string(b)
+}
+
+// match reports whether the requests have the same method, path, query and
+// body, both bodies being redacted. Headers are ignored.
+func match(recorded, req Request) bool {
+	return recorded.Method == req.Method &&
+		recorded.Path == req.Path &&
+		normalizeQuery(recorded.Query) == normalizeQuery(req.Query) &&
+		normalizeBody(recorded.Body) == normalizeBody(req.Body)
+}
+
+// normalizeQuery sorts the query parameters by key.
+func normalizeQuery(query string) string {
+	values, err := url.ParseQuery(query)
+	if err != nil {
+		return query
+	}
+
+	return values.Encode()
+}
+
+// normalizeBody sorts the keys of JSON bodies and removes their whitespaces.
+// Other bodies are returned as is.
+func normalizeBody(body string) string {
+	var v any
+	if err := json.Unmarshal([]byte(body), &v); err != nil {
+		return body
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return body
+	}
+
+	return string(b)
+}
@@ -0,0 +1,256 @@
+package cassette
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/yumi-ia/sendbird-go/pkg/client"
+)
+
+type user struct {
+	UserID   string `json:"user_id"`
+	Nickname string `json:"nickname"`
+}
+
+func TestRecorder(t *testing.T) {
+	t.Parallel()
+
+	var calls int
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+
+		var u user
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&u))
+		assert.Equal(t, "secret", r.Header.Get("Api-Token"))
+
+		w.Header().Set("X-Secret", "secret")
+		w.WriteHeader(http.StatusOK)
+		assert.NoError(t, json.NewEncoder(w).Encode(user{UserID: u.UserID, Nickname: "nickname-" + u.UserID}))
+	}))
+
+	path := filepath.Join(t.TempDir(), "testdata", "users.json")
+
+	rec, err := New(path, ModeAuto, WithRedactedHeaders("X-Secret"))
+	require.NoError(t, err)
+	assert.Equal(t, ModeRecord, rec.Mode())
+
+	c := client.NewClient(client.WithURL(s.URL), client.WithAPIToken("secret"), client.WithHTTPClient(rec.Client()))
+
+	for _, id := range []string{"1", "2"} {
+		resp := &user{}
+		_, err := c.Post(context.Background(), "/users?b=2&a=1", user{UserID: id}, resp)
+		require.NoError(t, err)
+		assert.Equal(t, "nickname-"+id, resp.Nickname)
+	}
+
+	require.NoError(t, rec.Stop())
+	s.Close()
+	assert.Equal(t, 2, calls)
+
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(b), "secret")
+	assert.Contains(t, string(b), Redacted)
+
+	rec, err = New(path, ModeAuto)
+	require.NoError(t, err)
+	assert.Equal(t, ModeReplay, rec.Mode())
+
+	c = client.NewClient(client.WithURL(s.URL), client.WithAPIToken("other"), client.WithHTTPClient(rec.Client()))
+
+	// Replayed out of order, with the query and the body keys in another order.
+	for _, id := range []string{"2", "1"} {
+		resp := &user{}
+		_, err := c.Post(context.Background(), "/users?a=1&b=2", map[string]any{"nickname": "", "user_id": id}, resp)
+		require.NoError(t, err)
+		assert.Equal(t, "nickname-"+id, resp.Nickname)
+	}
+
+	_, err = c.Post(context.Background(), "/users?a=1&b=2", user{UserID: "1"}, nil)
+	require.ErrorIs(t, err, ErrInteractionNotFound)
+
+	require.NoError(t, rec.Stop())
+}
+
+func TestNew_replayMissingCassette(t *testing.T) {
+	t.Parallel()
+
+	_, err := New(filepath.Join(t.TempDir(), "missing.json"), ModeReplay)
+	require.ErrorContains(t, err, "failed to read cassette")
+}
+
+func TestMatch(t *testing.T) {
+	t.Parallel()
+
+	recorded := Request{Method: http.MethodGet, Path: "/users", Query: "a=1&b=2", Body: `{"a":1,"b":2}`}
+
+	tests := []struct {
+		name     string
+		req      Request
+		expected bool
+	}{
+		{
+			name:     "same",
+			req:      recorded,
+			expected: true,
+		},
+		{
+			name:     "normalized",
+			req:      Request{Method: http.MethodGet, Path: "/users", Query: "b=2&a=1", Body: `{ "b": 2, "a": 1 }`},
+			expected: true,
+		},
+		{
+			name: "other method",
+			req:  Request{Method: http.MethodPost, Path: "/users", Query: "a=1&b=2", Body: `{"a":1,"b":2}`},
+		},
+		{
+			name: "other path",
+			req:  Request{Method: http.MethodGet, Path: "/users/1", Query: "a=1&b=2", Body: `{"a":1,"b":2}`},
+		},
+		{
+			name: "other query",
+			req:  Request{Method: http.MethodGet, Path: "/users", Query: "a=1", Body: `{"a":1,"b":2}`},
+		},
+		{
+			name: "other body",
+			req:  Request{Method: http.MethodGet, Path: "/users", Query: "a=1&b=2", Body: `{"a":1}`},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, match(recorded, test.req))
+		})
+	}
+}
+
+func TestRecorder_transportError(t *testing.T) {
+	t.Parallel()
+
+	rec, err := New(filepath.Join(t.TempDir(), "error.json"), ModeRecord,
+		WithTransport(client.RoundTripperFunc(func(*http.Request) (*http.Response, error) {
+			return nil, io.ErrUnexpectedEOF
+		})))
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodGet, "http://example.com/users", nil)
+
+	_, err = rec.RoundTrip(req)
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+}
+
+func TestRecorder_redactedFields(t *testing.T) {
+	t.Parallel()
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"user_id":"1","session_tokens":[{"session_token":"secret-token"}],"phone":"0600"}`))
+	}))
+	defer s.Close()
+
+	path := filepath.Join(t.TempDir(), "redacted.json")
+
+	rec, err := New(path, ModeRecord, WithRedactedFields("phone"))
+	require.NoError(t, err)
+
+	c := client.NewClient(client.WithURL(s.URL), client.WithHTTPClient(rec.Client()))
+
+	resp := map[string]any{}
+	_, err = c.Post(context.Background(), "/users", map[string]any{"user_id": "1", "password": "secret-password"}, &resp)
+	require.NoError(t, err)
+	// The response given to the client is not redacted.
+	assert.Equal(t, "0600", resp["phone"])
+
+	require.NoError(t, rec.Stop())
+
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(b), "secret-token")
+	assert.NotContains(t, string(b), "secret-password")
+	assert.NotContains(t, string(b), "0600")
+
+	rec, err = New(path, ModeReplay, WithRedactedFields("phone"))
+	require.NoError(t, err)
+
+	c = client.NewClient(client.WithURL(s.URL), client.WithHTTPClient(rec.Client()))
+
+	// The request matches its recording once redacted.
+	resp = map[string]any{}
+	_, err = c.Post(context.Background(), "/users", map[string]any{"user_id": "1", "password": "other"}, &resp)
+	require.NoError(t, err)
+	assert.Equal(t, Redacted, resp["phone"])
+	assert.Equal(t, Redacted, resp["session_tokens"])
+}
+
+// trackedBody records whether it is closed, and fails to be read when err is
+// set.
+type trackedBody struct {
+	io.Reader
+	err    error
+	closed bool
+}
+
+func (tb *trackedBody) Read(p []byte) (int, error) {
+	if tb.err != nil {
+		return 0, tb.err
+	}
+
+	return tb.Reader.Read(p)
+}
+
+func (tb *trackedBody) Close() error {
+	tb.closed = true
+
+	return nil
+}
+
+func TestRecorder_closesBodies(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "closed.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"interactions":[{
+		"request":{"method":"POST","path":"/users","body":"{\"user_id\":\"1\"}"},
+		"response":{"status_code":200,"body":"{}"}
+	}]}`), 0o600))
+
+	rec, err := New(path, ModeReplay)
+	require.NoError(t, err)
+
+	// Replayed.
+	body := &trackedBody{Reader: strings.NewReader(`{"user_id":"1"}`)}
+	resp, err := rec.RoundTrip(httptest.NewRequest(http.MethodPost, "http://example.com/users", body))
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	assert.True(t, body.closed)
+
+	// Failing request body.
+	body = &trackedBody{err: io.ErrUnexpectedEOF}
+	_, err = rec.RoundTrip(httptest.NewRequest(http.MethodPost, "http://example.com/users", body))
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	assert.True(t, body.closed)
+
+	// Failing response body.
+	respBody := &trackedBody{err: io.ErrUnexpectedEOF}
+	rec, err = New(filepath.Join(t.TempDir(), "recorded.json"), ModeRecord,
+		WithTransport(client.RoundTripperFunc(func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: http.StatusOK, Body: respBody}, nil
+		})))
+	require.NoError(t, err)
+
+	_, err = rec.RoundTrip(httptest.NewRequest(http.MethodGet, "http://example.com/users", nil))
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	assert.True(t, respBody.closed)
+}
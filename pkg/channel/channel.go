@@ -19,10 +19,31 @@ type Channel interface {
 	// ListGroupChannels lists group channels.
 	// See https://sendbird.com/docs/chat/platform-api/v3/channel/listing-channels-in-an-application/list-group-channels
 	ListGroupChannels(ctx context.Context, listChannelRequest ListGroupChannelRequest) (*ListGroupChannelResponse, error)
+	// ListGroupChannelsStream lists group channels, calling fn with each
+	// channel as soon as it is decoded from the response, and returns the token
+	// of the next page.
+	// See https://sendbird.com/docs/chat/platform-api/v3/channel/listing-channels-in-an-application/list-group-channels
+	ListGroupChannelsStream(ctx context.Context, listChannelRequest ListGroupChannelRequest, fn func(ChannelResource) error) (string, error)
 	// MarkAsRead marks all messages in a group channel as read for a specific
 	// user. This action is only applicable for users in a group channel.
 	// See https://sendbird.com/docs/chat/platform-api/v3/message/read-receipts/mark-all-messages-as-read-message
 	MarkAsRead(ctx context.Context, channelURL, userID string) error
+	// GetReadStatus retrieves the read and delivery receipts of the members of
+	// a group channel, optionally restricted to the given users.
+	// See https://sendbird.com/docs/chat/platform-api/v3/message/read-receipts/read-receipts-overview
+	GetReadStatus(ctx context.Context, channelURL string, userIDs []string) (*GetReadStatusResponse, error)
+	// GetUnreadMemberCount retrieves the number of members of a group channel
+	// who haven't read a specific message yet.
+	// See https://sendbird.com/docs/chat/platform-api/v3/message/read-receipts/read-receipts-overview
+	GetUnreadMemberCount(ctx context.Context, channelURL string, messageID int) (*GetUnreadMemberCountResponse, error)
+	// MarkAsDelivered marks all messages in a group channel as delivered for a
+	// specific user.
+	// See https://sendbird.com/docs/chat/platform-api/v3/message/delivery-receipts/mark-messages-as-delivered
+	MarkAsDelivered(ctx context.Context, channelURL, userID string) (*MarkAsDeliveredResponse, error)
+	// ViewNumberOfUndeliveredMembers retrieves the number of members of a group
+	// channel who haven't received a specific message yet.
+	// See https://sendbird.com/docs/chat/platform-api/v3/message/delivery-receipts/delivery-receipts-overview
+	ViewNumberOfUndeliveredMembers(ctx context.Context, channelURL string, messageID int) (*ViewNumberOfUndeliveredMembersResponse, error)
 	// StartTyping start showing a typing indicator for the channel. This feature
 	// is only applicable to group channels.
 	// When there are 100 or more members in a group channel, typing indicator
@@ -36,6 +57,10 @@ type Channel interface {
 	// sends a message or completely deletes the message text.
 	// See https://docs.sendbird.com/docs/chat/platform-api/v3/channel/managing-typing-indicators/stop-typing-indicators
 	StopTyping(ctx context.Context, channelURL string, userIDs []string) error
+	// ResetChatHistory resets the chat history of a user in a group channel, or
+	// of every member of the channel.
+	// See https://sendbird.com/docs/chat/platform-api/v3/channel/managing-a-channel/reset-chat-history
+	ResetChatHistory(ctx context.Context, channelURL string, resetChatHistoryRequest ResetChatHistoryRequest) (*ResetChatHistoryResponse, error)
 }
 
 type channel struct {
@@ -0,0 +1,22 @@
+package channel
+
+import (
+	"github.com/yumi-ia/sendbird-go/pkg/utils/payload"
+)
+
+// EncodeData encodes v to JSON, to be used as the Data of the requests
+// creating or updating a channel.
+func EncodeData[T any](v T) (string, error) {
+	return payload.EncodeData(v)
+}
+
+// DecodeData decodes the JSON Data of a channel into a T. It returns an error
+// wrapping payload.ErrEmptyData if the channel has no data.
+func DecodeData[T any](channelResource ChannelResource) (T, error) {
+	return payload.DecodeData[T](channelResource.Data)
+}
+
+// DecodeMemberMetadata decodes the metadata of a member of a channel into a T.
+func DecodeMemberMetadata[T any](member Member) (T, error) {
+	return payload.DecodeMetadata[T](member.Metadata)
+}
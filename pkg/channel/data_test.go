@@ -0,0 +1,39 @@
+package channel
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yumi-ia/sendbird-go/pkg/utils/payload"
+)
+
+type topic struct {
+	Title string   `json:"title"`
+	Tags  []string `json:"tags"`
+}
+
+func TestData(t *testing.T) {
+	t.Parallel()
+
+	data, err := EncodeData(topic{Title: "Go", Tags: []string{"generics"}})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"title": "Go", "tags": ["generics"]}`, data)
+
+	tp, err := DecodeData[topic](ChannelResource{Data: data})
+	require.NoError(t, err)
+	assert.Equal(t, topic{Title: "Go", Tags: []string{"generics"}}, tp)
+
+	_, err = DecodeData[topic](ChannelResource{})
+	require.ErrorIs(t, err, payload.ErrEmptyData)
+}
+
+func TestDecodeMemberMetadata(t *testing.T) {
+	t.Parallel()
+
+	metadata, err := DecodeMemberMetadata[struct {
+		Team string `json:"team"`
+	}](Member{Metadata: map[string]interface{}{"team": "blue"}})
+	require.NoError(t, err)
+	assert.Equal(t, "blue", metadata.Team)
+}
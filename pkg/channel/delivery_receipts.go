@@ -0,0 +1,81 @@
+package channel
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// markAsDeliveredRequest is the request to mark all messages in a group
+// channel as delivered for a specific user.
+type markAsDeliveredRequest struct {
+	// UserID specifies the ID of the target user.
+	UserID string `json:"user_id"`
+}
+
+// MarkAsDeliveredResponse is the response to mark all messages in a group
+// channel as delivered.
+type MarkAsDeliveredResponse struct {
+	// TS is the timestamp of when the messages were marked as delivered, in Unix
+	// milliseconds.
+	TS int64 `json:"ts"`
+}
+
+// ViewNumberOfUndeliveredMembersResponse is the response to get the number of
+// members who haven't received a message.
+type ViewNumberOfUndeliveredMembersResponse struct {
+	// UndeliveredMemberCount is the number of members who haven't received the
+	// message yet.
+	UndeliveredMemberCount int
+}
+
+// MarkAsDelivered marks all messages in a group channel as delivered for a
+// specific user. This action is only applicable for users in a group channel.
+// See https://sendbird.com/docs/chat/platform-api/v3/message/delivery-receipts/mark-messages-as-delivered
+func (c *channel) MarkAsDelivered(ctx context.Context, channelURL, userID string) (*MarkAsDeliveredResponse, error) {
+	u, err := url.Parse(fmt.Sprintf("/group_channels/%s/messages/mark_as_delivered", channelURL))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse URL: %w", err)
+	}
+
+	req := markAsDeliveredRequest{
+		UserID: userID,
+	}
+
+	madr, err := c.client.Put(ctx, u.String(), req, &MarkAsDeliveredResponse{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to mark as delivered: %w", err)
+	}
+
+	markAsDeliveredResponse, ok := madr.(*MarkAsDeliveredResponse)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast body to MarkAsDeliveredResponse: %+v", madr)
+	}
+
+	return markAsDeliveredResponse, nil
+}
+
+// undeliveredCountResponse is the body of the response to get the number of
+// members who haven't received a message.
+type undeliveredCountResponse struct {
+	UndeliveredCount int `json:"undelivered_count"`
+}
+
+// ViewNumberOfUndeliveredMembers retrieves the number of members of a group
+// channel who haven't received a specific message yet.
+// See https://sendbird.com/docs/chat/platform-api/v3/message/delivery-receipts/view-number-of-undelivered-members
+func (c *channel) ViewNumberOfUndeliveredMembers(ctx context.Context, channelURL string, messageID int) (*ViewNumberOfUndeliveredMembersResponse, error) {
+	path := fmt.Sprintf("/group_channels/%s/messages/%d/undelivered_count", channelURL, messageID)
+
+	ucr, err := c.client.Get(ctx, path, nil, &undeliveredCountResponse{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to view number of undelivered members: %w", err)
+	}
+
+	undeliveredCount, ok := ucr.(*undeliveredCountResponse)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast body to undeliveredCountResponse: %+v", ucr)
+	}
+
+	return &ViewNumberOfUndeliveredMembersResponse{UndeliveredMemberCount: undeliveredCount.UndeliveredCount}, nil
+}
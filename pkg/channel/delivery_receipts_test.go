@@ -0,0 +1,39 @@
+package channel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yumi-ia/sendbird-go/pkg/client"
+)
+
+func TestMarkAsDelivered(t *testing.T) {
+	t.Parallel()
+
+	markAsDeliveredResponse := &MarkAsDeliveredResponse{TS: 42}
+
+	client := client.NewClientMock(t).
+		OnPut("/group_channels/channel-url/messages/mark_as_delivered", markAsDeliveredRequest{UserID: "user-id"}, &MarkAsDeliveredResponse{}).TypedReturns(markAsDeliveredResponse, nil).Once().
+		Parent
+	channel := NewChannel(client)
+
+	cur, err := channel.MarkAsDelivered(context.Background(), "channel-url", "user-id")
+	require.NoError(t, err)
+	assert.Equal(t, markAsDeliveredResponse, cur)
+}
+
+func TestViewNumberOfUndeliveredMembers(t *testing.T) {
+	t.Parallel()
+
+	client := client.NewClientMock(t).
+		OnGet("/group_channels/channel-url/messages/42/undelivered_count", nil, &undeliveredCountResponse{}).
+		TypedReturns(&undeliveredCountResponse{UndeliveredCount: 1}, nil).Once().
+		Parent
+	channel := NewChannel(client)
+
+	cur, err := channel.ViewNumberOfUndeliveredMembers(context.Background(), "channel-url", 42)
+	require.NoError(t, err)
+	assert.Equal(t, 1, cur.UndeliveredMemberCount)
+}
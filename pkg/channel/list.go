@@ -2,10 +2,12 @@ package channel
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
 
+	"github.com/yumi-ia/sendbird-go/pkg/client"
 	strconvSlice "github.com/yumi-ia/sendbird-go/pkg/utils/strconv"
 )
 
@@ -391,6 +393,46 @@ func listChannelRequestToMap(lcr ListGroupChannelRequest) map[string]string {
 // ListGroupChannels lists group channels.
 // See https://sendbird.com/docs/chat/platform-api/v3/channel/listing-channels-in-an-application/list-group-channels
 func (c *channel) ListGroupChannels(ctx context.Context, listChannelRequest ListGroupChannelRequest) (*ListGroupChannelResponse, error) {
+	lgcr, err := c.client.Get(ctx, listGroupChannelsPath(listChannelRequest), nil, &ListGroupChannelResponse{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list channel: %w", err)
+	}
+
+	listChannelResponse, ok := lgcr.(*ListGroupChannelResponse)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast body to ListGroupChannelResponse: %+v", lgcr)
+	}
+
+	return listChannelResponse, nil
+}
+
+// groupChannelsStream decodes the channels of a list response one by one.
+type groupChannelsStream struct {
+	fn   func(ChannelResource) error
+	next string
+}
+
+func (gcs *groupChannelsStream) DecodeStream(dec *json.Decoder) error {
+	return client.StreamArray(dec, "channels", gcs.fn, map[string]any{"next": &gcs.next})
+}
+
+// ListGroupChannelsStream lists group channels like ListGroupChannels, but
+// calls fn with each channel as soon as it is decoded from the response
+// instead of holding the whole page in memory. It returns the token of the
+// next page. Returning client.ErrStopStream from fn stops the listing without
+// error, the returned token is then empty unless it preceded the channels.
+// See https://sendbird.com/docs/chat/platform-api/v3/channel/listing-channels-in-an-application/list-group-channels
+func (c *channel) ListGroupChannelsStream(ctx context.Context, listChannelRequest ListGroupChannelRequest, fn func(ChannelResource) error) (string, error) {
+	stream := &groupChannelsStream{fn: fn}
+
+	if _, err := c.client.Get(ctx, listGroupChannelsPath(listChannelRequest), nil, stream); err != nil {
+		return "", fmt.Errorf("failed to list channel: %w", err)
+	}
+
+	return stream.next, nil
+}
+
+func listGroupChannelsPath(listChannelRequest ListGroupChannelRequest) string {
 	u := &url.URL{
 		Path: "/group_channels",
 	}
@@ -402,15 +444,5 @@ func (c *channel) ListGroupChannels(ctx context.Context, listChannelRequest List
 
 	u.RawQuery = query.Encode()
 
-	lgcr, err := c.client.Get(ctx, u.String(), nil, &ListGroupChannelResponse{})
-	if err != nil {
-		return nil, fmt.Errorf("failed to list channel: %w", err)
-	}
-
-	listChannelResponse, ok := lgcr.(*ListGroupChannelResponse)
-	if !ok {
-		return nil, fmt.Errorf("failed to cast body to ListGroupChannelResponse: %+v", lgcr)
-	}
-
-	return listChannelResponse, nil
+	return u.String()
 }
@@ -2,9 +2,12 @@ package channel
 
 import (
 	"context"
+	"encoding/json"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/yumi-ia/sendbird-go/pkg/client"
 )
@@ -117,3 +120,35 @@ func TestListGroupChannels(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, listChannelsResponse, cur)
 }
+
+func TestListGroupChannelsStream(t *testing.T) {
+	t.Parallel()
+
+	url := "/group_channels?limit=100&show_member=true"
+	body := `{"channels":[{"channel_url":"url1"},{"channel_url":"url2"}],"next":"next-token"}`
+
+	client := client.NewClientMock(t).
+		OnGetRaw(url, nil, mock.Anything).
+		ReturnsFn(func(_ string, _, resp any) (any, error) {
+			sd, ok := resp.(client.StreamDecoder)
+			require.True(t, ok)
+
+			return resp, sd.DecodeStream(json.NewDecoder(strings.NewReader(body)))
+		}).Once().
+		Parent
+	channel := NewChannel(client)
+
+	var urls []string
+
+	next, err := channel.ListGroupChannelsStream(context.Background(), ListGroupChannelRequest{
+		Limit:      ptr(100),
+		ShowMember: ptr(true),
+	}, func(cr ChannelResource) error {
+		urls = append(urls, cr.ChannelURL)
+
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"url1", "url2"}, urls)
+	assert.Equal(t, "next-token", next)
+}
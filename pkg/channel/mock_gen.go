@@ -113,14 +113,34 @@ func (_c *channelCreateGroupChannelCall) OnCreateGroupChannel(createChannelReque
 	return _c.Parent.OnCreateGroupChannel(createChannelRequest)
 }
 
+func (_c *channelCreateGroupChannelCall) OnGetReadStatus(channelURL string, userIDs []string) *channelGetReadStatusCall {
+	return _c.Parent.OnGetReadStatus(channelURL, userIDs)
+}
+
+func (_c *channelCreateGroupChannelCall) OnGetUnreadMemberCount(channelURL string, messageID int) *channelGetUnreadMemberCountCall {
+	return _c.Parent.OnGetUnreadMemberCount(channelURL, messageID)
+}
+
 func (_c *channelCreateGroupChannelCall) OnListGroupChannels(listChannelRequest ListGroupChannelRequest) *channelListGroupChannelsCall {
 	return _c.Parent.OnListGroupChannels(listChannelRequest)
 }
 
+func (_c *channelCreateGroupChannelCall) OnListGroupChannelsStream(listChannelRequest ListGroupChannelRequest, fn func(ChannelResource) error) *channelListGroupChannelsStreamCall {
+	return _c.Parent.OnListGroupChannelsStream(listChannelRequest, fn)
+}
+
+func (_c *channelCreateGroupChannelCall) OnMarkAsDelivered(channelURL string, userID string) *channelMarkAsDeliveredCall {
+	return _c.Parent.OnMarkAsDelivered(channelURL, userID)
+}
+
 func (_c *channelCreateGroupChannelCall) OnMarkAsRead(channelURL string, userID string) *channelMarkAsReadCall {
 	return _c.Parent.OnMarkAsRead(channelURL, userID)
 }
 
+func (_c *channelCreateGroupChannelCall) OnResetChatHistory(channelURL string, resetChatHistoryRequest ResetChatHistoryRequest) *channelResetChatHistoryCall {
+	return _c.Parent.OnResetChatHistory(channelURL, resetChatHistoryRequest)
+}
+
 func (_c *channelCreateGroupChannelCall) OnStartTyping(channelURL string, userIDs []string) *channelStartTypingCall {
 	return _c.Parent.OnStartTyping(channelURL, userIDs)
 }
@@ -133,18 +153,42 @@ func (_c *channelCreateGroupChannelCall) OnUpdateGroupChannel(channelURL string,
 	return _c.Parent.OnUpdateGroupChannel(channelURL, updateChannelRequest)
 }
 
+func (_c *channelCreateGroupChannelCall) OnViewNumberOfUndeliveredMembers(channelURL string, messageID int) *channelViewNumberOfUndeliveredMembersCall {
+	return _c.Parent.OnViewNumberOfUndeliveredMembers(channelURL, messageID)
+}
+
 func (_c *channelCreateGroupChannelCall) OnCreateGroupChannelRaw(createChannelRequest interface{}) *channelCreateGroupChannelCall {
 	return _c.Parent.OnCreateGroupChannelRaw(createChannelRequest)
 }
 
+func (_c *channelCreateGroupChannelCall) OnGetReadStatusRaw(channelURL interface{}, userIDs interface{}) *channelGetReadStatusCall {
+	return _c.Parent.OnGetReadStatusRaw(channelURL, userIDs)
+}
+
+func (_c *channelCreateGroupChannelCall) OnGetUnreadMemberCountRaw(channelURL interface{}, messageID interface{}) *channelGetUnreadMemberCountCall {
+	return _c.Parent.OnGetUnreadMemberCountRaw(channelURL, messageID)
+}
+
 func (_c *channelCreateGroupChannelCall) OnListGroupChannelsRaw(listChannelRequest interface{}) *channelListGroupChannelsCall {
 	return _c.Parent.OnListGroupChannelsRaw(listChannelRequest)
 }
 
+func (_c *channelCreateGroupChannelCall) OnListGroupChannelsStreamRaw(listChannelRequest interface{}, fn interface{}) *channelListGroupChannelsStreamCall {
+	return _c.Parent.OnListGroupChannelsStreamRaw(listChannelRequest, fn)
+}
+
+func (_c *channelCreateGroupChannelCall) OnMarkAsDeliveredRaw(channelURL interface{}, userID interface{}) *channelMarkAsDeliveredCall {
+	return _c.Parent.OnMarkAsDeliveredRaw(channelURL, userID)
+}
+
 func (_c *channelCreateGroupChannelCall) OnMarkAsReadRaw(channelURL interface{}, userID interface{}) *channelMarkAsReadCall {
 	return _c.Parent.OnMarkAsReadRaw(channelURL, userID)
 }
 
+func (_c *channelCreateGroupChannelCall) OnResetChatHistoryRaw(channelURL interface{}, resetChatHistoryRequest interface{}) *channelResetChatHistoryCall {
+	return _c.Parent.OnResetChatHistoryRaw(channelURL, resetChatHistoryRequest)
+}
+
 func (_c *channelCreateGroupChannelCall) OnStartTypingRaw(channelURL interface{}, userIDs interface{}) *channelStartTypingCall {
 	return _c.Parent.OnStartTypingRaw(channelURL, userIDs)
 }
@@ -157,270 +201,1275 @@ func (_c *channelCreateGroupChannelCall) OnUpdateGroupChannelRaw(channelURL inte
 	return _c.Parent.OnUpdateGroupChannelRaw(channelURL, updateChannelRequest)
 }
 
-func (_m *channelMock) ListGroupChannels(_ context.Context, listChannelRequest ListGroupChannelRequest) (*ListGroupChannelResponse, error) {
-	_ret := _m.Called(listChannelRequest)
+func (_c *channelCreateGroupChannelCall) OnViewNumberOfUndeliveredMembersRaw(channelURL interface{}, messageID interface{}) *channelViewNumberOfUndeliveredMembersCall {
+	return _c.Parent.OnViewNumberOfUndeliveredMembersRaw(channelURL, messageID)
+}
 
-	if _rf, ok := _ret.Get(0).(func(ListGroupChannelRequest) (*ListGroupChannelResponse, error)); ok {
-		return _rf(listChannelRequest)
+func (_m *channelMock) GetReadStatus(_ context.Context, channelURL string, userIDs []string) (*GetReadStatusResponse, error) {
+	_ret := _m.Called(channelURL, userIDs)
+
+	if _rf, ok := _ret.Get(0).(func(string, []string) (*GetReadStatusResponse, error)); ok {
+		return _rf(channelURL, userIDs)
 	}
 
-	_ra0, _ := _ret.Get(0).(*ListGroupChannelResponse)
+	_ra0, _ := _ret.Get(0).(*GetReadStatusResponse)
 	_rb1 := _ret.Error(1)
 
 	return _ra0, _rb1
 }
 
-func (_m *channelMock) OnListGroupChannels(listChannelRequest ListGroupChannelRequest) *channelListGroupChannelsCall {
-	return &channelListGroupChannelsCall{Call: _m.Mock.On("ListGroupChannels", listChannelRequest), Parent: _m}
+func (_m *channelMock) OnGetReadStatus(channelURL string, userIDs []string) *channelGetReadStatusCall {
+	return &channelGetReadStatusCall{Call: _m.Mock.On("GetReadStatus", channelURL, userIDs), Parent: _m}
 }
 
-func (_m *channelMock) OnListGroupChannelsRaw(listChannelRequest interface{}) *channelListGroupChannelsCall {
-	return &channelListGroupChannelsCall{Call: _m.Mock.On("ListGroupChannels", listChannelRequest), Parent: _m}
+func (_m *channelMock) OnGetReadStatusRaw(channelURL interface{}, userIDs interface{}) *channelGetReadStatusCall {
+	return &channelGetReadStatusCall{Call: _m.Mock.On("GetReadStatus", channelURL, userIDs), Parent: _m}
 }
 
-type channelListGroupChannelsCall struct {
+type channelGetReadStatusCall struct {
 	*mock.Call
 	Parent *channelMock
 }
 
-func (_c *channelListGroupChannelsCall) Panic(msg string) *channelListGroupChannelsCall {
+func (_c *channelGetReadStatusCall) Panic(msg string) *channelGetReadStatusCall {
 	_c.Call = _c.Call.Panic(msg)
 	return _c
 }
 
-func (_c *channelListGroupChannelsCall) Once() *channelListGroupChannelsCall {
+func (_c *channelGetReadStatusCall) Once() *channelGetReadStatusCall {
 	_c.Call = _c.Call.Once()
 	return _c
 }
 
-func (_c *channelListGroupChannelsCall) Twice() *channelListGroupChannelsCall {
+func (_c *channelGetReadStatusCall) Twice() *channelGetReadStatusCall {
 	_c.Call = _c.Call.Twice()
 	return _c
 }
 
-func (_c *channelListGroupChannelsCall) Times(i int) *channelListGroupChannelsCall {
+func (_c *channelGetReadStatusCall) Times(i int) *channelGetReadStatusCall {
 	_c.Call = _c.Call.Times(i)
 	return _c
 }
 
-func (_c *channelListGroupChannelsCall) WaitUntil(w <-chan time.Time) *channelListGroupChannelsCall {
+func (_c *channelGetReadStatusCall) WaitUntil(w <-chan time.Time) *channelGetReadStatusCall {
 	_c.Call = _c.Call.WaitUntil(w)
 	return _c
 }
 
-func (_c *channelListGroupChannelsCall) After(d time.Duration) *channelListGroupChannelsCall {
+func (_c *channelGetReadStatusCall) After(d time.Duration) *channelGetReadStatusCall {
 	_c.Call = _c.Call.After(d)
 	return _c
 }
 
-func (_c *channelListGroupChannelsCall) Run(fn func(args mock.Arguments)) *channelListGroupChannelsCall {
+func (_c *channelGetReadStatusCall) Run(fn func(args mock.Arguments)) *channelGetReadStatusCall {
 	_c.Call = _c.Call.Run(fn)
 	return _c
 }
 
-func (_c *channelListGroupChannelsCall) Maybe() *channelListGroupChannelsCall {
+func (_c *channelGetReadStatusCall) Maybe() *channelGetReadStatusCall {
 	_c.Call = _c.Call.Maybe()
 	return _c
 }
 
-func (_c *channelListGroupChannelsCall) TypedReturns(a *ListGroupChannelResponse, b error) *channelListGroupChannelsCall {
+func (_c *channelGetReadStatusCall) TypedReturns(a *GetReadStatusResponse, b error) *channelGetReadStatusCall {
 	_c.Call = _c.Return(a, b)
 	return _c
 }
 
-func (_c *channelListGroupChannelsCall) ReturnsFn(fn func(ListGroupChannelRequest) (*ListGroupChannelResponse, error)) *channelListGroupChannelsCall {
+func (_c *channelGetReadStatusCall) ReturnsFn(fn func(string, []string) (*GetReadStatusResponse, error)) *channelGetReadStatusCall {
 	_c.Call = _c.Return(fn)
 	return _c
 }
 
-func (_c *channelListGroupChannelsCall) TypedRun(fn func(ListGroupChannelRequest)) *channelListGroupChannelsCall {
+func (_c *channelGetReadStatusCall) TypedRun(fn func(string, []string)) *channelGetReadStatusCall {
 	_c.Call = _c.Call.Run(func(args mock.Arguments) {
-		_listChannelRequest, _ := args.Get(0).(ListGroupChannelRequest)
-		fn(_listChannelRequest)
+		_channelURL := args.String(0)
+		_userIDs, _ := args.Get(1).([]string)
+		fn(_channelURL, _userIDs)
 	})
 	return _c
 }
 
-func (_c *channelListGroupChannelsCall) OnCreateGroupChannel(createChannelRequest CreateGroupChannelRequest) *channelCreateGroupChannelCall {
+func (_c *channelGetReadStatusCall) OnCreateGroupChannel(createChannelRequest CreateGroupChannelRequest) *channelCreateGroupChannelCall {
 	return _c.Parent.OnCreateGroupChannel(createChannelRequest)
 }
 
-func (_c *channelListGroupChannelsCall) OnListGroupChannels(listChannelRequest ListGroupChannelRequest) *channelListGroupChannelsCall {
+func (_c *channelGetReadStatusCall) OnGetReadStatus(channelURL string, userIDs []string) *channelGetReadStatusCall {
+	return _c.Parent.OnGetReadStatus(channelURL, userIDs)
+}
+
+func (_c *channelGetReadStatusCall) OnGetUnreadMemberCount(channelURL string, messageID int) *channelGetUnreadMemberCountCall {
+	return _c.Parent.OnGetUnreadMemberCount(channelURL, messageID)
+}
+
+func (_c *channelGetReadStatusCall) OnListGroupChannels(listChannelRequest ListGroupChannelRequest) *channelListGroupChannelsCall {
 	return _c.Parent.OnListGroupChannels(listChannelRequest)
 }
 
-func (_c *channelListGroupChannelsCall) OnMarkAsRead(channelURL string, userID string) *channelMarkAsReadCall {
+func (_c *channelGetReadStatusCall) OnListGroupChannelsStream(listChannelRequest ListGroupChannelRequest, fn func(ChannelResource) error) *channelListGroupChannelsStreamCall {
+	return _c.Parent.OnListGroupChannelsStream(listChannelRequest, fn)
+}
+
+func (_c *channelGetReadStatusCall) OnMarkAsDelivered(channelURL string, userID string) *channelMarkAsDeliveredCall {
+	return _c.Parent.OnMarkAsDelivered(channelURL, userID)
+}
+
+func (_c *channelGetReadStatusCall) OnMarkAsRead(channelURL string, userID string) *channelMarkAsReadCall {
 	return _c.Parent.OnMarkAsRead(channelURL, userID)
 }
 
-func (_c *channelListGroupChannelsCall) OnStartTyping(channelURL string, userIDs []string) *channelStartTypingCall {
+func (_c *channelGetReadStatusCall) OnResetChatHistory(channelURL string, resetChatHistoryRequest ResetChatHistoryRequest) *channelResetChatHistoryCall {
+	return _c.Parent.OnResetChatHistory(channelURL, resetChatHistoryRequest)
+}
+
+func (_c *channelGetReadStatusCall) OnStartTyping(channelURL string, userIDs []string) *channelStartTypingCall {
 	return _c.Parent.OnStartTyping(channelURL, userIDs)
 }
 
-func (_c *channelListGroupChannelsCall) OnStopTyping(channelURL string, userIDs []string) *channelStopTypingCall {
+func (_c *channelGetReadStatusCall) OnStopTyping(channelURL string, userIDs []string) *channelStopTypingCall {
 	return _c.Parent.OnStopTyping(channelURL, userIDs)
 }
 
-func (_c *channelListGroupChannelsCall) OnUpdateGroupChannel(channelURL string, updateChannelRequest UpdateGroupChannelRequest) *channelUpdateGroupChannelCall {
+func (_c *channelGetReadStatusCall) OnUpdateGroupChannel(channelURL string, updateChannelRequest UpdateGroupChannelRequest) *channelUpdateGroupChannelCall {
 	return _c.Parent.OnUpdateGroupChannel(channelURL, updateChannelRequest)
 }
 
-func (_c *channelListGroupChannelsCall) OnCreateGroupChannelRaw(createChannelRequest interface{}) *channelCreateGroupChannelCall {
+func (_c *channelGetReadStatusCall) OnViewNumberOfUndeliveredMembers(channelURL string, messageID int) *channelViewNumberOfUndeliveredMembersCall {
+	return _c.Parent.OnViewNumberOfUndeliveredMembers(channelURL, messageID)
+}
+
+func (_c *channelGetReadStatusCall) OnCreateGroupChannelRaw(createChannelRequest interface{}) *channelCreateGroupChannelCall {
 	return _c.Parent.OnCreateGroupChannelRaw(createChannelRequest)
 }
 
-func (_c *channelListGroupChannelsCall) OnListGroupChannelsRaw(listChannelRequest interface{}) *channelListGroupChannelsCall {
+func (_c *channelGetReadStatusCall) OnGetReadStatusRaw(channelURL interface{}, userIDs interface{}) *channelGetReadStatusCall {
+	return _c.Parent.OnGetReadStatusRaw(channelURL, userIDs)
+}
+
+func (_c *channelGetReadStatusCall) OnGetUnreadMemberCountRaw(channelURL interface{}, messageID interface{}) *channelGetUnreadMemberCountCall {
+	return _c.Parent.OnGetUnreadMemberCountRaw(channelURL, messageID)
+}
+
+func (_c *channelGetReadStatusCall) OnListGroupChannelsRaw(listChannelRequest interface{}) *channelListGroupChannelsCall {
 	return _c.Parent.OnListGroupChannelsRaw(listChannelRequest)
 }
 
-func (_c *channelListGroupChannelsCall) OnMarkAsReadRaw(channelURL interface{}, userID interface{}) *channelMarkAsReadCall {
+func (_c *channelGetReadStatusCall) OnListGroupChannelsStreamRaw(listChannelRequest interface{}, fn interface{}) *channelListGroupChannelsStreamCall {
+	return _c.Parent.OnListGroupChannelsStreamRaw(listChannelRequest, fn)
+}
+
+func (_c *channelGetReadStatusCall) OnMarkAsDeliveredRaw(channelURL interface{}, userID interface{}) *channelMarkAsDeliveredCall {
+	return _c.Parent.OnMarkAsDeliveredRaw(channelURL, userID)
+}
+
+func (_c *channelGetReadStatusCall) OnMarkAsReadRaw(channelURL interface{}, userID interface{}) *channelMarkAsReadCall {
 	return _c.Parent.OnMarkAsReadRaw(channelURL, userID)
 }
 
-func (_c *channelListGroupChannelsCall) OnStartTypingRaw(channelURL interface{}, userIDs interface{}) *channelStartTypingCall {
+func (_c *channelGetReadStatusCall) OnResetChatHistoryRaw(channelURL interface{}, resetChatHistoryRequest interface{}) *channelResetChatHistoryCall {
+	return _c.Parent.OnResetChatHistoryRaw(channelURL, resetChatHistoryRequest)
+}
+
+func (_c *channelGetReadStatusCall) OnStartTypingRaw(channelURL interface{}, userIDs interface{}) *channelStartTypingCall {
 	return _c.Parent.OnStartTypingRaw(channelURL, userIDs)
 }
 
-func (_c *channelListGroupChannelsCall) OnStopTypingRaw(channelURL interface{}, userIDs interface{}) *channelStopTypingCall {
+func (_c *channelGetReadStatusCall) OnStopTypingRaw(channelURL interface{}, userIDs interface{}) *channelStopTypingCall {
 	return _c.Parent.OnStopTypingRaw(channelURL, userIDs)
 }
 
-func (_c *channelListGroupChannelsCall) OnUpdateGroupChannelRaw(channelURL interface{}, updateChannelRequest interface{}) *channelUpdateGroupChannelCall {
+func (_c *channelGetReadStatusCall) OnUpdateGroupChannelRaw(channelURL interface{}, updateChannelRequest interface{}) *channelUpdateGroupChannelCall {
 	return _c.Parent.OnUpdateGroupChannelRaw(channelURL, updateChannelRequest)
 }
 
-func (_m *channelMock) MarkAsRead(_ context.Context, channelURL string, userID string) error {
-	_ret := _m.Called(channelURL, userID)
+func (_c *channelGetReadStatusCall) OnViewNumberOfUndeliveredMembersRaw(channelURL interface{}, messageID interface{}) *channelViewNumberOfUndeliveredMembersCall {
+	return _c.Parent.OnViewNumberOfUndeliveredMembersRaw(channelURL, messageID)
+}
+
+func (_m *channelMock) GetUnreadMemberCount(_ context.Context, channelURL string, messageID int) (*GetUnreadMemberCountResponse, error) {
+	_ret := _m.Called(channelURL, messageID)
+
+	if _rf, ok := _ret.Get(0).(func(string, int) (*GetUnreadMemberCountResponse, error)); ok {
+		return _rf(channelURL, messageID)
+	}
+
+	_ra0, _ := _ret.Get(0).(*GetUnreadMemberCountResponse)
+	_rb1 := _ret.Error(1)
+
+	return _ra0, _rb1
+}
+
+func (_m *channelMock) OnGetUnreadMemberCount(channelURL string, messageID int) *channelGetUnreadMemberCountCall {
+	return &channelGetUnreadMemberCountCall{Call: _m.Mock.On("GetUnreadMemberCount", channelURL, messageID), Parent: _m}
+}
+
+func (_m *channelMock) OnGetUnreadMemberCountRaw(channelURL interface{}, messageID interface{}) *channelGetUnreadMemberCountCall {
+	return &channelGetUnreadMemberCountCall{Call: _m.Mock.On("GetUnreadMemberCount", channelURL, messageID), Parent: _m}
+}
+
+type channelGetUnreadMemberCountCall struct {
+	*mock.Call
+	Parent *channelMock
+}
+
+func (_c *channelGetUnreadMemberCountCall) Panic(msg string) *channelGetUnreadMemberCountCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *channelGetUnreadMemberCountCall) Once() *channelGetUnreadMemberCountCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *channelGetUnreadMemberCountCall) Twice() *channelGetUnreadMemberCountCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *channelGetUnreadMemberCountCall) Times(i int) *channelGetUnreadMemberCountCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *channelGetUnreadMemberCountCall) WaitUntil(w <-chan time.Time) *channelGetUnreadMemberCountCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *channelGetUnreadMemberCountCall) After(d time.Duration) *channelGetUnreadMemberCountCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *channelGetUnreadMemberCountCall) Run(fn func(args mock.Arguments)) *channelGetUnreadMemberCountCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *channelGetUnreadMemberCountCall) Maybe() *channelGetUnreadMemberCountCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *channelGetUnreadMemberCountCall) TypedReturns(a *GetUnreadMemberCountResponse, b error) *channelGetUnreadMemberCountCall {
+	_c.Call = _c.Return(a, b)
+	return _c
+}
+
+func (_c *channelGetUnreadMemberCountCall) ReturnsFn(fn func(string, int) (*GetUnreadMemberCountResponse, error)) *channelGetUnreadMemberCountCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *channelGetUnreadMemberCountCall) TypedRun(fn func(string, int)) *channelGetUnreadMemberCountCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_channelURL := args.String(0)
+		_messageID := args.Int(1)
+		fn(_channelURL, _messageID)
+	})
+	return _c
+}
+
+func (_c *channelGetUnreadMemberCountCall) OnCreateGroupChannel(createChannelRequest CreateGroupChannelRequest) *channelCreateGroupChannelCall {
+	return _c.Parent.OnCreateGroupChannel(createChannelRequest)
+}
+
+func (_c *channelGetUnreadMemberCountCall) OnGetReadStatus(channelURL string, userIDs []string) *channelGetReadStatusCall {
+	return _c.Parent.OnGetReadStatus(channelURL, userIDs)
+}
+
+func (_c *channelGetUnreadMemberCountCall) OnGetUnreadMemberCount(channelURL string, messageID int) *channelGetUnreadMemberCountCall {
+	return _c.Parent.OnGetUnreadMemberCount(channelURL, messageID)
+}
+
+func (_c *channelGetUnreadMemberCountCall) OnListGroupChannels(listChannelRequest ListGroupChannelRequest) *channelListGroupChannelsCall {
+	return _c.Parent.OnListGroupChannels(listChannelRequest)
+}
+
+func (_c *channelGetUnreadMemberCountCall) OnListGroupChannelsStream(listChannelRequest ListGroupChannelRequest, fn func(ChannelResource) error) *channelListGroupChannelsStreamCall {
+	return _c.Parent.OnListGroupChannelsStream(listChannelRequest, fn)
+}
+
+func (_c *channelGetUnreadMemberCountCall) OnMarkAsDelivered(channelURL string, userID string) *channelMarkAsDeliveredCall {
+	return _c.Parent.OnMarkAsDelivered(channelURL, userID)
+}
+
+func (_c *channelGetUnreadMemberCountCall) OnMarkAsRead(channelURL string, userID string) *channelMarkAsReadCall {
+	return _c.Parent.OnMarkAsRead(channelURL, userID)
+}
+
+func (_c *channelGetUnreadMemberCountCall) OnResetChatHistory(channelURL string, resetChatHistoryRequest ResetChatHistoryRequest) *channelResetChatHistoryCall {
+	return _c.Parent.OnResetChatHistory(channelURL, resetChatHistoryRequest)
+}
+
+func (_c *channelGetUnreadMemberCountCall) OnStartTyping(channelURL string, userIDs []string) *channelStartTypingCall {
+	return _c.Parent.OnStartTyping(channelURL, userIDs)
+}
+
+func (_c *channelGetUnreadMemberCountCall) OnStopTyping(channelURL string, userIDs []string) *channelStopTypingCall {
+	return _c.Parent.OnStopTyping(channelURL, userIDs)
+}
+
+func (_c *channelGetUnreadMemberCountCall) OnUpdateGroupChannel(channelURL string, updateChannelRequest UpdateGroupChannelRequest) *channelUpdateGroupChannelCall {
+	return _c.Parent.OnUpdateGroupChannel(channelURL, updateChannelRequest)
+}
+
+func (_c *channelGetUnreadMemberCountCall) OnViewNumberOfUndeliveredMembers(channelURL string, messageID int) *channelViewNumberOfUndeliveredMembersCall {
+	return _c.Parent.OnViewNumberOfUndeliveredMembers(channelURL, messageID)
+}
+
+func (_c *channelGetUnreadMemberCountCall) OnCreateGroupChannelRaw(createChannelRequest interface{}) *channelCreateGroupChannelCall {
+	return _c.Parent.OnCreateGroupChannelRaw(createChannelRequest)
+}
+
+func (_c *channelGetUnreadMemberCountCall) OnGetReadStatusRaw(channelURL interface{}, userIDs interface{}) *channelGetReadStatusCall {
+	return _c.Parent.OnGetReadStatusRaw(channelURL, userIDs)
+}
+
+func (_c *channelGetUnreadMemberCountCall) OnGetUnreadMemberCountRaw(channelURL interface{}, messageID interface{}) *channelGetUnreadMemberCountCall {
+	return _c.Parent.OnGetUnreadMemberCountRaw(channelURL, messageID)
+}
+
+func (_c *channelGetUnreadMemberCountCall) OnListGroupChannelsRaw(listChannelRequest interface{}) *channelListGroupChannelsCall {
+	return _c.Parent.OnListGroupChannelsRaw(listChannelRequest)
+}
+
+func (_c *channelGetUnreadMemberCountCall) OnListGroupChannelsStreamRaw(listChannelRequest interface{}, fn interface{}) *channelListGroupChannelsStreamCall {
+	return _c.Parent.OnListGroupChannelsStreamRaw(listChannelRequest, fn)
+}
+
+func (_c *channelGetUnreadMemberCountCall) OnMarkAsDeliveredRaw(channelURL interface{}, userID interface{}) *channelMarkAsDeliveredCall {
+	return _c.Parent.OnMarkAsDeliveredRaw(channelURL, userID)
+}
+
+func (_c *channelGetUnreadMemberCountCall) OnMarkAsReadRaw(channelURL interface{}, userID interface{}) *channelMarkAsReadCall {
+	return _c.Parent.OnMarkAsReadRaw(channelURL, userID)
+}
+
+func (_c *channelGetUnreadMemberCountCall) OnResetChatHistoryRaw(channelURL interface{}, resetChatHistoryRequest interface{}) *channelResetChatHistoryCall {
+	return _c.Parent.OnResetChatHistoryRaw(channelURL, resetChatHistoryRequest)
+}
+
+func (_c *channelGetUnreadMemberCountCall) OnStartTypingRaw(channelURL interface{}, userIDs interface{}) *channelStartTypingCall {
+	return _c.Parent.OnStartTypingRaw(channelURL, userIDs)
+}
+
+func (_c *channelGetUnreadMemberCountCall) OnStopTypingRaw(channelURL interface{}, userIDs interface{}) *channelStopTypingCall {
+	return _c.Parent.OnStopTypingRaw(channelURL, userIDs)
+}
+
+func (_c *channelGetUnreadMemberCountCall) OnUpdateGroupChannelRaw(channelURL interface{}, updateChannelRequest interface{}) *channelUpdateGroupChannelCall {
+	return _c.Parent.OnUpdateGroupChannelRaw(channelURL, updateChannelRequest)
+}
+
+func (_c *channelGetUnreadMemberCountCall) OnViewNumberOfUndeliveredMembersRaw(channelURL interface{}, messageID interface{}) *channelViewNumberOfUndeliveredMembersCall {
+	return _c.Parent.OnViewNumberOfUndeliveredMembersRaw(channelURL, messageID)
+}
+
+func (_m *channelMock) ListGroupChannels(_ context.Context, listChannelRequest ListGroupChannelRequest) (*ListGroupChannelResponse, error) {
+	_ret := _m.Called(listChannelRequest)
+
+	if _rf, ok := _ret.Get(0).(func(ListGroupChannelRequest) (*ListGroupChannelResponse, error)); ok {
+		return _rf(listChannelRequest)
+	}
+
+	_ra0, _ := _ret.Get(0).(*ListGroupChannelResponse)
+	_rb1 := _ret.Error(1)
+
+	return _ra0, _rb1
+}
+
+func (_m *channelMock) OnListGroupChannels(listChannelRequest ListGroupChannelRequest) *channelListGroupChannelsCall {
+	return &channelListGroupChannelsCall{Call: _m.Mock.On("ListGroupChannels", listChannelRequest), Parent: _m}
+}
+
+func (_m *channelMock) OnListGroupChannelsRaw(listChannelRequest interface{}) *channelListGroupChannelsCall {
+	return &channelListGroupChannelsCall{Call: _m.Mock.On("ListGroupChannels", listChannelRequest), Parent: _m}
+}
+
+type channelListGroupChannelsCall struct {
+	*mock.Call
+	Parent *channelMock
+}
+
+func (_c *channelListGroupChannelsCall) Panic(msg string) *channelListGroupChannelsCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *channelListGroupChannelsCall) Once() *channelListGroupChannelsCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *channelListGroupChannelsCall) Twice() *channelListGroupChannelsCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *channelListGroupChannelsCall) Times(i int) *channelListGroupChannelsCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *channelListGroupChannelsCall) WaitUntil(w <-chan time.Time) *channelListGroupChannelsCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *channelListGroupChannelsCall) After(d time.Duration) *channelListGroupChannelsCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *channelListGroupChannelsCall) Run(fn func(args mock.Arguments)) *channelListGroupChannelsCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *channelListGroupChannelsCall) Maybe() *channelListGroupChannelsCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *channelListGroupChannelsCall) TypedReturns(a *ListGroupChannelResponse, b error) *channelListGroupChannelsCall {
+	_c.Call = _c.Return(a, b)
+	return _c
+}
+
+func (_c *channelListGroupChannelsCall) ReturnsFn(fn func(ListGroupChannelRequest) (*ListGroupChannelResponse, error)) *channelListGroupChannelsCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *channelListGroupChannelsCall) TypedRun(fn func(ListGroupChannelRequest)) *channelListGroupChannelsCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_listChannelRequest, _ := args.Get(0).(ListGroupChannelRequest)
+		fn(_listChannelRequest)
+	})
+	return _c
+}
+
+func (_c *channelListGroupChannelsCall) OnCreateGroupChannel(createChannelRequest CreateGroupChannelRequest) *channelCreateGroupChannelCall {
+	return _c.Parent.OnCreateGroupChannel(createChannelRequest)
+}
+
+func (_c *channelListGroupChannelsCall) OnGetReadStatus(channelURL string, userIDs []string) *channelGetReadStatusCall {
+	return _c.Parent.OnGetReadStatus(channelURL, userIDs)
+}
+
+func (_c *channelListGroupChannelsCall) OnGetUnreadMemberCount(channelURL string, messageID int) *channelGetUnreadMemberCountCall {
+	return _c.Parent.OnGetUnreadMemberCount(channelURL, messageID)
+}
+
+func (_c *channelListGroupChannelsCall) OnListGroupChannels(listChannelRequest ListGroupChannelRequest) *channelListGroupChannelsCall {
+	return _c.Parent.OnListGroupChannels(listChannelRequest)
+}
+
+func (_c *channelListGroupChannelsCall) OnListGroupChannelsStream(listChannelRequest ListGroupChannelRequest, fn func(ChannelResource) error) *channelListGroupChannelsStreamCall {
+	return _c.Parent.OnListGroupChannelsStream(listChannelRequest, fn)
+}
+
+func (_c *channelListGroupChannelsCall) OnMarkAsDelivered(channelURL string, userID string) *channelMarkAsDeliveredCall {
+	return _c.Parent.OnMarkAsDelivered(channelURL, userID)
+}
+
+func (_c *channelListGroupChannelsCall) OnMarkAsRead(channelURL string, userID string) *channelMarkAsReadCall {
+	return _c.Parent.OnMarkAsRead(channelURL, userID)
+}
+
+func (_c *channelListGroupChannelsCall) OnResetChatHistory(channelURL string, resetChatHistoryRequest ResetChatHistoryRequest) *channelResetChatHistoryCall {
+	return _c.Parent.OnResetChatHistory(channelURL, resetChatHistoryRequest)
+}
+
+func (_c *channelListGroupChannelsCall) OnStartTyping(channelURL string, userIDs []string) *channelStartTypingCall {
+	return _c.Parent.OnStartTyping(channelURL, userIDs)
+}
+
+func (_c *channelListGroupChannelsCall) OnStopTyping(channelURL string, userIDs []string) *channelStopTypingCall {
+	return _c.Parent.OnStopTyping(channelURL, userIDs)
+}
+
+func (_c *channelListGroupChannelsCall) OnUpdateGroupChannel(channelURL string, updateChannelRequest UpdateGroupChannelRequest) *channelUpdateGroupChannelCall {
+	return _c.Parent.OnUpdateGroupChannel(channelURL, updateChannelRequest)
+}
+
+func (_c *channelListGroupChannelsCall) OnViewNumberOfUndeliveredMembers(channelURL string, messageID int) *channelViewNumberOfUndeliveredMembersCall {
+	return _c.Parent.OnViewNumberOfUndeliveredMembers(channelURL, messageID)
+}
+
+func (_c *channelListGroupChannelsCall) OnCreateGroupChannelRaw(createChannelRequest interface{}) *channelCreateGroupChannelCall {
+	return _c.Parent.OnCreateGroupChannelRaw(createChannelRequest)
+}
+
+func (_c *channelListGroupChannelsCall) OnGetReadStatusRaw(channelURL interface{}, userIDs interface{}) *channelGetReadStatusCall {
+	return _c.Parent.OnGetReadStatusRaw(channelURL, userIDs)
+}
+
+func (_c *channelListGroupChannelsCall) OnGetUnreadMemberCountRaw(channelURL interface{}, messageID interface{}) *channelGetUnreadMemberCountCall {
+	return _c.Parent.OnGetUnreadMemberCountRaw(channelURL, messageID)
+}
+
+func (_c *channelListGroupChannelsCall) OnListGroupChannelsRaw(listChannelRequest interface{}) *channelListGroupChannelsCall {
+	return _c.Parent.OnListGroupChannelsRaw(listChannelRequest)
+}
+
+func (_c *channelListGroupChannelsCall) OnListGroupChannelsStreamRaw(listChannelRequest interface{}, fn interface{}) *channelListGroupChannelsStreamCall {
+	return _c.Parent.OnListGroupChannelsStreamRaw(listChannelRequest, fn)
+}
+
+func (_c *channelListGroupChannelsCall) OnMarkAsDeliveredRaw(channelURL interface{}, userID interface{}) *channelMarkAsDeliveredCall {
+	return _c.Parent.OnMarkAsDeliveredRaw(channelURL, userID)
+}
+
+func (_c *channelListGroupChannelsCall) OnMarkAsReadRaw(channelURL interface{}, userID interface{}) *channelMarkAsReadCall {
+	return _c.Parent.OnMarkAsReadRaw(channelURL, userID)
+}
+
+func (_c *channelListGroupChannelsCall) OnResetChatHistoryRaw(channelURL interface{}, resetChatHistoryRequest interface{}) *channelResetChatHistoryCall {
+	return _c.Parent.OnResetChatHistoryRaw(channelURL, resetChatHistoryRequest)
+}
+
+func (_c *channelListGroupChannelsCall) OnStartTypingRaw(channelURL interface{}, userIDs interface{}) *channelStartTypingCall {
+	return _c.Parent.OnStartTypingRaw(channelURL, userIDs)
+}
+
+func (_c *channelListGroupChannelsCall) OnStopTypingRaw(channelURL interface{}, userIDs interface{}) *channelStopTypingCall {
+	return _c.Parent.OnStopTypingRaw(channelURL, userIDs)
+}
+
+func (_c *channelListGroupChannelsCall) OnUpdateGroupChannelRaw(channelURL interface{}, updateChannelRequest interface{}) *channelUpdateGroupChannelCall {
+	return _c.Parent.OnUpdateGroupChannelRaw(channelURL, updateChannelRequest)
+}
+
+func (_c *channelListGroupChannelsCall) OnViewNumberOfUndeliveredMembersRaw(channelURL interface{}, messageID interface{}) *channelViewNumberOfUndeliveredMembersCall {
+	return _c.Parent.OnViewNumberOfUndeliveredMembersRaw(channelURL, messageID)
+}
+
+func (_m *channelMock) ListGroupChannelsStream(_ context.Context, listChannelRequest ListGroupChannelRequest, fn func(ChannelResource) error) (string, error) {
+	_ret := _m.Called(listChannelRequest, fn)
+
+	if _rf, ok := _ret.Get(0).(func(ListGroupChannelRequest, func(ChannelResource) error) (string, error)); ok {
+		return _rf(listChannelRequest, fn)
+	}
+
+	_ra0 := _ret.String(0)
+	_rb1 := _ret.Error(1)
+
+	return _ra0, _rb1
+}
+
+func (_m *channelMock) OnListGroupChannelsStream(listChannelRequest ListGroupChannelRequest, fn func(ChannelResource) error) *channelListGroupChannelsStreamCall {
+	return &channelListGroupChannelsStreamCall{Call: _m.Mock.On("ListGroupChannelsStream", listChannelRequest, fn), Parent: _m}
+}
+
+func (_m *channelMock) OnListGroupChannelsStreamRaw(listChannelRequest interface{}, fn interface{}) *channelListGroupChannelsStreamCall {
+	return &channelListGroupChannelsStreamCall{Call: _m.Mock.On("ListGroupChannelsStream", listChannelRequest, fn), Parent: _m}
+}
+
+type channelListGroupChannelsStreamCall struct {
+	*mock.Call
+	Parent *channelMock
+}
+
+func (_c *channelListGroupChannelsStreamCall) Panic(msg string) *channelListGroupChannelsStreamCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *channelListGroupChannelsStreamCall) Once() *channelListGroupChannelsStreamCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *channelListGroupChannelsStreamCall) Twice() *channelListGroupChannelsStreamCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *channelListGroupChannelsStreamCall) Times(i int) *channelListGroupChannelsStreamCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *channelListGroupChannelsStreamCall) WaitUntil(w <-chan time.Time) *channelListGroupChannelsStreamCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *channelListGroupChannelsStreamCall) After(d time.Duration) *channelListGroupChannelsStreamCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *channelListGroupChannelsStreamCall) Run(fn func(args mock.Arguments)) *channelListGroupChannelsStreamCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *channelListGroupChannelsStreamCall) Maybe() *channelListGroupChannelsStreamCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *channelListGroupChannelsStreamCall) TypedReturns(a string, b error) *channelListGroupChannelsStreamCall {
+	_c.Call = _c.Return(a, b)
+	return _c
+}
+
+func (_c *channelListGroupChannelsStreamCall) ReturnsFn(fn func(ListGroupChannelRequest, func(ChannelResource) error) (string, error)) *channelListGroupChannelsStreamCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *channelListGroupChannelsStreamCall) TypedRun(fn func(ListGroupChannelRequest, func(ChannelResource) error)) *channelListGroupChannelsStreamCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_listChannelRequest, _ := args.Get(0).(ListGroupChannelRequest)
+		_fn, _ := args.Get(1).(func(ChannelResource) error)
+		fn(_listChannelRequest, _fn)
+	})
+	return _c
+}
+
+func (_c *channelListGroupChannelsStreamCall) OnCreateGroupChannel(createChannelRequest CreateGroupChannelRequest) *channelCreateGroupChannelCall {
+	return _c.Parent.OnCreateGroupChannel(createChannelRequest)
+}
+
+func (_c *channelListGroupChannelsStreamCall) OnGetReadStatus(channelURL string, userIDs []string) *channelGetReadStatusCall {
+	return _c.Parent.OnGetReadStatus(channelURL, userIDs)
+}
+
+func (_c *channelListGroupChannelsStreamCall) OnGetUnreadMemberCount(channelURL string, messageID int) *channelGetUnreadMemberCountCall {
+	return _c.Parent.OnGetUnreadMemberCount(channelURL, messageID)
+}
+
+func (_c *channelListGroupChannelsStreamCall) OnListGroupChannels(listChannelRequest ListGroupChannelRequest) *channelListGroupChannelsCall {
+	return _c.Parent.OnListGroupChannels(listChannelRequest)
+}
+
+func (_c *channelListGroupChannelsStreamCall) OnListGroupChannelsStream(listChannelRequest ListGroupChannelRequest, fn func(ChannelResource) error) *channelListGroupChannelsStreamCall {
+	return _c.Parent.OnListGroupChannelsStream(listChannelRequest, fn)
+}
+
+func (_c *channelListGroupChannelsStreamCall) OnMarkAsDelivered(channelURL string, userID string) *channelMarkAsDeliveredCall {
+	return _c.Parent.OnMarkAsDelivered(channelURL, userID)
+}
+
+func (_c *channelListGroupChannelsStreamCall) OnMarkAsRead(channelURL string, userID string) *channelMarkAsReadCall {
+	return _c.Parent.OnMarkAsRead(channelURL, userID)
+}
+
+func (_c *channelListGroupChannelsStreamCall) OnResetChatHistory(channelURL string, resetChatHistoryRequest ResetChatHistoryRequest) *channelResetChatHistoryCall {
+	return _c.Parent.OnResetChatHistory(channelURL, resetChatHistoryRequest)
+}
+
+func (_c *channelListGroupChannelsStreamCall) OnStartTyping(channelURL string, userIDs []string) *channelStartTypingCall {
+	return _c.Parent.OnStartTyping(channelURL, userIDs)
+}
+
+func (_c *channelListGroupChannelsStreamCall) OnStopTyping(channelURL string, userIDs []string) *channelStopTypingCall {
+	return _c.Parent.OnStopTyping(channelURL, userIDs)
+}
+
+func (_c *channelListGroupChannelsStreamCall) OnUpdateGroupChannel(channelURL string, updateChannelRequest UpdateGroupChannelRequest) *channelUpdateGroupChannelCall {
+	return _c.Parent.OnUpdateGroupChannel(channelURL, updateChannelRequest)
+}
+
+func (_c *channelListGroupChannelsStreamCall) OnViewNumberOfUndeliveredMembers(channelURL string, messageID int) *channelViewNumberOfUndeliveredMembersCall {
+	return _c.Parent.OnViewNumberOfUndeliveredMembers(channelURL, messageID)
+}
+
+func (_c *channelListGroupChannelsStreamCall) OnCreateGroupChannelRaw(createChannelRequest interface{}) *channelCreateGroupChannelCall {
+	return _c.Parent.OnCreateGroupChannelRaw(createChannelRequest)
+}
+
+func (_c *channelListGroupChannelsStreamCall) OnGetReadStatusRaw(channelURL interface{}, userIDs interface{}) *channelGetReadStatusCall {
+	return _c.Parent.OnGetReadStatusRaw(channelURL, userIDs)
+}
+
+func (_c *channelListGroupChannelsStreamCall) OnGetUnreadMemberCountRaw(channelURL interface{}, messageID interface{}) *channelGetUnreadMemberCountCall {
+	return _c.Parent.OnGetUnreadMemberCountRaw(channelURL, messageID)
+}
+
+func (_c *channelListGroupChannelsStreamCall) OnListGroupChannelsRaw(listChannelRequest interface{}) *channelListGroupChannelsCall {
+	return _c.Parent.OnListGroupChannelsRaw(listChannelRequest)
+}
+
+func (_c *channelListGroupChannelsStreamCall) OnListGroupChannelsStreamRaw(listChannelRequest interface{}, fn interface{}) *channelListGroupChannelsStreamCall {
+	return _c.Parent.OnListGroupChannelsStreamRaw(listChannelRequest, fn)
+}
+
+func (_c *channelListGroupChannelsStreamCall) OnMarkAsDeliveredRaw(channelURL interface{}, userID interface{}) *channelMarkAsDeliveredCall {
+	return _c.Parent.OnMarkAsDeliveredRaw(channelURL, userID)
+}
+
+func (_c *channelListGroupChannelsStreamCall) OnMarkAsReadRaw(channelURL interface{}, userID interface{}) *channelMarkAsReadCall {
+	return _c.Parent.OnMarkAsReadRaw(channelURL, userID)
+}
+
+func (_c *channelListGroupChannelsStreamCall) OnResetChatHistoryRaw(channelURL interface{}, resetChatHistoryRequest interface{}) *channelResetChatHistoryCall {
+	return _c.Parent.OnResetChatHistoryRaw(channelURL, resetChatHistoryRequest)
+}
+
+func (_c *channelListGroupChannelsStreamCall) OnStartTypingRaw(channelURL interface{}, userIDs interface{}) *channelStartTypingCall {
+	return _c.Parent.OnStartTypingRaw(channelURL, userIDs)
+}
+
+func (_c *channelListGroupChannelsStreamCall) OnStopTypingRaw(channelURL interface{}, userIDs interface{}) *channelStopTypingCall {
+	return _c.Parent.OnStopTypingRaw(channelURL, userIDs)
+}
+
+func (_c *channelListGroupChannelsStreamCall) OnUpdateGroupChannelRaw(channelURL interface{}, updateChannelRequest interface{}) *channelUpdateGroupChannelCall {
+	return _c.Parent.OnUpdateGroupChannelRaw(channelURL, updateChannelRequest)
+}
+
+func (_c *channelListGroupChannelsStreamCall) OnViewNumberOfUndeliveredMembersRaw(channelURL interface{}, messageID interface{}) *channelViewNumberOfUndeliveredMembersCall {
+	return _c.Parent.OnViewNumberOfUndeliveredMembersRaw(channelURL, messageID)
+}
+
+func (_m *channelMock) MarkAsDelivered(_ context.Context, channelURL string, userID string) (*MarkAsDeliveredResponse, error) {
+	_ret := _m.Called(channelURL, userID)
+
+	if _rf, ok := _ret.Get(0).(func(string, string) (*MarkAsDeliveredResponse, error)); ok {
+		return _rf(channelURL, userID)
+	}
+
+	_ra0, _ := _ret.Get(0).(*MarkAsDeliveredResponse)
+	_rb1 := _ret.Error(1)
+
+	return _ra0, _rb1
+}
+
+func (_m *channelMock) OnMarkAsDelivered(channelURL string, userID string) *channelMarkAsDeliveredCall {
+	return &channelMarkAsDeliveredCall{Call: _m.Mock.On("MarkAsDelivered", channelURL, userID), Parent: _m}
+}
+
+func (_m *channelMock) OnMarkAsDeliveredRaw(channelURL interface{}, userID interface{}) *channelMarkAsDeliveredCall {
+	return &channelMarkAsDeliveredCall{Call: _m.Mock.On("MarkAsDelivered", channelURL, userID), Parent: _m}
+}
+
+type channelMarkAsDeliveredCall struct {
+	*mock.Call
+	Parent *channelMock
+}
+
+func (_c *channelMarkAsDeliveredCall) Panic(msg string) *channelMarkAsDeliveredCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *channelMarkAsDeliveredCall) Once() *channelMarkAsDeliveredCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *channelMarkAsDeliveredCall) Twice() *channelMarkAsDeliveredCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *channelMarkAsDeliveredCall) Times(i int) *channelMarkAsDeliveredCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *channelMarkAsDeliveredCall) WaitUntil(w <-chan time.Time) *channelMarkAsDeliveredCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *channelMarkAsDeliveredCall) After(d time.Duration) *channelMarkAsDeliveredCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *channelMarkAsDeliveredCall) Run(fn func(args mock.Arguments)) *channelMarkAsDeliveredCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *channelMarkAsDeliveredCall) Maybe() *channelMarkAsDeliveredCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *channelMarkAsDeliveredCall) TypedReturns(a *MarkAsDeliveredResponse, b error) *channelMarkAsDeliveredCall {
+	_c.Call = _c.Return(a, b)
+	return _c
+}
+
+func (_c *channelMarkAsDeliveredCall) ReturnsFn(fn func(string, string) (*MarkAsDeliveredResponse, error)) *channelMarkAsDeliveredCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *channelMarkAsDeliveredCall) TypedRun(fn func(string, string)) *channelMarkAsDeliveredCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_channelURL := args.String(0)
+		_userID := args.String(1)
+		fn(_channelURL, _userID)
+	})
+	return _c
+}
+
+func (_c *channelMarkAsDeliveredCall) OnCreateGroupChannel(createChannelRequest CreateGroupChannelRequest) *channelCreateGroupChannelCall {
+	return _c.Parent.OnCreateGroupChannel(createChannelRequest)
+}
+
+func (_c *channelMarkAsDeliveredCall) OnGetReadStatus(channelURL string, userIDs []string) *channelGetReadStatusCall {
+	return _c.Parent.OnGetReadStatus(channelURL, userIDs)
+}
+
+func (_c *channelMarkAsDeliveredCall) OnGetUnreadMemberCount(channelURL string, messageID int) *channelGetUnreadMemberCountCall {
+	return _c.Parent.OnGetUnreadMemberCount(channelURL, messageID)
+}
+
+func (_c *channelMarkAsDeliveredCall) OnListGroupChannels(listChannelRequest ListGroupChannelRequest) *channelListGroupChannelsCall {
+	return _c.Parent.OnListGroupChannels(listChannelRequest)
+}
+
+func (_c *channelMarkAsDeliveredCall) OnListGroupChannelsStream(listChannelRequest ListGroupChannelRequest, fn func(ChannelResource) error) *channelListGroupChannelsStreamCall {
+	return _c.Parent.OnListGroupChannelsStream(listChannelRequest, fn)
+}
+
+func (_c *channelMarkAsDeliveredCall) OnMarkAsDelivered(channelURL string, userID string) *channelMarkAsDeliveredCall {
+	return _c.Parent.OnMarkAsDelivered(channelURL, userID)
+}
+
+func (_c *channelMarkAsDeliveredCall) OnMarkAsRead(channelURL string, userID string) *channelMarkAsReadCall {
+	return _c.Parent.OnMarkAsRead(channelURL, userID)
+}
+
+func (_c *channelMarkAsDeliveredCall) OnResetChatHistory(channelURL string, resetChatHistoryRequest ResetChatHistoryRequest) *channelResetChatHistoryCall {
+	return _c.Parent.OnResetChatHistory(channelURL, resetChatHistoryRequest)
+}
+
+func (_c *channelMarkAsDeliveredCall) OnStartTyping(channelURL string, userIDs []string) *channelStartTypingCall {
+	return _c.Parent.OnStartTyping(channelURL, userIDs)
+}
+
+func (_c *channelMarkAsDeliveredCall) OnStopTyping(channelURL string, userIDs []string) *channelStopTypingCall {
+	return _c.Parent.OnStopTyping(channelURL, userIDs)
+}
+
+func (_c *channelMarkAsDeliveredCall) OnUpdateGroupChannel(channelURL string, updateChannelRequest UpdateGroupChannelRequest) *channelUpdateGroupChannelCall {
+	return _c.Parent.OnUpdateGroupChannel(channelURL, updateChannelRequest)
+}
+
+func (_c *channelMarkAsDeliveredCall) OnViewNumberOfUndeliveredMembers(channelURL string, messageID int) *channelViewNumberOfUndeliveredMembersCall {
+	return _c.Parent.OnViewNumberOfUndeliveredMembers(channelURL, messageID)
+}
+
+func (_c *channelMarkAsDeliveredCall) OnCreateGroupChannelRaw(createChannelRequest interface{}) *channelCreateGroupChannelCall {
+	return _c.Parent.OnCreateGroupChannelRaw(createChannelRequest)
+}
+
+func (_c *channelMarkAsDeliveredCall) OnGetReadStatusRaw(channelURL interface{}, userIDs interface{}) *channelGetReadStatusCall {
+	return _c.Parent.OnGetReadStatusRaw(channelURL, userIDs)
+}
+
+func (_c *channelMarkAsDeliveredCall) OnGetUnreadMemberCountRaw(channelURL interface{}, messageID interface{}) *channelGetUnreadMemberCountCall {
+	return _c.Parent.OnGetUnreadMemberCountRaw(channelURL, messageID)
+}
+
+func (_c *channelMarkAsDeliveredCall) OnListGroupChannelsRaw(listChannelRequest interface{}) *channelListGroupChannelsCall {
+	return _c.Parent.OnListGroupChannelsRaw(listChannelRequest)
+}
+
+func (_c *channelMarkAsDeliveredCall) OnListGroupChannelsStreamRaw(listChannelRequest interface{}, fn interface{}) *channelListGroupChannelsStreamCall {
+	return _c.Parent.OnListGroupChannelsStreamRaw(listChannelRequest, fn)
+}
+
+func (_c *channelMarkAsDeliveredCall) OnMarkAsDeliveredRaw(channelURL interface{}, userID interface{}) *channelMarkAsDeliveredCall {
+	return _c.Parent.OnMarkAsDeliveredRaw(channelURL, userID)
+}
+
+func (_c *channelMarkAsDeliveredCall) OnMarkAsReadRaw(channelURL interface{}, userID interface{}) *channelMarkAsReadCall {
+	return _c.Parent.OnMarkAsReadRaw(channelURL, userID)
+}
+
+func (_c *channelMarkAsDeliveredCall) OnResetChatHistoryRaw(channelURL interface{}, resetChatHistoryRequest interface{}) *channelResetChatHistoryCall {
+	return _c.Parent.OnResetChatHistoryRaw(channelURL, resetChatHistoryRequest)
+}
+
+func (_c *channelMarkAsDeliveredCall) OnStartTypingRaw(channelURL interface{}, userIDs interface{}) *channelStartTypingCall {
+	return _c.Parent.OnStartTypingRaw(channelURL, userIDs)
+}
+
+func (_c *channelMarkAsDeliveredCall) OnStopTypingRaw(channelURL interface{}, userIDs interface{}) *channelStopTypingCall {
+	return _c.Parent.OnStopTypingRaw(channelURL, userIDs)
+}
+
+func (_c *channelMarkAsDeliveredCall) OnUpdateGroupChannelRaw(channelURL interface{}, updateChannelRequest interface{}) *channelUpdateGroupChannelCall {
+	return _c.Parent.OnUpdateGroupChannelRaw(channelURL, updateChannelRequest)
+}
+
+func (_c *channelMarkAsDeliveredCall) OnViewNumberOfUndeliveredMembersRaw(channelURL interface{}, messageID interface{}) *channelViewNumberOfUndeliveredMembersCall {
+	return _c.Parent.OnViewNumberOfUndeliveredMembersRaw(channelURL, messageID)
+}
+
+func (_m *channelMock) MarkAsRead(_ context.Context, channelURL string, userID string) error {
+	_ret := _m.Called(channelURL, userID)
+
+	if _rf, ok := _ret.Get(0).(func(string, string) error); ok {
+		return _rf(channelURL, userID)
+	}
+
+	_ra0 := _ret.Error(0)
+
+	return _ra0
+}
+
+func (_m *channelMock) OnMarkAsRead(channelURL string, userID string) *channelMarkAsReadCall {
+	return &channelMarkAsReadCall{Call: _m.Mock.On("MarkAsRead", channelURL, userID), Parent: _m}
+}
+
+func (_m *channelMock) OnMarkAsReadRaw(channelURL interface{}, userID interface{}) *channelMarkAsReadCall {
+	return &channelMarkAsReadCall{Call: _m.Mock.On("MarkAsRead", channelURL, userID), Parent: _m}
+}
+
+type channelMarkAsReadCall struct {
+	*mock.Call
+	Parent *channelMock
+}
+
+func (_c *channelMarkAsReadCall) Panic(msg string) *channelMarkAsReadCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *channelMarkAsReadCall) Once() *channelMarkAsReadCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *channelMarkAsReadCall) Twice() *channelMarkAsReadCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *channelMarkAsReadCall) Times(i int) *channelMarkAsReadCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *channelMarkAsReadCall) WaitUntil(w <-chan time.Time) *channelMarkAsReadCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *channelMarkAsReadCall) After(d time.Duration) *channelMarkAsReadCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *channelMarkAsReadCall) Run(fn func(args mock.Arguments)) *channelMarkAsReadCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *channelMarkAsReadCall) Maybe() *channelMarkAsReadCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *channelMarkAsReadCall) TypedReturns(a error) *channelMarkAsReadCall {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *channelMarkAsReadCall) ReturnsFn(fn func(string, string) error) *channelMarkAsReadCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *channelMarkAsReadCall) TypedRun(fn func(string, string)) *channelMarkAsReadCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_channelURL := args.String(0)
+		_userID := args.String(1)
+		fn(_channelURL, _userID)
+	})
+	return _c
+}
+
+func (_c *channelMarkAsReadCall) OnCreateGroupChannel(createChannelRequest CreateGroupChannelRequest) *channelCreateGroupChannelCall {
+	return _c.Parent.OnCreateGroupChannel(createChannelRequest)
+}
+
+func (_c *channelMarkAsReadCall) OnGetReadStatus(channelURL string, userIDs []string) *channelGetReadStatusCall {
+	return _c.Parent.OnGetReadStatus(channelURL, userIDs)
+}
+
+func (_c *channelMarkAsReadCall) OnGetUnreadMemberCount(channelURL string, messageID int) *channelGetUnreadMemberCountCall {
+	return _c.Parent.OnGetUnreadMemberCount(channelURL, messageID)
+}
+
+func (_c *channelMarkAsReadCall) OnListGroupChannels(listChannelRequest ListGroupChannelRequest) *channelListGroupChannelsCall {
+	return _c.Parent.OnListGroupChannels(listChannelRequest)
+}
+
+func (_c *channelMarkAsReadCall) OnListGroupChannelsStream(listChannelRequest ListGroupChannelRequest, fn func(ChannelResource) error) *channelListGroupChannelsStreamCall {
+	return _c.Parent.OnListGroupChannelsStream(listChannelRequest, fn)
+}
+
+func (_c *channelMarkAsReadCall) OnMarkAsDelivered(channelURL string, userID string) *channelMarkAsDeliveredCall {
+	return _c.Parent.OnMarkAsDelivered(channelURL, userID)
+}
+
+func (_c *channelMarkAsReadCall) OnMarkAsRead(channelURL string, userID string) *channelMarkAsReadCall {
+	return _c.Parent.OnMarkAsRead(channelURL, userID)
+}
+
+func (_c *channelMarkAsReadCall) OnResetChatHistory(channelURL string, resetChatHistoryRequest ResetChatHistoryRequest) *channelResetChatHistoryCall {
+	return _c.Parent.OnResetChatHistory(channelURL, resetChatHistoryRequest)
+}
+
+func (_c *channelMarkAsReadCall) OnStartTyping(channelURL string, userIDs []string) *channelStartTypingCall {
+	return _c.Parent.OnStartTyping(channelURL, userIDs)
+}
+
+func (_c *channelMarkAsReadCall) OnStopTyping(channelURL string, userIDs []string) *channelStopTypingCall {
+	return _c.Parent.OnStopTyping(channelURL, userIDs)
+}
+
+func (_c *channelMarkAsReadCall) OnUpdateGroupChannel(channelURL string, updateChannelRequest UpdateGroupChannelRequest) *channelUpdateGroupChannelCall {
+	return _c.Parent.OnUpdateGroupChannel(channelURL, updateChannelRequest)
+}
+
+func (_c *channelMarkAsReadCall) OnViewNumberOfUndeliveredMembers(channelURL string, messageID int) *channelViewNumberOfUndeliveredMembersCall {
+	return _c.Parent.OnViewNumberOfUndeliveredMembers(channelURL, messageID)
+}
+
+func (_c *channelMarkAsReadCall) OnCreateGroupChannelRaw(createChannelRequest interface{}) *channelCreateGroupChannelCall {
+	return _c.Parent.OnCreateGroupChannelRaw(createChannelRequest)
+}
+
+func (_c *channelMarkAsReadCall) OnGetReadStatusRaw(channelURL interface{}, userIDs interface{}) *channelGetReadStatusCall {
+	return _c.Parent.OnGetReadStatusRaw(channelURL, userIDs)
+}
+
+func (_c *channelMarkAsReadCall) OnGetUnreadMemberCountRaw(channelURL interface{}, messageID interface{}) *channelGetUnreadMemberCountCall {
+	return _c.Parent.OnGetUnreadMemberCountRaw(channelURL, messageID)
+}
+
+func (_c *channelMarkAsReadCall) OnListGroupChannelsRaw(listChannelRequest interface{}) *channelListGroupChannelsCall {
+	return _c.Parent.OnListGroupChannelsRaw(listChannelRequest)
+}
+
+func (_c *channelMarkAsReadCall) OnListGroupChannelsStreamRaw(listChannelRequest interface{}, fn interface{}) *channelListGroupChannelsStreamCall {
+	return _c.Parent.OnListGroupChannelsStreamRaw(listChannelRequest, fn)
+}
+
+func (_c *channelMarkAsReadCall) OnMarkAsDeliveredRaw(channelURL interface{}, userID interface{}) *channelMarkAsDeliveredCall {
+	return _c.Parent.OnMarkAsDeliveredRaw(channelURL, userID)
+}
+
+func (_c *channelMarkAsReadCall) OnMarkAsReadRaw(channelURL interface{}, userID interface{}) *channelMarkAsReadCall {
+	return _c.Parent.OnMarkAsReadRaw(channelURL, userID)
+}
+
+func (_c *channelMarkAsReadCall) OnResetChatHistoryRaw(channelURL interface{}, resetChatHistoryRequest interface{}) *channelResetChatHistoryCall {
+	return _c.Parent.OnResetChatHistoryRaw(channelURL, resetChatHistoryRequest)
+}
+
+func (_c *channelMarkAsReadCall) OnStartTypingRaw(channelURL interface{}, userIDs interface{}) *channelStartTypingCall {
+	return _c.Parent.OnStartTypingRaw(channelURL, userIDs)
+}
+
+func (_c *channelMarkAsReadCall) OnStopTypingRaw(channelURL interface{}, userIDs interface{}) *channelStopTypingCall {
+	return _c.Parent.OnStopTypingRaw(channelURL, userIDs)
+}
+
+func (_c *channelMarkAsReadCall) OnUpdateGroupChannelRaw(channelURL interface{}, updateChannelRequest interface{}) *channelUpdateGroupChannelCall {
+	return _c.Parent.OnUpdateGroupChannelRaw(channelURL, updateChannelRequest)
+}
+
+func (_c *channelMarkAsReadCall) OnViewNumberOfUndeliveredMembersRaw(channelURL interface{}, messageID interface{}) *channelViewNumberOfUndeliveredMembersCall {
+	return _c.Parent.OnViewNumberOfUndeliveredMembersRaw(channelURL, messageID)
+}
+
+func (_m *channelMock) ResetChatHistory(_ context.Context, channelURL string, resetChatHistoryRequest ResetChatHistoryRequest) (*ResetChatHistoryResponse, error) {
+	_ret := _m.Called(channelURL, resetChatHistoryRequest)
 
-	if _rf, ok := _ret.Get(0).(func(string, string) error); ok {
-		return _rf(channelURL, userID)
+	if _rf, ok := _ret.Get(0).(func(string, ResetChatHistoryRequest) (*ResetChatHistoryResponse, error)); ok {
+		return _rf(channelURL, resetChatHistoryRequest)
 	}
 
-	_ra0 := _ret.Error(0)
+	_ra0, _ := _ret.Get(0).(*ResetChatHistoryResponse)
+	_rb1 := _ret.Error(1)
 
-	return _ra0
+	return _ra0, _rb1
 }
 
-func (_m *channelMock) OnMarkAsRead(channelURL string, userID string) *channelMarkAsReadCall {
-	return &channelMarkAsReadCall{Call: _m.Mock.On("MarkAsRead", channelURL, userID), Parent: _m}
+func (_m *channelMock) OnResetChatHistory(channelURL string, resetChatHistoryRequest ResetChatHistoryRequest) *channelResetChatHistoryCall {
+	return &channelResetChatHistoryCall{Call: _m.Mock.On("ResetChatHistory", channelURL, resetChatHistoryRequest), Parent: _m}
 }
 
-func (_m *channelMock) OnMarkAsReadRaw(channelURL interface{}, userID interface{}) *channelMarkAsReadCall {
-	return &channelMarkAsReadCall{Call: _m.Mock.On("MarkAsRead", channelURL, userID), Parent: _m}
+func (_m *channelMock) OnResetChatHistoryRaw(channelURL interface{}, resetChatHistoryRequest interface{}) *channelResetChatHistoryCall {
+	return &channelResetChatHistoryCall{Call: _m.Mock.On("ResetChatHistory", channelURL, resetChatHistoryRequest), Parent: _m}
 }
 
-type channelMarkAsReadCall struct {
+type channelResetChatHistoryCall struct {
 	*mock.Call
 	Parent *channelMock
 }
 
-func (_c *channelMarkAsReadCall) Panic(msg string) *channelMarkAsReadCall {
+func (_c *channelResetChatHistoryCall) Panic(msg string) *channelResetChatHistoryCall {
 	_c.Call = _c.Call.Panic(msg)
 	return _c
 }
 
-func (_c *channelMarkAsReadCall) Once() *channelMarkAsReadCall {
+func (_c *channelResetChatHistoryCall) Once() *channelResetChatHistoryCall {
 	_c.Call = _c.Call.Once()
 	return _c
 }
 
-func (_c *channelMarkAsReadCall) Twice() *channelMarkAsReadCall {
+func (_c *channelResetChatHistoryCall) Twice() *channelResetChatHistoryCall {
 	_c.Call = _c.Call.Twice()
 	return _c
 }
 
-func (_c *channelMarkAsReadCall) Times(i int) *channelMarkAsReadCall {
+func (_c *channelResetChatHistoryCall) Times(i int) *channelResetChatHistoryCall {
 	_c.Call = _c.Call.Times(i)
 	return _c
 }
 
-func (_c *channelMarkAsReadCall) WaitUntil(w <-chan time.Time) *channelMarkAsReadCall {
+func (_c *channelResetChatHistoryCall) WaitUntil(w <-chan time.Time) *channelResetChatHistoryCall {
 	_c.Call = _c.Call.WaitUntil(w)
 	return _c
 }
 
-func (_c *channelMarkAsReadCall) After(d time.Duration) *channelMarkAsReadCall {
+func (_c *channelResetChatHistoryCall) After(d time.Duration) *channelResetChatHistoryCall {
 	_c.Call = _c.Call.After(d)
 	return _c
 }
 
-func (_c *channelMarkAsReadCall) Run(fn func(args mock.Arguments)) *channelMarkAsReadCall {
+func (_c *channelResetChatHistoryCall) Run(fn func(args mock.Arguments)) *channelResetChatHistoryCall {
 	_c.Call = _c.Call.Run(fn)
 	return _c
 }
 
-func (_c *channelMarkAsReadCall) Maybe() *channelMarkAsReadCall {
+func (_c *channelResetChatHistoryCall) Maybe() *channelResetChatHistoryCall {
 	_c.Call = _c.Call.Maybe()
 	return _c
 }
 
-func (_c *channelMarkAsReadCall) TypedReturns(a error) *channelMarkAsReadCall {
-	_c.Call = _c.Return(a)
+func (_c *channelResetChatHistoryCall) TypedReturns(a *ResetChatHistoryResponse, b error) *channelResetChatHistoryCall {
+	_c.Call = _c.Return(a, b)
 	return _c
 }
 
-func (_c *channelMarkAsReadCall) ReturnsFn(fn func(string, string) error) *channelMarkAsReadCall {
+func (_c *channelResetChatHistoryCall) ReturnsFn(fn func(string, ResetChatHistoryRequest) (*ResetChatHistoryResponse, error)) *channelResetChatHistoryCall {
 	_c.Call = _c.Return(fn)
 	return _c
 }
 
-func (_c *channelMarkAsReadCall) TypedRun(fn func(string, string)) *channelMarkAsReadCall {
+func (_c *channelResetChatHistoryCall) TypedRun(fn func(string, ResetChatHistoryRequest)) *channelResetChatHistoryCall {
 	_c.Call = _c.Call.Run(func(args mock.Arguments) {
 		_channelURL := args.String(0)
-		_userID := args.String(1)
-		fn(_channelURL, _userID)
+		_resetChatHistoryRequest, _ := args.Get(1).(ResetChatHistoryRequest)
+		fn(_channelURL, _resetChatHistoryRequest)
 	})
 	return _c
 }
 
-func (_c *channelMarkAsReadCall) OnCreateGroupChannel(createChannelRequest CreateGroupChannelRequest) *channelCreateGroupChannelCall {
+func (_c *channelResetChatHistoryCall) OnCreateGroupChannel(createChannelRequest CreateGroupChannelRequest) *channelCreateGroupChannelCall {
 	return _c.Parent.OnCreateGroupChannel(createChannelRequest)
 }
 
-func (_c *channelMarkAsReadCall) OnListGroupChannels(listChannelRequest ListGroupChannelRequest) *channelListGroupChannelsCall {
+func (_c *channelResetChatHistoryCall) OnGetReadStatus(channelURL string, userIDs []string) *channelGetReadStatusCall {
+	return _c.Parent.OnGetReadStatus(channelURL, userIDs)
+}
+
+func (_c *channelResetChatHistoryCall) OnGetUnreadMemberCount(channelURL string, messageID int) *channelGetUnreadMemberCountCall {
+	return _c.Parent.OnGetUnreadMemberCount(channelURL, messageID)
+}
+
+func (_c *channelResetChatHistoryCall) OnListGroupChannels(listChannelRequest ListGroupChannelRequest) *channelListGroupChannelsCall {
 	return _c.Parent.OnListGroupChannels(listChannelRequest)
 }
 
-func (_c *channelMarkAsReadCall) OnMarkAsRead(channelURL string, userID string) *channelMarkAsReadCall {
+func (_c *channelResetChatHistoryCall) OnListGroupChannelsStream(listChannelRequest ListGroupChannelRequest, fn func(ChannelResource) error) *channelListGroupChannelsStreamCall {
+	return _c.Parent.OnListGroupChannelsStream(listChannelRequest, fn)
+}
+
+func (_c *channelResetChatHistoryCall) OnMarkAsDelivered(channelURL string, userID string) *channelMarkAsDeliveredCall {
+	return _c.Parent.OnMarkAsDelivered(channelURL, userID)
+}
+
+func (_c *channelResetChatHistoryCall) OnMarkAsRead(channelURL string, userID string) *channelMarkAsReadCall {
 	return _c.Parent.OnMarkAsRead(channelURL, userID)
 }
 
-func (_c *channelMarkAsReadCall) OnStartTyping(channelURL string, userIDs []string) *channelStartTypingCall {
+func (_c *channelResetChatHistoryCall) OnResetChatHistory(channelURL string, resetChatHistoryRequest ResetChatHistoryRequest) *channelResetChatHistoryCall {
+	return _c.Parent.OnResetChatHistory(channelURL, resetChatHistoryRequest)
+}
+
+func (_c *channelResetChatHistoryCall) OnStartTyping(channelURL string, userIDs []string) *channelStartTypingCall {
 	return _c.Parent.OnStartTyping(channelURL, userIDs)
 }
 
-func (_c *channelMarkAsReadCall) OnStopTyping(channelURL string, userIDs []string) *channelStopTypingCall {
+func (_c *channelResetChatHistoryCall) OnStopTyping(channelURL string, userIDs []string) *channelStopTypingCall {
 	return _c.Parent.OnStopTyping(channelURL, userIDs)
 }
 
-func (_c *channelMarkAsReadCall) OnUpdateGroupChannel(channelURL string, updateChannelRequest UpdateGroupChannelRequest) *channelUpdateGroupChannelCall {
+func (_c *channelResetChatHistoryCall) OnUpdateGroupChannel(channelURL string, updateChannelRequest UpdateGroupChannelRequest) *channelUpdateGroupChannelCall {
 	return _c.Parent.OnUpdateGroupChannel(channelURL, updateChannelRequest)
 }
 
-func (_c *channelMarkAsReadCall) OnCreateGroupChannelRaw(createChannelRequest interface{}) *channelCreateGroupChannelCall {
+func (_c *channelResetChatHistoryCall) OnViewNumberOfUndeliveredMembers(channelURL string, messageID int) *channelViewNumberOfUndeliveredMembersCall {
+	return _c.Parent.OnViewNumberOfUndeliveredMembers(channelURL, messageID)
+}
+
+func (_c *channelResetChatHistoryCall) OnCreateGroupChannelRaw(createChannelRequest interface{}) *channelCreateGroupChannelCall {
 	return _c.Parent.OnCreateGroupChannelRaw(createChannelRequest)
 }
 
-func (_c *channelMarkAsReadCall) OnListGroupChannelsRaw(listChannelRequest interface{}) *channelListGroupChannelsCall {
+func (_c *channelResetChatHistoryCall) OnGetReadStatusRaw(channelURL interface{}, userIDs interface{}) *channelGetReadStatusCall {
+	return _c.Parent.OnGetReadStatusRaw(channelURL, userIDs)
+}
+
+func (_c *channelResetChatHistoryCall) OnGetUnreadMemberCountRaw(channelURL interface{}, messageID interface{}) *channelGetUnreadMemberCountCall {
+	return _c.Parent.OnGetUnreadMemberCountRaw(channelURL, messageID)
+}
+
+func (_c *channelResetChatHistoryCall) OnListGroupChannelsRaw(listChannelRequest interface{}) *channelListGroupChannelsCall {
 	return _c.Parent.OnListGroupChannelsRaw(listChannelRequest)
 }
 
-func (_c *channelMarkAsReadCall) OnMarkAsReadRaw(channelURL interface{}, userID interface{}) *channelMarkAsReadCall {
+func (_c *channelResetChatHistoryCall) OnListGroupChannelsStreamRaw(listChannelRequest interface{}, fn interface{}) *channelListGroupChannelsStreamCall {
+	return _c.Parent.OnListGroupChannelsStreamRaw(listChannelRequest, fn)
+}
+
+func (_c *channelResetChatHistoryCall) OnMarkAsDeliveredRaw(channelURL interface{}, userID interface{}) *channelMarkAsDeliveredCall {
+	return _c.Parent.OnMarkAsDeliveredRaw(channelURL, userID)
+}
+
+func (_c *channelResetChatHistoryCall) OnMarkAsReadRaw(channelURL interface{}, userID interface{}) *channelMarkAsReadCall {
 	return _c.Parent.OnMarkAsReadRaw(channelURL, userID)
 }
 
-func (_c *channelMarkAsReadCall) OnStartTypingRaw(channelURL interface{}, userIDs interface{}) *channelStartTypingCall {
+func (_c *channelResetChatHistoryCall) OnResetChatHistoryRaw(channelURL interface{}, resetChatHistoryRequest interface{}) *channelResetChatHistoryCall {
+	return _c.Parent.OnResetChatHistoryRaw(channelURL, resetChatHistoryRequest)
+}
+
+func (_c *channelResetChatHistoryCall) OnStartTypingRaw(channelURL interface{}, userIDs interface{}) *channelStartTypingCall {
 	return _c.Parent.OnStartTypingRaw(channelURL, userIDs)
 }
 
-func (_c *channelMarkAsReadCall) OnStopTypingRaw(channelURL interface{}, userIDs interface{}) *channelStopTypingCall {
+func (_c *channelResetChatHistoryCall) OnStopTypingRaw(channelURL interface{}, userIDs interface{}) *channelStopTypingCall {
 	return _c.Parent.OnStopTypingRaw(channelURL, userIDs)
 }
 
-func (_c *channelMarkAsReadCall) OnUpdateGroupChannelRaw(channelURL interface{}, updateChannelRequest interface{}) *channelUpdateGroupChannelCall {
+func (_c *channelResetChatHistoryCall) OnUpdateGroupChannelRaw(channelURL interface{}, updateChannelRequest interface{}) *channelUpdateGroupChannelCall {
 	return _c.Parent.OnUpdateGroupChannelRaw(channelURL, updateChannelRequest)
 }
 
+func (_c *channelResetChatHistoryCall) OnViewNumberOfUndeliveredMembersRaw(channelURL interface{}, messageID interface{}) *channelViewNumberOfUndeliveredMembersCall {
+	return _c.Parent.OnViewNumberOfUndeliveredMembersRaw(channelURL, messageID)
+}
+
 func (_m *channelMock) StartTyping(_ context.Context, channelURL string, userIDs []string) error {
 	_ret := _m.Called(channelURL, userIDs)
 
@@ -509,14 +1558,34 @@ func (_c *channelStartTypingCall) OnCreateGroupChannel(createChannelRequest Crea
 	return _c.Parent.OnCreateGroupChannel(createChannelRequest)
 }
 
+func (_c *channelStartTypingCall) OnGetReadStatus(channelURL string, userIDs []string) *channelGetReadStatusCall {
+	return _c.Parent.OnGetReadStatus(channelURL, userIDs)
+}
+
+func (_c *channelStartTypingCall) OnGetUnreadMemberCount(channelURL string, messageID int) *channelGetUnreadMemberCountCall {
+	return _c.Parent.OnGetUnreadMemberCount(channelURL, messageID)
+}
+
 func (_c *channelStartTypingCall) OnListGroupChannels(listChannelRequest ListGroupChannelRequest) *channelListGroupChannelsCall {
 	return _c.Parent.OnListGroupChannels(listChannelRequest)
 }
 
+func (_c *channelStartTypingCall) OnListGroupChannelsStream(listChannelRequest ListGroupChannelRequest, fn func(ChannelResource) error) *channelListGroupChannelsStreamCall {
+	return _c.Parent.OnListGroupChannelsStream(listChannelRequest, fn)
+}
+
+func (_c *channelStartTypingCall) OnMarkAsDelivered(channelURL string, userID string) *channelMarkAsDeliveredCall {
+	return _c.Parent.OnMarkAsDelivered(channelURL, userID)
+}
+
 func (_c *channelStartTypingCall) OnMarkAsRead(channelURL string, userID string) *channelMarkAsReadCall {
 	return _c.Parent.OnMarkAsRead(channelURL, userID)
 }
 
+func (_c *channelStartTypingCall) OnResetChatHistory(channelURL string, resetChatHistoryRequest ResetChatHistoryRequest) *channelResetChatHistoryCall {
+	return _c.Parent.OnResetChatHistory(channelURL, resetChatHistoryRequest)
+}
+
 func (_c *channelStartTypingCall) OnStartTyping(channelURL string, userIDs []string) *channelStartTypingCall {
 	return _c.Parent.OnStartTyping(channelURL, userIDs)
 }
@@ -529,18 +1598,42 @@ func (_c *channelStartTypingCall) OnUpdateGroupChannel(channelURL string, update
 	return _c.Parent.OnUpdateGroupChannel(channelURL, updateChannelRequest)
 }
 
+func (_c *channelStartTypingCall) OnViewNumberOfUndeliveredMembers(channelURL string, messageID int) *channelViewNumberOfUndeliveredMembersCall {
+	return _c.Parent.OnViewNumberOfUndeliveredMembers(channelURL, messageID)
+}
+
 func (_c *channelStartTypingCall) OnCreateGroupChannelRaw(createChannelRequest interface{}) *channelCreateGroupChannelCall {
 	return _c.Parent.OnCreateGroupChannelRaw(createChannelRequest)
 }
 
+func (_c *channelStartTypingCall) OnGetReadStatusRaw(channelURL interface{}, userIDs interface{}) *channelGetReadStatusCall {
+	return _c.Parent.OnGetReadStatusRaw(channelURL, userIDs)
+}
+
+func (_c *channelStartTypingCall) OnGetUnreadMemberCountRaw(channelURL interface{}, messageID interface{}) *channelGetUnreadMemberCountCall {
+	return _c.Parent.OnGetUnreadMemberCountRaw(channelURL, messageID)
+}
+
 func (_c *channelStartTypingCall) OnListGroupChannelsRaw(listChannelRequest interface{}) *channelListGroupChannelsCall {
 	return _c.Parent.OnListGroupChannelsRaw(listChannelRequest)
 }
 
+func (_c *channelStartTypingCall) OnListGroupChannelsStreamRaw(listChannelRequest interface{}, fn interface{}) *channelListGroupChannelsStreamCall {
+	return _c.Parent.OnListGroupChannelsStreamRaw(listChannelRequest, fn)
+}
+
+func (_c *channelStartTypingCall) OnMarkAsDeliveredRaw(channelURL interface{}, userID interface{}) *channelMarkAsDeliveredCall {
+	return _c.Parent.OnMarkAsDeliveredRaw(channelURL, userID)
+}
+
 func (_c *channelStartTypingCall) OnMarkAsReadRaw(channelURL interface{}, userID interface{}) *channelMarkAsReadCall {
 	return _c.Parent.OnMarkAsReadRaw(channelURL, userID)
 }
 
+func (_c *channelStartTypingCall) OnResetChatHistoryRaw(channelURL interface{}, resetChatHistoryRequest interface{}) *channelResetChatHistoryCall {
+	return _c.Parent.OnResetChatHistoryRaw(channelURL, resetChatHistoryRequest)
+}
+
 func (_c *channelStartTypingCall) OnStartTypingRaw(channelURL interface{}, userIDs interface{}) *channelStartTypingCall {
 	return _c.Parent.OnStartTypingRaw(channelURL, userIDs)
 }
@@ -553,6 +1646,10 @@ func (_c *channelStartTypingCall) OnUpdateGroupChannelRaw(channelURL interface{}
 	return _c.Parent.OnUpdateGroupChannelRaw(channelURL, updateChannelRequest)
 }
 
+func (_c *channelStartTypingCall) OnViewNumberOfUndeliveredMembersRaw(channelURL interface{}, messageID interface{}) *channelViewNumberOfUndeliveredMembersCall {
+	return _c.Parent.OnViewNumberOfUndeliveredMembersRaw(channelURL, messageID)
+}
+
 func (_m *channelMock) StopTyping(_ context.Context, channelURL string, userIDs []string) error {
 	_ret := _m.Called(channelURL, userIDs)
 
@@ -641,14 +1738,34 @@ func (_c *channelStopTypingCall) OnCreateGroupChannel(createChannelRequest Creat
 	return _c.Parent.OnCreateGroupChannel(createChannelRequest)
 }
 
+func (_c *channelStopTypingCall) OnGetReadStatus(channelURL string, userIDs []string) *channelGetReadStatusCall {
+	return _c.Parent.OnGetReadStatus(channelURL, userIDs)
+}
+
+func (_c *channelStopTypingCall) OnGetUnreadMemberCount(channelURL string, messageID int) *channelGetUnreadMemberCountCall {
+	return _c.Parent.OnGetUnreadMemberCount(channelURL, messageID)
+}
+
 func (_c *channelStopTypingCall) OnListGroupChannels(listChannelRequest ListGroupChannelRequest) *channelListGroupChannelsCall {
 	return _c.Parent.OnListGroupChannels(listChannelRequest)
 }
 
+func (_c *channelStopTypingCall) OnListGroupChannelsStream(listChannelRequest ListGroupChannelRequest, fn func(ChannelResource) error) *channelListGroupChannelsStreamCall {
+	return _c.Parent.OnListGroupChannelsStream(listChannelRequest, fn)
+}
+
+func (_c *channelStopTypingCall) OnMarkAsDelivered(channelURL string, userID string) *channelMarkAsDeliveredCall {
+	return _c.Parent.OnMarkAsDelivered(channelURL, userID)
+}
+
 func (_c *channelStopTypingCall) OnMarkAsRead(channelURL string, userID string) *channelMarkAsReadCall {
 	return _c.Parent.OnMarkAsRead(channelURL, userID)
 }
 
+func (_c *channelStopTypingCall) OnResetChatHistory(channelURL string, resetChatHistoryRequest ResetChatHistoryRequest) *channelResetChatHistoryCall {
+	return _c.Parent.OnResetChatHistory(channelURL, resetChatHistoryRequest)
+}
+
 func (_c *channelStopTypingCall) OnStartTyping(channelURL string, userIDs []string) *channelStartTypingCall {
 	return _c.Parent.OnStartTyping(channelURL, userIDs)
 }
@@ -661,18 +1778,42 @@ func (_c *channelStopTypingCall) OnUpdateGroupChannel(channelURL string, updateC
 	return _c.Parent.OnUpdateGroupChannel(channelURL, updateChannelRequest)
 }
 
+func (_c *channelStopTypingCall) OnViewNumberOfUndeliveredMembers(channelURL string, messageID int) *channelViewNumberOfUndeliveredMembersCall {
+	return _c.Parent.OnViewNumberOfUndeliveredMembers(channelURL, messageID)
+}
+
 func (_c *channelStopTypingCall) OnCreateGroupChannelRaw(createChannelRequest interface{}) *channelCreateGroupChannelCall {
 	return _c.Parent.OnCreateGroupChannelRaw(createChannelRequest)
 }
 
+func (_c *channelStopTypingCall) OnGetReadStatusRaw(channelURL interface{}, userIDs interface{}) *channelGetReadStatusCall {
+	return _c.Parent.OnGetReadStatusRaw(channelURL, userIDs)
+}
+
+func (_c *channelStopTypingCall) OnGetUnreadMemberCountRaw(channelURL interface{}, messageID interface{}) *channelGetUnreadMemberCountCall {
+	return _c.Parent.OnGetUnreadMemberCountRaw(channelURL, messageID)
+}
+
 func (_c *channelStopTypingCall) OnListGroupChannelsRaw(listChannelRequest interface{}) *channelListGroupChannelsCall {
 	return _c.Parent.OnListGroupChannelsRaw(listChannelRequest)
 }
 
+func (_c *channelStopTypingCall) OnListGroupChannelsStreamRaw(listChannelRequest interface{}, fn interface{}) *channelListGroupChannelsStreamCall {
+	return _c.Parent.OnListGroupChannelsStreamRaw(listChannelRequest, fn)
+}
+
+func (_c *channelStopTypingCall) OnMarkAsDeliveredRaw(channelURL interface{}, userID interface{}) *channelMarkAsDeliveredCall {
+	return _c.Parent.OnMarkAsDeliveredRaw(channelURL, userID)
+}
+
 func (_c *channelStopTypingCall) OnMarkAsReadRaw(channelURL interface{}, userID interface{}) *channelMarkAsReadCall {
 	return _c.Parent.OnMarkAsReadRaw(channelURL, userID)
 }
 
+func (_c *channelStopTypingCall) OnResetChatHistoryRaw(channelURL interface{}, resetChatHistoryRequest interface{}) *channelResetChatHistoryCall {
+	return _c.Parent.OnResetChatHistoryRaw(channelURL, resetChatHistoryRequest)
+}
+
 func (_c *channelStopTypingCall) OnStartTypingRaw(channelURL interface{}, userIDs interface{}) *channelStartTypingCall {
 	return _c.Parent.OnStartTypingRaw(channelURL, userIDs)
 }
@@ -685,6 +1826,10 @@ func (_c *channelStopTypingCall) OnUpdateGroupChannelRaw(channelURL interface{},
 	return _c.Parent.OnUpdateGroupChannelRaw(channelURL, updateChannelRequest)
 }
 
+func (_c *channelStopTypingCall) OnViewNumberOfUndeliveredMembersRaw(channelURL interface{}, messageID interface{}) *channelViewNumberOfUndeliveredMembersCall {
+	return _c.Parent.OnViewNumberOfUndeliveredMembersRaw(channelURL, messageID)
+}
+
 func (_m *channelMock) UpdateGroupChannel(_ context.Context, channelURL string, updateChannelRequest UpdateGroupChannelRequest) (*UpdateGroupChannelResponse, error) {
 	_ret := _m.Called(channelURL, updateChannelRequest)
 
@@ -774,14 +1919,34 @@ func (_c *channelUpdateGroupChannelCall) OnCreateGroupChannel(createChannelReque
 	return _c.Parent.OnCreateGroupChannel(createChannelRequest)
 }
 
+func (_c *channelUpdateGroupChannelCall) OnGetReadStatus(channelURL string, userIDs []string) *channelGetReadStatusCall {
+	return _c.Parent.OnGetReadStatus(channelURL, userIDs)
+}
+
+func (_c *channelUpdateGroupChannelCall) OnGetUnreadMemberCount(channelURL string, messageID int) *channelGetUnreadMemberCountCall {
+	return _c.Parent.OnGetUnreadMemberCount(channelURL, messageID)
+}
+
 func (_c *channelUpdateGroupChannelCall) OnListGroupChannels(listChannelRequest ListGroupChannelRequest) *channelListGroupChannelsCall {
 	return _c.Parent.OnListGroupChannels(listChannelRequest)
 }
 
+func (_c *channelUpdateGroupChannelCall) OnListGroupChannelsStream(listChannelRequest ListGroupChannelRequest, fn func(ChannelResource) error) *channelListGroupChannelsStreamCall {
+	return _c.Parent.OnListGroupChannelsStream(listChannelRequest, fn)
+}
+
+func (_c *channelUpdateGroupChannelCall) OnMarkAsDelivered(channelURL string, userID string) *channelMarkAsDeliveredCall {
+	return _c.Parent.OnMarkAsDelivered(channelURL, userID)
+}
+
 func (_c *channelUpdateGroupChannelCall) OnMarkAsRead(channelURL string, userID string) *channelMarkAsReadCall {
 	return _c.Parent.OnMarkAsRead(channelURL, userID)
 }
 
+func (_c *channelUpdateGroupChannelCall) OnResetChatHistory(channelURL string, resetChatHistoryRequest ResetChatHistoryRequest) *channelResetChatHistoryCall {
+	return _c.Parent.OnResetChatHistory(channelURL, resetChatHistoryRequest)
+}
+
 func (_c *channelUpdateGroupChannelCall) OnStartTyping(channelURL string, userIDs []string) *channelStartTypingCall {
 	return _c.Parent.OnStartTyping(channelURL, userIDs)
 }
@@ -794,18 +1959,42 @@ func (_c *channelUpdateGroupChannelCall) OnUpdateGroupChannel(channelURL string,
 	return _c.Parent.OnUpdateGroupChannel(channelURL, updateChannelRequest)
 }
 
+func (_c *channelUpdateGroupChannelCall) OnViewNumberOfUndeliveredMembers(channelURL string, messageID int) *channelViewNumberOfUndeliveredMembersCall {
+	return _c.Parent.OnViewNumberOfUndeliveredMembers(channelURL, messageID)
+}
+
 func (_c *channelUpdateGroupChannelCall) OnCreateGroupChannelRaw(createChannelRequest interface{}) *channelCreateGroupChannelCall {
 	return _c.Parent.OnCreateGroupChannelRaw(createChannelRequest)
 }
 
+func (_c *channelUpdateGroupChannelCall) OnGetReadStatusRaw(channelURL interface{}, userIDs interface{}) *channelGetReadStatusCall {
+	return _c.Parent.OnGetReadStatusRaw(channelURL, userIDs)
+}
+
+func (_c *channelUpdateGroupChannelCall) OnGetUnreadMemberCountRaw(channelURL interface{}, messageID interface{}) *channelGetUnreadMemberCountCall {
+	return _c.Parent.OnGetUnreadMemberCountRaw(channelURL, messageID)
+}
+
 func (_c *channelUpdateGroupChannelCall) OnListGroupChannelsRaw(listChannelRequest interface{}) *channelListGroupChannelsCall {
 	return _c.Parent.OnListGroupChannelsRaw(listChannelRequest)
 }
 
+func (_c *channelUpdateGroupChannelCall) OnListGroupChannelsStreamRaw(listChannelRequest interface{}, fn interface{}) *channelListGroupChannelsStreamCall {
+	return _c.Parent.OnListGroupChannelsStreamRaw(listChannelRequest, fn)
+}
+
+func (_c *channelUpdateGroupChannelCall) OnMarkAsDeliveredRaw(channelURL interface{}, userID interface{}) *channelMarkAsDeliveredCall {
+	return _c.Parent.OnMarkAsDeliveredRaw(channelURL, userID)
+}
+
 func (_c *channelUpdateGroupChannelCall) OnMarkAsReadRaw(channelURL interface{}, userID interface{}) *channelMarkAsReadCall {
 	return _c.Parent.OnMarkAsReadRaw(channelURL, userID)
 }
 
+func (_c *channelUpdateGroupChannelCall) OnResetChatHistoryRaw(channelURL interface{}, resetChatHistoryRequest interface{}) *channelResetChatHistoryCall {
+	return _c.Parent.OnResetChatHistoryRaw(channelURL, resetChatHistoryRequest)
+}
+
 func (_c *channelUpdateGroupChannelCall) OnStartTypingRaw(channelURL interface{}, userIDs interface{}) *channelStartTypingCall {
 	return _c.Parent.OnStartTypingRaw(channelURL, userIDs)
 }
@@ -817,3 +2006,188 @@ func (_c *channelUpdateGroupChannelCall) OnStopTypingRaw(channelURL interface{},
 func (_c *channelUpdateGroupChannelCall) OnUpdateGroupChannelRaw(channelURL interface{}, updateChannelRequest interface{}) *channelUpdateGroupChannelCall {
 	return _c.Parent.OnUpdateGroupChannelRaw(channelURL, updateChannelRequest)
 }
+
+func (_c *channelUpdateGroupChannelCall) OnViewNumberOfUndeliveredMembersRaw(channelURL interface{}, messageID interface{}) *channelViewNumberOfUndeliveredMembersCall {
+	return _c.Parent.OnViewNumberOfUndeliveredMembersRaw(channelURL, messageID)
+}
+
+func (_m *channelMock) ViewNumberOfUndeliveredMembers(_ context.Context, channelURL string, messageID int) (*ViewNumberOfUndeliveredMembersResponse, error) {
+	_ret := _m.Called(channelURL, messageID)
+
+	if _rf, ok := _ret.Get(0).(func(string, int) (*ViewNumberOfUndeliveredMembersResponse, error)); ok {
+		return _rf(channelURL, messageID)
+	}
+
+	_ra0, _ := _ret.Get(0).(*ViewNumberOfUndeliveredMembersResponse)
+	_rb1 := _ret.Error(1)
+
+	return _ra0, _rb1
+}
+
+func (_m *channelMock) OnViewNumberOfUndeliveredMembers(channelURL string, messageID int) *channelViewNumberOfUndeliveredMembersCall {
+	return &channelViewNumberOfUndeliveredMembersCall{Call: _m.Mock.On("ViewNumberOfUndeliveredMembers", channelURL, messageID), Parent: _m}
+}
+
+func (_m *channelMock) OnViewNumberOfUndeliveredMembersRaw(channelURL interface{}, messageID interface{}) *channelViewNumberOfUndeliveredMembersCall {
+	return &channelViewNumberOfUndeliveredMembersCall{Call: _m.Mock.On("ViewNumberOfUndeliveredMembers", channelURL, messageID), Parent: _m}
+}
+
+type channelViewNumberOfUndeliveredMembersCall struct {
+	*mock.Call
+	Parent *channelMock
+}
+
+func (_c *channelViewNumberOfUndeliveredMembersCall) Panic(msg string) *channelViewNumberOfUndeliveredMembersCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *channelViewNumberOfUndeliveredMembersCall) Once() *channelViewNumberOfUndeliveredMembersCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *channelViewNumberOfUndeliveredMembersCall) Twice() *channelViewNumberOfUndeliveredMembersCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *channelViewNumberOfUndeliveredMembersCall) Times(i int) *channelViewNumberOfUndeliveredMembersCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *channelViewNumberOfUndeliveredMembersCall) WaitUntil(w <-chan time.Time) *channelViewNumberOfUndeliveredMembersCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *channelViewNumberOfUndeliveredMembersCall) After(d time.Duration) *channelViewNumberOfUndeliveredMembersCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *channelViewNumberOfUndeliveredMembersCall) Run(fn func(args mock.Arguments)) *channelViewNumberOfUndeliveredMembersCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *channelViewNumberOfUndeliveredMembersCall) Maybe() *channelViewNumberOfUndeliveredMembersCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *channelViewNumberOfUndeliveredMembersCall) TypedReturns(a *ViewNumberOfUndeliveredMembersResponse, b error) *channelViewNumberOfUndeliveredMembersCall {
+	_c.Call = _c.Return(a, b)
+	return _c
+}
+
+func (_c *channelViewNumberOfUndeliveredMembersCall) ReturnsFn(fn func(string, int) (*ViewNumberOfUndeliveredMembersResponse, error)) *channelViewNumberOfUndeliveredMembersCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *channelViewNumberOfUndeliveredMembersCall) TypedRun(fn func(string, int)) *channelViewNumberOfUndeliveredMembersCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_channelURL := args.String(0)
+		_messageID := args.Int(1)
+		fn(_channelURL, _messageID)
+	})
+	return _c
+}
+
+func (_c *channelViewNumberOfUndeliveredMembersCall) OnCreateGroupChannel(createChannelRequest CreateGroupChannelRequest) *channelCreateGroupChannelCall {
+	return _c.Parent.OnCreateGroupChannel(createChannelRequest)
+}
+
+func (_c *channelViewNumberOfUndeliveredMembersCall) OnGetReadStatus(channelURL string, userIDs []string) *channelGetReadStatusCall {
+	return _c.Parent.OnGetReadStatus(channelURL, userIDs)
+}
+
+func (_c *channelViewNumberOfUndeliveredMembersCall) OnGetUnreadMemberCount(channelURL string, messageID int) *channelGetUnreadMemberCountCall {
+	return _c.Parent.OnGetUnreadMemberCount(channelURL, messageID)
+}
+
+func (_c *channelViewNumberOfUndeliveredMembersCall) OnListGroupChannels(listChannelRequest ListGroupChannelRequest) *channelListGroupChannelsCall {
+	return _c.Parent.OnListGroupChannels(listChannelRequest)
+}
+
+func (_c *channelViewNumberOfUndeliveredMembersCall) OnListGroupChannelsStream(listChannelRequest ListGroupChannelRequest, fn func(ChannelResource) error) *channelListGroupChannelsStreamCall {
+	return _c.Parent.OnListGroupChannelsStream(listChannelRequest, fn)
+}
+
+func (_c *channelViewNumberOfUndeliveredMembersCall) OnMarkAsDelivered(channelURL string, userID string) *channelMarkAsDeliveredCall {
+	return _c.Parent.OnMarkAsDelivered(channelURL, userID)
+}
+
+func (_c *channelViewNumberOfUndeliveredMembersCall) OnMarkAsRead(channelURL string, userID string) *channelMarkAsReadCall {
+	return _c.Parent.OnMarkAsRead(channelURL, userID)
+}
+
+func (_c *channelViewNumberOfUndeliveredMembersCall) OnResetChatHistory(channelURL string, resetChatHistoryRequest ResetChatHistoryRequest) *channelResetChatHistoryCall {
+	return _c.Parent.OnResetChatHistory(channelURL, resetChatHistoryRequest)
+}
+
+func (_c *channelViewNumberOfUndeliveredMembersCall) OnStartTyping(channelURL string, userIDs []string) *channelStartTypingCall {
+	return _c.Parent.OnStartTyping(channelURL, userIDs)
+}
+
+func (_c *channelViewNumberOfUndeliveredMembersCall) OnStopTyping(channelURL string, userIDs []string) *channelStopTypingCall {
+	return _c.Parent.OnStopTyping(channelURL, userIDs)
+}
+
+func (_c *channelViewNumberOfUndeliveredMembersCall) OnUpdateGroupChannel(channelURL string, updateChannelRequest UpdateGroupChannelRequest) *channelUpdateGroupChannelCall {
+	return _c.Parent.OnUpdateGroupChannel(channelURL, updateChannelRequest)
+}
+
+func (_c *channelViewNumberOfUndeliveredMembersCall) OnViewNumberOfUndeliveredMembers(channelURL string, messageID int) *channelViewNumberOfUndeliveredMembersCall {
+	return _c.Parent.OnViewNumberOfUndeliveredMembers(channelURL, messageID)
+}
+
+func (_c *channelViewNumberOfUndeliveredMembersCall) OnCreateGroupChannelRaw(createChannelRequest interface{}) *channelCreateGroupChannelCall {
+	return _c.Parent.OnCreateGroupChannelRaw(createChannelRequest)
+}
+
+func (_c *channelViewNumberOfUndeliveredMembersCall) OnGetReadStatusRaw(channelURL interface{}, userIDs interface{}) *channelGetReadStatusCall {
+	return _c.Parent.OnGetReadStatusRaw(channelURL, userIDs)
+}
+
+func (_c *channelViewNumberOfUndeliveredMembersCall) OnGetUnreadMemberCountRaw(channelURL interface{}, messageID interface{}) *channelGetUnreadMemberCountCall {
+	return _c.Parent.OnGetUnreadMemberCountRaw(channelURL, messageID)
+}
+
+func (_c *channelViewNumberOfUndeliveredMembersCall) OnListGroupChannelsRaw(listChannelRequest interface{}) *channelListGroupChannelsCall {
+	return _c.Parent.OnListGroupChannelsRaw(listChannelRequest)
+}
+
+func (_c *channelViewNumberOfUndeliveredMembersCall) OnListGroupChannelsStreamRaw(listChannelRequest interface{}, fn interface{}) *channelListGroupChannelsStreamCall {
+	return _c.Parent.OnListGroupChannelsStreamRaw(listChannelRequest, fn)
+}
+
+func (_c *channelViewNumberOfUndeliveredMembersCall) OnMarkAsDeliveredRaw(channelURL interface{}, userID interface{}) *channelMarkAsDeliveredCall {
+	return _c.Parent.OnMarkAsDeliveredRaw(channelURL, userID)
+}
+
+func (_c *channelViewNumberOfUndeliveredMembersCall) OnMarkAsReadRaw(channelURL interface{}, userID interface{}) *channelMarkAsReadCall {
+	return _c.Parent.OnMarkAsReadRaw(channelURL, userID)
+}
+
+func (_c *channelViewNumberOfUndeliveredMembersCall) OnResetChatHistoryRaw(channelURL interface{}, resetChatHistoryRequest interface{}) *channelResetChatHistoryCall {
+	return _c.Parent.OnResetChatHistoryRaw(channelURL, resetChatHistoryRequest)
+}
+
+func (_c *channelViewNumberOfUndeliveredMembersCall) OnStartTypingRaw(channelURL interface{}, userIDs interface{}) *channelStartTypingCall {
+	return _c.Parent.OnStartTypingRaw(channelURL, userIDs)
+}
+
+func (_c *channelViewNumberOfUndeliveredMembersCall) OnStopTypingRaw(channelURL interface{}, userIDs interface{}) *channelStopTypingCall {
+	return _c.Parent.OnStopTypingRaw(channelURL, userIDs)
+}
+
+func (_c *channelViewNumberOfUndeliveredMembersCall) OnUpdateGroupChannelRaw(channelURL interface{}, updateChannelRequest interface{}) *channelUpdateGroupChannelCall {
+	return _c.Parent.OnUpdateGroupChannelRaw(channelURL, updateChannelRequest)
+}
+
+func (_c *channelViewNumberOfUndeliveredMembersCall) OnViewNumberOfUndeliveredMembersRaw(channelURL interface{}, messageID interface{}) *channelViewNumberOfUndeliveredMembersCall {
+	return _c.Parent.OnViewNumberOfUndeliveredMembersRaw(channelURL, messageID)
+}
@@ -0,0 +1,116 @@
+package channel
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// GetReadStatusResponse is the response to get the read status of a group
+// channel.
+type GetReadStatusResponse struct {
+	// ReadReceipts maps the ID of each member to the timestamp of when they
+	// last read the messages in the channel, in Unix milliseconds.
+	ReadReceipts map[string]int64
+	// DeliveryReceipts maps the ID of each member to the timestamp of when they
+	// last received the messages in the channel from the Sendbird server, in
+	// Unix milliseconds.
+	DeliveryReceipts map[string]int64
+}
+
+// GetUnreadMemberCountResponse is the response to get the number of members
+// who haven't read a message.
+type GetUnreadMemberCountResponse struct {
+	// UnreadMemberCount is the number of members who haven't read the message
+	// yet.
+	UnreadMemberCount int
+}
+
+// getChannelWithReceipts retrieves a group channel along with its members and
+// their read and delivery receipts.
+// See https://sendbird.com/docs/chat/platform-api/v3/channel/listing-channels-in-an-application/get-a-group-channel
+func (c *channel) getChannelWithReceipts(ctx context.Context, channelURL string) (*ChannelResource, error) {
+	u, err := url.Parse("/group_channels/" + channelURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse URL: %w", err)
+	}
+
+	query := u.Query()
+	query.Set("show_member", "true")
+	query.Set("show_read_receipt", "true")
+	query.Set("show_delivery_receipt", "true")
+
+	u.RawQuery = query.Encode()
+
+	gcr, err := c.client.Get(ctx, u.String(), nil, &ChannelResource{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get channel: %w", err)
+	}
+
+	channelResource, ok := gcr.(*ChannelResource)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast body to ChannelResource: %+v", gcr)
+	}
+
+	return channelResource, nil
+}
+
+// GetReadStatus retrieves the read and delivery receipts of the members of a
+// group channel. If userIDs is not empty, only the receipts of the
+// corresponding members are returned.
+// See https://sendbird.com/docs/chat/platform-api/v3/message/read-receipts/read-receipts-overview
+func (c *channel) GetReadStatus(ctx context.Context, channelURL string, userIDs []string) (*GetReadStatusResponse, error) {
+	channelResource, err := c.getChannelWithReceipts(ctx, channelURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get read status: %w", err)
+	}
+
+	getReadStatusResponse := &GetReadStatusResponse{
+		ReadReceipts:     channelResource.ReadReceipt,
+		DeliveryReceipts: channelResource.DeliveryReceipt,
+	}
+
+	if len(userIDs) == 0 {
+		return getReadStatusResponse, nil
+	}
+
+	getReadStatusResponse.ReadReceipts = make(map[string]int64, len(userIDs))
+	getReadStatusResponse.DeliveryReceipts = make(map[string]int64, len(userIDs))
+
+	for _, userID := range userIDs {
+		if ts, ok := channelResource.ReadReceipt[userID]; ok {
+			getReadStatusResponse.ReadReceipts[userID] = ts
+		}
+
+		if ts, ok := channelResource.DeliveryReceipt[userID]; ok {
+			getReadStatusResponse.DeliveryReceipts[userID] = ts
+		}
+	}
+
+	return getReadStatusResponse, nil
+}
+
+// unreadCountResponse is the body of the response to get the number of
+// members who haven't read a message.
+type unreadCountResponse struct {
+	UnreadCount int `json:"unread_count"`
+}
+
+// GetUnreadMemberCount retrieves the number of members of a group channel who
+// haven't read a specific message yet.
+// See https://sendbird.com/docs/chat/platform-api/v3/message/read-receipts/view-number-of-unread-members
+func (c *channel) GetUnreadMemberCount(ctx context.Context, channelURL string, messageID int) (*GetUnreadMemberCountResponse, error) {
+	path := fmt.Sprintf("/group_channels/%s/messages/%d/unread_count", channelURL, messageID)
+
+	ucr, err := c.client.Get(ctx, path, nil, &unreadCountResponse{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get unread member count: %w", err)
+	}
+
+	unreadCount, ok := ucr.(*unreadCountResponse)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast body to unreadCountResponse: %+v", ucr)
+	}
+
+	return &GetUnreadMemberCountResponse{UnreadMemberCount: unreadCount.UnreadCount}, nil
+}
@@ -0,0 +1,76 @@
+package channel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yumi-ia/sendbird-go/pkg/client"
+)
+
+const channelWithReceiptsURL = "/group_channels/channel-url?show_delivery_receipt=true&show_member=true&show_read_receipt=true"
+
+func channelWithReceipts() *ChannelResource {
+	return &ChannelResource{
+		ChannelURL: "channel-url",
+		Members: []Member{
+			{UserID: "sender"},
+			{UserID: "reader"},
+			{UserID: "late-reader"},
+			{UserID: "never-reader"},
+		},
+		ReadReceipt: map[string]int64{
+			"sender":      100,
+			"reader":      100,
+			"late-reader": 42,
+		},
+		DeliveryReceipt: map[string]int64{
+			"sender":      100,
+			"reader":      100,
+			"late-reader": 100,
+		},
+	}
+}
+
+func TestGetReadStatus(t *testing.T) {
+	t.Parallel()
+
+	client := client.NewClientMock(t).
+		OnGet(channelWithReceiptsURL, nil, &ChannelResource{}).TypedReturns(channelWithReceipts(), nil).Once().
+		Parent
+	channel := NewChannel(client)
+
+	cur, err := channel.GetReadStatus(context.Background(), "channel-url", nil)
+	require.NoError(t, err)
+	assert.Equal(t, channelWithReceipts().ReadReceipt, cur.ReadReceipts)
+	assert.Equal(t, channelWithReceipts().DeliveryReceipt, cur.DeliveryReceipts)
+}
+
+func TestGetReadStatus_filtered(t *testing.T) {
+	t.Parallel()
+
+	client := client.NewClientMock(t).
+		OnGet(channelWithReceiptsURL, nil, &ChannelResource{}).TypedReturns(channelWithReceipts(), nil).Once().
+		Parent
+	channel := NewChannel(client)
+
+	cur, err := channel.GetReadStatus(context.Background(), "channel-url", []string{"late-reader", "never-reader"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int64{"late-reader": 42}, cur.ReadReceipts)
+	assert.Equal(t, map[string]int64{"late-reader": 100}, cur.DeliveryReceipts)
+}
+
+func TestGetUnreadMemberCount(t *testing.T) {
+	t.Parallel()
+
+	client := client.NewClientMock(t).
+		OnGet("/group_channels/channel-url/messages/42/unread_count", nil, &unreadCountResponse{}).
+		TypedReturns(&unreadCountResponse{UnreadCount: 2}, nil).Once().
+		Parent
+	channel := NewChannel(client)
+
+	cur, err := channel.GetUnreadMemberCount(context.Background(), "channel-url", 42)
+	require.NoError(t, err)
+	assert.Equal(t, 2, cur.UnreadMemberCount)
+}
@@ -0,0 +1,59 @@
+package channel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ResetChatHistoryRequest is the request to reset the chat history of a group
+// channel.
+type ResetChatHistoryRequest struct {
+	// UserID specifies the ID of the user whose chat history is reset. Required
+	// unless ResetAll is true.
+	UserID string `json:"user_id,omitempty"`
+	// ResetAll determines whether to reset the chat history of every member of
+	// the channel.
+	// Optional. (Default: false)
+	ResetAll bool `json:"reset_all,omitempty"`
+}
+
+func (rchr *ResetChatHistoryRequest) Validate() error {
+	if rchr.UserID == "" && !rchr.ResetAll {
+		return errors.New("user ID is required unless resetting all members")
+	}
+
+	return nil
+}
+
+// ResetChatHistoryResponse is the response to reset the chat history of a
+// group channel.
+type ResetChatHistoryResponse struct {
+	// TSMessageOffset is the time, in Unix milliseconds, before which the
+	// messages are no longer visible to the user.
+	TSMessageOffset int64 `json:"ts_message_offset"`
+}
+
+// ResetChatHistory resets the chat history of a user in a group channel, or of
+// every member if ResetAll is set. The messages sent before the reset are no
+// longer visible to the user, but are not deleted from the channel.
+// See https://sendbird.com/docs/chat/platform-api/v3/channel/managing-a-channel/reset-chat-history
+func (c *channel) ResetChatHistory(ctx context.Context, channelURL string, resetChatHistoryRequest ResetChatHistoryRequest) (*ResetChatHistoryResponse, error) {
+	if err := resetChatHistoryRequest.Validate(); err != nil {
+		return nil, fmt.Errorf("failed to validate reset chat history request: %w", err)
+	}
+
+	path := fmt.Sprintf("/group_channels/%s/reset_user_history", channelURL)
+
+	rchr, err := c.client.Put(ctx, path, resetChatHistoryRequest, &ResetChatHistoryResponse{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to reset chat history: %w", err)
+	}
+
+	resetChatHistoryResponse, ok := rchr.(*ResetChatHistoryResponse)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast body to ResetChatHistoryResponse: %+v", rchr)
+	}
+
+	return resetChatHistoryResponse, nil
+}
@@ -0,0 +1,49 @@
+package channel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yumi-ia/sendbird-go/pkg/client"
+)
+
+func TestResetChatHistory(t *testing.T) {
+	t.Parallel()
+
+	resetChatHistoryRequest := ResetChatHistoryRequest{UserID: "user-id"}
+	resetChatHistoryResponse := &ResetChatHistoryResponse{TSMessageOffset: 1700000000000}
+
+	client := client.NewClientMock(t).
+		OnPut("/group_channels/channel-url/reset_user_history", resetChatHistoryRequest, &ResetChatHistoryResponse{}).TypedReturns(resetChatHistoryResponse, nil).Once().
+		Parent
+	channel := NewChannel(client)
+
+	rchr, err := channel.ResetChatHistory(context.Background(), "channel-url", resetChatHistoryRequest)
+	require.NoError(t, err)
+	assert.Equal(t, resetChatHistoryResponse, rchr)
+}
+
+func TestResetChatHistory_resetAll(t *testing.T) {
+	t.Parallel()
+
+	resetChatHistoryRequest := ResetChatHistoryRequest{ResetAll: true}
+
+	client := client.NewClientMock(t).
+		OnPut("/group_channels/channel-url/reset_user_history", resetChatHistoryRequest, &ResetChatHistoryResponse{}).TypedReturns(&ResetChatHistoryResponse{}, nil).Once().
+		Parent
+	channel := NewChannel(client)
+
+	_, err := channel.ResetChatHistory(context.Background(), "channel-url", resetChatHistoryRequest)
+	require.NoError(t, err)
+}
+
+func TestResetChatHistory_invalid(t *testing.T) {
+	t.Parallel()
+
+	channel := NewChannel(client.NewClientMock(t))
+
+	_, err := channel.ResetChatHistory(context.Background(), "channel-url", ResetChatHistoryRequest{})
+	require.Error(t, err)
+}
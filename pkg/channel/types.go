@@ -88,6 +88,8 @@ type ChannelResource struct {
 	MemberCount            int                     `json:"member_count"`
 	JoinedMemberCount      int                     `json:"joined_member_count"`
 	UnreadMentionCount     int                     `json:"unread_mention_count"`
+	ReadReceipt            map[string]int64        `json:"read_receipt"`
+	DeliveryReceipt        map[string]int64        `json:"delivery_receipt"`
 	CreatedBy              CreatedBy               `json:"created_by"`
 	Members                []Member                `json:"members"`
 	Operators              []Operator              `json:"operators"`
@@ -10,6 +10,9 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"time"
+
+	"github.com/yumi-ia/sendbird-go/pkg/utils/wait"
 )
 
 // Client is the interface for the client of the sendbird API.
@@ -36,12 +39,33 @@ func NewClient(opts ...Option) Client {
 	return cfg
 }
 
+// NewClientWithValidation creates a new client for the sendbird API, returning
+// the error of the first invalid option, if any.
+func NewClientWithValidation(opts ...Option) (Client, error) {
+	cfg := &client{}
+	cfg.SetDefault()
+
+	for _, opt := range opts {
+		cfg = opt(cfg)
+	}
+
+	if cfg.err != nil {
+		return nil, fmt.Errorf("invalid option: %w", cfg.err)
+	}
+
+	return cfg, nil
+}
+
 // do send a request to the sendbird API.
 func (c *client) do(ctx context.Context, method, path string, obj any, resp any) (any, error) {
+	if c.err != nil {
+		return nil, fmt.Errorf("invalid client: %w", c.err)
+	}
+
 	logger := c.logger.With("method", method, "path", path)
 	logger.Debug("do")
 
-	var reqBody io.Reader
+	var body []byte
 
 	if obj != nil {
 		m, err := json.Marshal(obj)
@@ -49,43 +73,115 @@ func (c *client) do(ctx context.Context, method, path string, obj any, resp any)
 			return nil, fmt.Errorf("failed to marshal object: %w", err)
 		}
 
-		reqBody = bytes.NewReader(m)
+		body = m
 	}
 
 	u := c.getURL(path)
 
 	logger = logger.With("url", u.Redacted())
 
-	req, err := http.NewRequestWithContext(ctx, method, u.String(), reqBody)
+	ctx, span, attrs := c.telemetry.start(ctx, method, path, u.Host)
+	start := time.Now()
+
+	var (
+		result any
+		status int
+		err    error
+	)
+
+	for attempt := 0; ; attempt++ {
+		result, status, err = c.send(ctx, method, u, body, resp)
+		if err == nil || attempt >= c.maxRetries || !c.canRetry(method) || !isRetryable(err) {
+			break
+		}
+
+		c.telemetry.retry(ctx, span, attrs, attempt+1, err)
+		logger.Warn("retrying request", "attempt", attempt+1, "error", err)
+
+		if werr := wait.Sleep(ctx, wait.Exponential(c.retryBackoff, attempt, maxRetryBackoff)); werr != nil {
+			err = fmt.Errorf("failed to wait before retrying: %w", werr)
+
+			break
+		}
+	}
+
+	c.telemetry.end(ctx, span, attrs, time.Since(start).Seconds(), status, err)
+
+	logger = logger.With("status", status)
+
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+		logger.Debug("request failed", "error", err)
+
+		return nil, err
+	}
+
+	if result != nil {
+		logger = logger.With("response", result)
 	}
 
-	req.Header = c.header
+	logger.Debug("request succeeded")
+
+	return result, nil
+}
+
+// send sends a single request to the sendbird API and returns the decoded
+// response along with the status code.
+func (c *client) send(ctx context.Context, method string, u *url.URL, body []byte, resp any) (any, int, error) {
+	if c.limiter != nil {
+		if err := c.limiter.Wait(ctx); err != nil {
+			return nil, 0, fmt.Errorf("failed to wait for rate limit: %w", err)
+		}
+	}
+
+	var reqBody io.Reader
+	if body != nil {
+		reqBody = bytes.NewReader(body)
+	}
 
-	r, err := c.httpClient.Do(req)
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), reqBody)
 	if err != nil {
-		return nil, fmt.Errorf("failed to do request: %w", err)
+		return nil, 0, fmt.Errorf("failed to create request: %w", err)
 	}
-	defer r.Body.Close()
 
-	logger = logger.With("status", r.StatusCode)
+	c.headerMu.RLock()
+	req.Header = c.header.Clone()
+	c.headerMu.RUnlock()
+
+	r, err := c.chain().RoundTrip(req)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to do request: %w", err)
+	}
+	defer closeBody(r.Body)
 
 	if r.StatusCode < 200 || r.StatusCode >= 300 {
-		return nil, c.handleError(r.StatusCode, r.Body)
+		return nil, r.StatusCode, c.handleError(r.StatusCode, r.Body)
 	}
 
-	if resp != nil {
+	if sd, ok := resp.(StreamDecoder); ok {
+		if err := sd.DecodeStream(json.NewDecoder(r.Body)); err != nil {
+			return nil, r.StatusCode, fmt.Errorf("failed to decode response: %w", err)
+		}
+	} else if resp != nil {
 		if err := json.NewDecoder(r.Body).Decode(resp); err != nil {
-			return nil, fmt.Errorf("failed to decode response: %w", err)
+			return nil, r.StatusCode, fmt.Errorf("failed to decode response: %w", err)
 		}
-
-		logger = logger.With("response", resp)
 	}
 
-	logger.Debug("request succeeded")
+	return resp, r.StatusCode, nil
+}
+
+// closeBody discards what is left of body, up to maxDrainSize bytes, before
+// closing it, so that the connection can be reused when a stream is stopped
+// before the end of the body.
+func closeBody(body io.ReadCloser) {
+	_, _ = io.Copy(io.Discard, io.LimitReader(body, maxDrainSize))
+	_ = body.Close()
+}
 
-	return resp, nil
+// canRetry reports whether a request with the given method can be retried.
+// POST requests are not idempotent, retrying them could create duplicates.
+func (c *client) canRetry(method string) bool {
+	return method != http.MethodPost || c.retryPost
 }
 
 // Get sends a GET request to the sendbird API.
@@ -108,6 +204,14 @@ func (c *client) Delete(ctx context.Context, path string, obj any, resp any) (an
 	return c.do(ctx, http.MethodDelete, path, obj, resp)
 }
 
+// setAPIToken replaces the API token of the client.
+func (c *client) setAPIToken(apiToken string) {
+	c.headerMu.Lock()
+	defer c.headerMu.Unlock()
+
+	c.header.Set("Api-Token", apiToken)
+}
+
 func (c *client) getURL(path string) *url.URL {
 	uu, err := url.Parse(path)
 	if err != nil {
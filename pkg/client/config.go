@@ -5,6 +5,14 @@ import (
 	"log/slog"
 	"net/http"
 	"net/url"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/metric"
+	metricnoop "go.opentelemetry.io/otel/metric/noop"
+	"go.opentelemetry.io/otel/trace"
+	tracenoop "go.opentelemetry.io/otel/trace/noop"
+	"golang.org/x/time/rate"
 )
 
 // client is the implementation of the Client interface.
@@ -19,6 +27,32 @@ type client struct {
 	// header is the header of the client.
 	// See https://sendbird.com/docs/chat/platform-api/v3/prepare-to-use-api#2-headers
 	header http.Header
+	// headerMu guards header once the client is built, as the API token can be
+	// rotated at runtime.
+	headerMu sync.RWMutex
+	// tracerProvider is the OpenTelemetry tracer provider of the client.
+	tracerProvider trace.TracerProvider
+	// meterProvider is the OpenTelemetry meter provider of the client.
+	meterProvider metric.MeterProvider
+	// telemetry holds the instruments created from the providers.
+	telemetry *telemetry
+	// maxRetries is the maximum number of retries of a request failing with
+	// a rate limit or a temporary unavailability of the server. POST requests
+	// are only retried if retryPost is set.
+	maxRetries int
+	// retryBackoff is the delay before the first retry, doubled on each retry
+	// up to maxRetryBackoff.
+	retryBackoff time.Duration
+	// retryPost determines whether to retry the POST requests too.
+	retryPost bool
+	// middlewares are the middlewares wrapping the requests, the first one
+	// being the outermost.
+	middlewares []Middleware
+	// limiter limits the rate of the requests sent, if any.
+	limiter *rate.Limiter
+	// err is the first error of the options, if any. It is returned by
+	// NewClientWithValidation and by every request.
+	err error
 }
 
 func (c *client) SetDefault() {
@@ -30,8 +64,20 @@ func (c *client) SetDefault() {
 	}
 	c.header = http.Header{}
 	c.header.Set("Content-Type", "application/json; charset=utf-8")
+	c.tracerProvider = tracenoop.NewTracerProvider()
+	c.meterProvider = metricnoop.NewMeterProvider()
+	c.telemetry = defaultTelemetry()
+	c.maxRetries = 0
+	c.retryBackoff = time.Second
+	c.retryPost = false
+	c.middlewares = nil
+	c.limiter = nil
+	c.err = nil
 }
 
+// maxRetryBackoff is the maximum delay between two retries.
+const maxRetryBackoff = 30 * time.Second
+
 // Option is the interface for the options of the client.
 type Option func(client *client) *client
 
@@ -80,12 +126,17 @@ func WithPath(path string) Option {
 	}
 }
 
-// WithURL is the option for the url of the client.
+// WithURL is the option for the url of the client. An invalid url is
+// returned by NewClientWithValidation and by every request of the client.
 func WithURL(u string) Option {
 	return func(client *client) *client {
 		baseURL, err := url.Parse(u)
 		if err != nil {
-			panic(fmt.Sprintf("invalid url: %v", err))
+			if client.err == nil {
+				client.err = fmt.Errorf("invalid url %q: %w", u, err)
+			}
+
+			return client
 		}
 
 		client.baseURL = baseURL
@@ -111,3 +162,74 @@ func WithAPPID(appID string) Option {
 		return client
 	}
 }
+
+// WithTracerProvider is the option for the OpenTelemetry tracer provider of the
+// client. Every request is wrapped in a span. (Default: no-op)
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return func(client *client) *client {
+		client.tracerProvider = tp
+		client.telemetry = newTelemetry(client.tracerProvider, client.meterProvider)
+
+		return client
+	}
+}
+
+// WithMeterProvider is the option for the OpenTelemetry meter provider of the
+// client. The latency, errors and retries of the requests are recorded.
+// (Default: no-op)
+func WithMeterProvider(mp metric.MeterProvider) Option {
+	return func(client *client) *client {
+		client.meterProvider = mp
+		client.telemetry = newTelemetry(client.tracerProvider, client.meterProvider)
+
+		return client
+	}
+}
+
+// WithRetry is the option for the retries of the client. A GET, PUT or DELETE
+// request failing with ErrAPITooManyRequests or ErrAPIServiceUnavailable is
+// retried up to maxRetries times, waiting backoff before the first retry and
+// doubling it on each retry, up to 30s. POST requests are only retried with
+// WithRetryPost. (Default: no retry)
+func WithRetry(maxRetries int, backoff time.Duration) Option {
+	return func(client *client) *client {
+		client.maxRetries = maxRetries
+		client.retryBackoff = backoff
+
+		return client
+	}
+}
+
+// WithRetryPost is the option to retry the POST requests too, such as the
+// ones sending messages or creating users. As they are not idempotent, a
+// retried request may be processed twice by the server. (Default: false)
+func WithRetryPost(retryPost bool) Option {
+	return func(client *client) *client {
+		client.retryPost = retryPost
+
+		return client
+	}
+}
+
+// WithMiddleware is the option for the middlewares of the client. The
+// middlewares are appended to the ones already set, the first one being the
+// outermost. They are called for each attempt of a request.
+func WithMiddleware(middlewares ...Middleware) Option {
+	return func(client *client) *client {
+		client.middlewares = append(client.middlewares, middlewares...)
+
+		return client
+	}
+}
+
+// WithRateLimit is the option for the rate limit of the client. At most
+// requestsPerSecond requests are sent per second, with bursts of up to burst
+// requests. Each retry counts as a request. (Default: no limit)
+// See https://sendbird.com/docs/chat/platform-api/v3/application/understanding-rate-limits/rate-limits
+func WithRateLimit(requestsPerSecond float64, burst int) Option {
+	return func(client *client) *client {
+		client.limiter = rate.NewLimiter(rate.Limit(requestsPerSecond), burst)
+
+		return client
+	}
+}
@@ -2,11 +2,16 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"log/slog"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 )
 
 func TestSetDefault(t *testing.T) {
@@ -84,6 +89,26 @@ func TestWithURL(t *testing.T) {
 	assert.Equal(t, "grcp", client.baseURL.Scheme)
 	assert.Equal(t, "example.org:8080", client.baseURL.Host)
 	assert.Equal(t, "/foo/bar", client.baseURL.Path)
+	require.NoError(t, client.err)
+
+	client = WithURL("::")(client)
+	assert.Equal(t, "example.org:8080", client.baseURL.Host)
+	require.ErrorContains(t, client.err, `invalid url "::"`)
+}
+
+func TestNewClientWithValidation(t *testing.T) {
+	t.Parallel()
+
+	c, err := NewClientWithValidation(WithURL("https://example.org/v3"))
+	require.NoError(t, err)
+	assert.NotNil(t, c)
+
+	_, err = NewClientWithValidation(WithURL("::"))
+	require.ErrorContains(t, err, `invalid option: invalid url "::"`)
+
+	// The clients created by NewClient return the error on every request.
+	_, err = NewClient(WithURL("::")).Get(context.Background(), "/users", nil, nil)
+	require.ErrorContains(t, err, `invalid client: invalid url "::"`)
 }
 
 func TestWithAPIToken(t *testing.T) {
@@ -106,3 +131,62 @@ func TestWithAPPID(t *testing.T) {
 	client = WithAPPID("api-id")(client)
 	assert.Equal(t, "api-api-id.sendbird.com", client.baseURL.Host)
 }
+
+func TestWithRetry(t *testing.T) {
+	t.Parallel()
+
+	client := &client{}
+	client.SetDefault()
+	assert.Equal(t, 0, client.maxRetries)
+
+	client = WithRetry(3, time.Millisecond)(client)
+	assert.Equal(t, 3, client.maxRetries)
+	assert.Equal(t, time.Millisecond, client.retryBackoff)
+}
+
+func TestWithTracerProvider(t *testing.T) {
+	t.Parallel()
+
+	tp := sdktrace.NewTracerProvider()
+
+	client := &client{}
+	client.SetDefault()
+
+	client = WithTracerProvider(tp)(client)
+	assert.Equal(t, tp, client.tracerProvider)
+}
+
+func TestWithMeterProvider(t *testing.T) {
+	t.Parallel()
+
+	mp := sdkmetric.NewMeterProvider()
+
+	client := &client{}
+	client.SetDefault()
+
+	client = WithMeterProvider(mp)(client)
+	assert.Equal(t, mp, client.meterProvider)
+}
+
+func TestWithMiddleware(t *testing.T) {
+	t.Parallel()
+
+	client := &client{}
+	client.SetDefault()
+
+	client = WithMiddleware(NewRequestIDMiddleware(nil))(client)
+	client = WithMiddleware(NewAuditMiddleware(nil), NewRequestIDMiddleware(nil))(client)
+	assert.Len(t, client.middlewares, 3)
+}
+
+func TestWithRateLimit(t *testing.T) {
+	t.Parallel()
+
+	client := &client{}
+	client.SetDefault()
+	assert.Nil(t, client.limiter)
+
+	client = WithRateLimit(10, 2)(client)
+	assert.InDelta(t, 10, float64(client.limiter.Limit()), 0)
+	assert.Equal(t, 2, client.limiter.Burst())
+}
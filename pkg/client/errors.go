@@ -137,6 +137,13 @@ func (c *client) handleError(status int, body io.Reader) error {
 		return nil
 	}
 
+	return &codeError{
+		code: handledError.Code,
+		err:  mapError(status, handledError),
+	}
+}
+
+func mapError(status int, handledError Error) error {
 	if err, ok := errorMap[handledError.Code]; ok {
 		return fmt.Errorf("%w: %s", err, handledError.Message)
 	}
@@ -157,3 +164,32 @@ func (c *client) handleError(status int, body io.Reader) error {
 	return fmt.Errorf("unknown error %w: status: %d, code: %d, message: %q",
 		ErrAPIDefault, status, handledError.Code, handledError.Message)
 }
+
+// codeError is an error returned by the sendbird API along with its code.
+type codeError struct {
+	code int
+	err  error
+}
+
+func (e *codeError) Error() string {
+	return e.err.Error()
+}
+
+func (e *codeError) Unwrap() error {
+	return e.err
+}
+
+// errorCode returns the sendbird error code of err, if any.
+func errorCode(err error) (int, bool) {
+	var ce *codeError
+	if !errors.As(err, &ce) {
+		return 0, false
+	}
+
+	return ce.code, true
+}
+
+// isRetryable reports whether a request failing with err can be retried.
+func isRetryable(err error) bool {
+	return errors.Is(err, ErrAPITooManyRequests) || errors.Is(err, ErrAPIServiceUnavailable)
+}
@@ -0,0 +1,287 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Duration is a time.Duration read from a string such as "1.5s" or "300ms".
+type Duration time.Duration
+
+// UnmarshalText parses the duration.
+func (d *Duration) UnmarshalText(text []byte) error {
+	parsed, err := time.ParseDuration(string(text))
+	if err != nil {
+		return fmt.Errorf("invalid duration %q: %w", text, err)
+	}
+
+	*d = Duration(parsed)
+
+	return nil
+}
+
+// MarshalText formats the duration.
+func (d Duration) MarshalText() ([]byte, error) {
+	return []byte(time.Duration(d).String()), nil
+}
+
+// RetryConfig is the retry configuration of the client.
+type RetryConfig struct {
+	// MaxRetries specifies the maximum number of retries of a GET, PUT or
+	// DELETE request failing with a rate limit or a temporary unavailability
+	// of the server. See WithRetry.
+	// Optional. (Default: 0)
+	MaxRetries int `json:"max_retries" yaml:"max_retries"`
+	// Backoff specifies the delay before the first retry, doubled on each
+	// retry. Optional. (Default: 1s)
+	Backoff Duration `json:"backoff" yaml:"backoff"`
+}
+
+// RateLimitConfig is the rate limit configuration of the client.
+type RateLimitConfig struct {
+	// RequestsPerSecond specifies the maximum number of requests sent per
+	// second, retries included. Optional. (Default: no limit)
+	RequestsPerSecond float64 `json:"requests_per_second" yaml:"requests_per_second"`
+	// Burst specifies the maximum number of requests sent at once.
+	// Optional. (Default: 1)
+	Burst int `json:"burst" yaml:"burst"`
+}
+
+// Config is the configuration of a client, as read from the environment or
+// from a file.
+type Config struct {
+	// AppID specifies the ID of the application. Required unless BaseURL is
+	// set.
+	AppID string `json:"app_id" yaml:"app_id"`
+	// APIToken specifies the API token of the application - required.
+	APIToken string `json:"api_token" yaml:"api_token"`
+	// BaseURL specifies the base URL of the sendbird API, such as
+	// https://api-{app_id}.sendbird.com/v3. Optional. (Default: derived from
+	// AppID)
+	BaseURL string `json:"base_url" yaml:"base_url"`
+	// Timeout specifies the timeout of the requests, retries excluded.
+	// Optional. (Default: no timeout)
+	Timeout Duration `json:"timeout" yaml:"timeout"`
+	// Retry specifies the retry configuration. Optional.
+	Retry RetryConfig `json:"retry" yaml:"retry"`
+	// RateLimit specifies the rate limit configuration. Optional.
+	RateLimit RateLimitConfig `json:"rate_limit" yaml:"rate_limit"`
+}
+
+// Validate returns all the errors of the configuration.
+func (c Config) Validate() error {
+	var errs []error
+
+	if c.AppID == "" && c.BaseURL == "" {
+		errs = append(errs, errors.New("app ID or base URL is required"))
+	}
+
+	if c.APIToken == "" {
+		errs = append(errs, errors.New("API token is required"))
+	}
+
+	if c.BaseURL != "" {
+		if u, err := url.Parse(c.BaseURL); err != nil {
+			errs = append(errs, fmt.Errorf("invalid base URL: %w", err))
+		} else if u.Scheme == "" || u.Host == "" {
+			errs = append(errs, fmt.Errorf("invalid base URL %q: scheme and host are required", c.BaseURL))
+		}
+	}
+
+	if c.Timeout < 0 {
+		errs = append(errs, errors.New("timeout must not be negative"))
+	}
+
+	if c.Retry.MaxRetries < 0 {
+		errs = append(errs, errors.New("max retries must not be negative"))
+	}
+
+	if c.Retry.Backoff < 0 {
+		errs = append(errs, errors.New("retry backoff must not be negative"))
+	}
+
+	if c.RateLimit.RequestsPerSecond < 0 {
+		errs = append(errs, errors.New("rate limit requests per second must not be negative"))
+	}
+
+	if c.RateLimit.Burst < 0 {
+		errs = append(errs, errors.New("rate limit burst must not be negative"))
+	}
+
+	return errors.Join(errs...)
+}
+
+// Options returns the options of the client matching the configuration.
+func (c Config) Options() ([]Option, error) {
+	if err := c.Validate(); err != nil {
+		return nil, fmt.Errorf("failed to validate config: %w", err)
+	}
+
+	var opts []Option
+
+	if c.AppID != "" {
+		opts = append(opts, WithAPPID(c.AppID))
+	}
+
+	if c.BaseURL != "" {
+		opts = append(opts, WithURL(c.BaseURL))
+	}
+
+	opts = append(opts, WithAPIToken(c.APIToken))
+
+	if c.Timeout > 0 {
+		opts = append(opts, WithHTTPClient(&http.Client{Timeout: time.Duration(c.Timeout)}))
+	}
+
+	if c.Retry.MaxRetries > 0 {
+		backoff := time.Duration(c.Retry.Backoff)
+		if backoff == 0 {
+			backoff = time.Second
+		}
+
+		opts = append(opts, WithRetry(c.Retry.MaxRetries, backoff))
+	}
+
+	if c.RateLimit.RequestsPerSecond > 0 {
+		burst := c.RateLimit.Burst
+		if burst == 0 {
+			burst = 1
+		}
+
+		opts = append(opts, WithRateLimit(c.RateLimit.RequestsPerSecond, burst))
+	}
+
+	return opts, nil
+}
+
+// Environment variables read by ConfigFromEnv.
+const (
+	EnvAppID          = "SENDBIRD_APP_ID"
+	EnvAPIToken       = "SENDBIRD_API_TOKEN"
+	EnvBaseURL        = "SENDBIRD_BASE_URL"
+	EnvTimeout        = "SENDBIRD_TIMEOUT"
+	EnvMaxRetries     = "SENDBIRD_MAX_RETRIES"
+	EnvRetryBackoff   = "SENDBIRD_RETRY_BACKOFF"
+	EnvRateLimit      = "SENDBIRD_RATE_LIMIT"
+	EnvRateLimitBurst = "SENDBIRD_RATE_LIMIT_BURST"
+)
+
+// ConfigFromEnv reads the configuration from the environment variables. The
+// durations are formatted as "1.5s" or "300ms" and SENDBIRD_RATE_LIMIT is a
+// number of requests per second.
+func ConfigFromEnv() (Config, error) {
+	return configFromEnv(os.LookupEnv)
+}
+
+func configFromEnv(lookup func(string) (string, bool)) (Config, error) {
+	var (
+		cfg  Config
+		errs []error
+	)
+
+	get := func(key string) string {
+		v, _ := lookup(key)
+
+		return v
+	}
+
+	cfg.AppID = get(EnvAppID)
+	cfg.APIToken = get(EnvAPIToken)
+	cfg.BaseURL = get(EnvBaseURL)
+
+	parse := func(key string, fn func(string) error) {
+		if v := get(key); v != "" {
+			if err := fn(v); err != nil {
+				errs = append(errs, fmt.Errorf("invalid %s: %w", key, err))
+			}
+		}
+	}
+
+	parse(EnvTimeout, func(v string) error { return cfg.Timeout.UnmarshalText([]byte(v)) })
+	parse(EnvRetryBackoff, func(v string) error { return cfg.Retry.Backoff.UnmarshalText([]byte(v)) })
+	parse(EnvMaxRetries, func(v string) (err error) {
+		cfg.Retry.MaxRetries, err = strconv.Atoi(v)
+
+		return err
+	})
+	parse(EnvRateLimit, func(v string) (err error) {
+		cfg.RateLimit.RequestsPerSecond, err = strconv.ParseFloat(v, 64)
+
+		return err
+	})
+	parse(EnvRateLimitBurst, func(v string) (err error) {
+		cfg.RateLimit.Burst, err = strconv.Atoi(v)
+
+		return err
+	})
+
+	if err := errors.Join(errs...); err != nil {
+		return Config{}, err
+	}
+
+	return cfg, nil
+}
+
+// LoadConfig reads the configuration from a YAML (.yaml, .yml) or JSON (.json)
+// file. Unknown fields are rejected.
+func LoadConfig(path string) (Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Config{}, fmt.Errorf("failed to read config: %w", err)
+	}
+
+	var cfg Config
+
+	switch ext := filepath.Ext(path); ext {
+	case ".yaml", ".yml":
+		dec := yaml.NewDecoder(bytes.NewReader(data))
+		dec.KnownFields(true)
+
+		err = dec.Decode(&cfg)
+	case ".json":
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.DisallowUnknownFields()
+
+		err = dec.Decode(&cfg)
+	default:
+		return Config{}, fmt.Errorf("unsupported config file extension %q", ext)
+	}
+
+	if err != nil {
+		return Config{}, fmt.Errorf("failed to decode config: %w", err)
+	}
+
+	return cfg, nil
+}
+
+// NewClientFromConfig creates a new client from the configuration. The given
+// options are applied after the ones of the configuration.
+func NewClientFromConfig(cfg Config, opts ...Option) (Client, error) {
+	cfgOpts, err := cfg.Options()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewClientWithValidation(append(cfgOpts, opts...)...)
+}
+
+// NewClientFromEnv creates a new client from the environment variables. The
+// given options are applied after the ones of the environment.
+func NewClientFromEnv(opts ...Option) (Client, error) {
+	cfg, err := ConfigFromEnv()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config from env: %w", err)
+	}
+
+	return NewClientFromConfig(cfg, opts...)
+}
@@ -0,0 +1,241 @@
+package client
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		cfg         Config
+		expectedErr []string
+	}{
+		{
+			name: "valid with app ID",
+			cfg:  Config{AppID: "app-id", APIToken: "token"},
+		},
+		{
+			name: "valid with base URL",
+			cfg:  Config{BaseURL: "https://example.com/v3", APIToken: "token"},
+		},
+		{
+			name:        "empty",
+			expectedErr: []string{"app ID or base URL is required", "API token is required"},
+		},
+		{
+			name:        "invalid base URL",
+			cfg:         Config{BaseURL: "://example.com", APIToken: "token"},
+			expectedErr: []string{"invalid base URL"},
+		},
+		{
+			name:        "base URL without host",
+			cfg:         Config{BaseURL: "example.com", APIToken: "token"},
+			expectedErr: []string{"scheme and host are required"},
+		},
+		{
+			name: "negative values",
+			cfg: Config{
+				AppID:     "app-id",
+				APIToken:  "token",
+				Timeout:   -1,
+				Retry:     RetryConfig{MaxRetries: -1, Backoff: -1},
+				RateLimit: RateLimitConfig{RequestsPerSecond: -1, Burst: -1},
+			},
+			expectedErr: []string{
+				"timeout must not be negative",
+				"max retries must not be negative",
+				"retry backoff must not be negative",
+				"rate limit requests per second must not be negative",
+				"rate limit burst must not be negative",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := test.cfg.Validate()
+			if len(test.expectedErr) == 0 {
+				assert.NoError(t, err)
+
+				return
+			}
+
+			require.Error(t, err)
+
+			for _, expected := range test.expectedErr {
+				assert.Contains(t, err.Error(), expected)
+			}
+		})
+	}
+}
+
+func TestConfigOptions(t *testing.T) {
+	t.Parallel()
+
+	cfg := Config{
+		AppID:     "app-id",
+		APIToken:  "token",
+		Timeout:   Duration(5 * time.Second),
+		Retry:     RetryConfig{MaxRetries: 3},
+		RateLimit: RateLimitConfig{RequestsPerSecond: 10},
+	}
+
+	c, err := NewClientFromConfig(cfg)
+	require.NoError(t, err)
+
+	cClient, ok := c.(*client)
+	require.True(t, ok)
+	assert.Equal(t, "api-app-id.sendbird.com", cClient.baseURL.Host)
+	assert.Equal(t, "/v3", cClient.baseURL.Path)
+	assert.Equal(t, "token", cClient.header.Get("Api-Token"))
+	assert.Equal(t, 5*time.Second, cClient.httpClient.Timeout)
+	assert.Equal(t, 3, cClient.maxRetries)
+	assert.Equal(t, time.Second, cClient.retryBackoff)
+	require.NotNil(t, cClient.limiter)
+	assert.Equal(t, 1, cClient.limiter.Burst())
+
+	_, err = NewClientFromConfig(Config{BaseURL: "::", APIToken: "token"})
+	require.ErrorContains(t, err, "invalid base URL")
+
+	// An invalid URL given as an option is returned rather than panicking.
+	_, err = NewClientFromConfig(Config{AppID: "app-id", APIToken: "token"}, WithURL("::"))
+	require.ErrorContains(t, err, `invalid url "::"`)
+}
+
+func TestConfigFromEnv(t *testing.T) {
+	t.Parallel()
+
+	env := map[string]string{
+		EnvAppID:          "app-id",
+		EnvAPIToken:       "token",
+		EnvBaseURL:        "https://example.com/v3",
+		EnvTimeout:        "2s",
+		EnvMaxRetries:     "2",
+		EnvRetryBackoff:   "100ms",
+		EnvRateLimit:      "5.5",
+		EnvRateLimitBurst: "3",
+	}
+
+	cfg, err := configFromEnv(func(key string) (string, bool) {
+		v, ok := env[key]
+
+		return v, ok
+	})
+	require.NoError(t, err)
+	assert.Equal(t, Config{
+		AppID:     "app-id",
+		APIToken:  "token",
+		BaseURL:   "https://example.com/v3",
+		Timeout:   Duration(2 * time.Second),
+		Retry:     RetryConfig{MaxRetries: 2, Backoff: Duration(100 * time.Millisecond)},
+		RateLimit: RateLimitConfig{RequestsPerSecond: 5.5, Burst: 3},
+	}, cfg)
+
+	env[EnvTimeout] = "2 seconds"
+	env[EnvMaxRetries] = "two"
+
+	_, err = configFromEnv(func(key string) (string, bool) {
+		v, ok := env[key]
+
+		return v, ok
+	})
+	require.ErrorContains(t, err, EnvTimeout)
+	require.ErrorContains(t, err, EnvMaxRetries)
+}
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	expected := Config{
+		AppID:     "app-id",
+		APIToken:  "token",
+		Timeout:   Duration(10 * time.Second),
+		Retry:     RetryConfig{MaxRetries: 3, Backoff: Duration(500 * time.Millisecond)},
+		RateLimit: RateLimitConfig{RequestsPerSecond: 20, Burst: 5},
+	}
+
+	tests := []struct {
+		name        string
+		file        string
+		content     string
+		expected    Config
+		expectedErr string
+	}{
+		{
+			name: "yaml",
+			file: "config.yaml",
+			content: `app_id: app-id
+api_token: token
+timeout: 10s
+retry:
+  max_retries: 3
+  backoff: 500ms
+rate_limit:
+  requests_per_second: 20
+  burst: 5
+`,
+			expected: expected,
+		},
+		{
+			name: "json",
+			file: "config.json",
+			content: `{
+  "app_id": "app-id",
+  "api_token": "token",
+  "timeout": "10s",
+  "retry": {"max_retries": 3, "backoff": "500ms"},
+  "rate_limit": {"requests_per_second": 20, "burst": 5}
+}`,
+			expected: expected,
+		},
+		{
+			name:        "unknown field",
+			file:        "config.yml",
+			content:     "app_idd: app-id\n",
+			expectedErr: "failed to decode config",
+		},
+		{
+			name:        "invalid duration",
+			file:        "config.json",
+			content:     `{"timeout": "ten seconds"}`,
+			expectedErr: "invalid duration",
+		},
+		{
+			name:        "unsupported extension",
+			file:        "config.toml",
+			expectedErr: "unsupported config file extension",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			path := filepath.Join(t.TempDir(), test.file)
+			require.NoError(t, os.WriteFile(path, []byte(test.content), 0o600))
+
+			cfg, err := LoadConfig(path)
+			if test.expectedErr != "" {
+				require.ErrorContains(t, err, test.expectedErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, cfg)
+		})
+	}
+
+	_, err := LoadConfig(filepath.Join(t.TempDir(), "missing.yaml"))
+	require.ErrorContains(t, err, "failed to read config")
+}
@@ -0,0 +1,209 @@
+package client
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/yumi-ia/sendbird-go/pkg/utils/peek"
+	"github.com/yumi-ia/sendbird-go/pkg/utils/redact"
+)
+
+// RoundTripperFunc is an adapter to allow the use of ordinary functions as
+// http.RoundTripper.
+type RoundTripperFunc func(req *http.Request) (*http.Response, error)
+
+// RoundTrip calls f(req).
+func (f RoundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// Middleware wraps the sending of a request to the sendbird API. It receives
+// the next step of the chain and returns a new step calling it. A middleware
+// may modify the request before calling next, inspect the response after, or
+// not call next at all.
+type Middleware func(next http.RoundTripper) http.RoundTripper
+
+// chain returns the round tripper sending the requests of the client through
+// its middlewares, the first one being the outermost.
+func (c *client) chain() http.RoundTripper {
+	var rt http.RoundTripper = RoundTripperFunc(c.httpClient.Do)
+
+	for i := len(c.middlewares) - 1; i >= 0; i-- {
+		rt = c.middlewares[i](rt)
+	}
+
+	return rt
+}
+
+// RequestIDHeader is the header set by the request ID middleware.
+const RequestIDHeader = "X-Request-Id"
+
+// NewRequestIDMiddleware returns a middleware setting the RequestIDHeader of
+// the requests not having one. The IDs are created by generate, or are random
+// hexadecimal strings if generate is nil.
+func NewRequestIDMiddleware(generate func() string) Middleware {
+	if generate == nil {
+		generate = randomID
+	}
+
+	return func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			if req.Header.Get(RequestIDHeader) == "" {
+				req.Header.Set(RequestIDHeader, generate())
+			}
+
+			return next.RoundTrip(req)
+		})
+	}
+}
+
+func randomID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+
+	return hex.EncodeToString(b)
+}
+
+// DefaultRedactedFields are the JSON fields redacted by the logging middleware
+// when no field is given.
+var DefaultRedactedFields = []string{ //nolint:gochecknoglobals
+	"access_token",
+	"session_token",
+	"session_tokens",
+	"api_token",
+	"password",
+}
+
+// maxLoggedBodySize is the maximum size of the bodies logged by the logging
+// middleware. Only this much of a body is read before it is passed on.
+const maxLoggedBodySize = 64 << 10
+
+// NewLoggingMiddleware returns a middleware logging the bodies of the requests
+// and responses at debug level. The values of the given JSON fields are
+// redacted at any depth, DefaultRedactedFields being used if none is given.
+// Headers, including the Api-Token, are never logged. Only the first 64KiB of
+// a body are read, so that responses are still streamed; larger bodies can't
+// be redacted and are logged as truncated. A body failing to be read is not
+// logged and is passed on as is, so that logging never fails a request.
+func NewLoggingMiddleware(logger *slog.Logger, fields ...string) Middleware {
+	if len(fields) == 0 {
+		fields = DefaultRedactedFields
+	}
+
+	redactedFields := make(map[string]struct{}, len(fields))
+	for _, field := range fields {
+		redactedFields[field] = struct{}{}
+	}
+
+	return func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			logger := logger.With("method", req.Method, "path", req.URL.Path)
+
+			reqBody, truncated, err := peek.Body(&req.Body, maxLoggedBodySize)
+			if err != nil {
+				logger.Debug("failed to read sendbird request body", "error", err)
+			} else {
+				logger.Debug("sendbird request", "body", logBody(reqBody, truncated, redactedFields))
+			}
+
+			resp, err := next.RoundTrip(req)
+			if err != nil {
+				logger.Debug("sendbird request failed", "error", err)
+
+				return nil, err
+			}
+
+			respBody, truncated, err := peek.Body(&resp.Body, maxLoggedBodySize)
+			if err != nil {
+				logger.Debug("failed to read sendbird response body", "status", resp.StatusCode, "error", err)
+
+				return resp, nil
+			}
+
+			logger.Debug("sendbird response",
+				"status", resp.StatusCode,
+				"body", logBody(respBody, truncated, redactedFields))
+
+			return resp, nil
+		})
+	}
+}
+
+// logBody returns the body to log, redacted.
+func logBody(body []byte, truncated bool, fields map[string]struct{}) string {
+	if truncated {
+		return fmt.Sprintf("<truncated: more than %d bytes>", len(body))
+	}
+
+	return redactBody(body, fields)
+}
+
+// redactBody returns the body with the values of the given fields redacted.
+// Bodies that are not JSON are replaced by their content type.
+func redactBody(body []byte, fields map[string]struct{}) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	b, err := redact.JSON(body, fields)
+	if err != nil {
+		return "<" + http.DetectContentType(body) + ">"
+	}
+
+	return string(b)
+}
+
+// AuditRecord describes a request sent to the sendbird API.
+type AuditRecord struct {
+	// Method is the HTTP method of the request.
+	Method string
+	// Path is the path of the request, without the query.
+	Path string
+	// Query is the encoded query of the request.
+	Query string
+	// RequestID is the RequestIDHeader of the request, if any.
+	RequestID string
+	// StatusCode is the status code of the response, or 0 if the request
+	// failed before getting a response.
+	StatusCode int
+	// Duration is the time taken by the request.
+	Duration time.Duration
+	// Err is the transport error of the request, if any.
+	Err error
+}
+
+// AuditFunc is called with the record of each request.
+type AuditFunc func(ctx context.Context, record AuditRecord)
+
+// NewAuditMiddleware returns a middleware calling fn once each request is
+// done.
+func NewAuditMiddleware(fn AuditFunc) Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			start := time.Now()
+
+			resp, err := next.RoundTrip(req)
+
+			record := AuditRecord{
+				Method:    req.Method,
+				Path:      req.URL.Path,
+				Query:     req.URL.RawQuery,
+				RequestID: req.Header.Get(RequestIDHeader),
+				Duration:  time.Since(start),
+				Err:       err,
+			}
+			if resp != nil {
+				record.StatusCode = resp.StatusCode
+			}
+
+			fn(req.Context(), record)
+
+			return resp, err
+		})
+	}
+}
@@ -0,0 +1,257 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yumi-ia/sendbird-go/pkg/utils/redact"
+)
+
+func TestMiddlewareChain(t *testing.T) {
+	t.Parallel()
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "outer,inner", r.Header.Get("X-Order"))
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer s.Close()
+
+	order := func(name string) Middleware {
+		return func(next http.RoundTripper) http.RoundTripper {
+			return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				req.Header.Set("X-Order", strings.Trim(req.Header.Get("X-Order")+","+name, ","))
+
+				return next.RoundTrip(req)
+			})
+		}
+	}
+
+	c := NewClient(
+		WithURL(s.URL),
+		WithMiddleware(order("outer")),
+		WithMiddleware(order("inner")),
+	)
+
+	_, err := c.Get(context.Background(), "/foo/bar", nil, nil)
+	require.NoError(t, err)
+
+	cClient, ok := c.(*client)
+	require.True(t, ok)
+	assert.Empty(t, cClient.header.Get("X-Order"), "middlewares must not modify the client header")
+}
+
+func TestNewRequestIDMiddleware(t *testing.T) {
+	t.Parallel()
+
+	var got []string
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = append(got, r.Header.Get(RequestIDHeader))
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer s.Close()
+
+	c := NewClient(
+		WithURL(s.URL),
+		WithMiddleware(NewRequestIDMiddleware(func() string { return "request-id" })),
+	)
+
+	_, err := c.Get(context.Background(), "/foo/bar", nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"request-id"}, got)
+
+	c = NewClient(
+		WithURL(s.URL),
+		WithMiddleware(NewRequestIDMiddleware(nil)),
+	)
+
+	_, err = c.Get(context.Background(), "/foo/bar", nil, nil)
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	assert.Len(t, got[1], 32)
+}
+
+func TestNewLoggingMiddleware(t *testing.T) {
+	t.Parallel()
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"user_id":"42","access_token":"secret"}`, string(body))
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"user_id":"42","session_tokens":[{"session_token":"secret"}]}`))
+	}))
+	defer s.Close()
+
+	var b bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&b, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	c := NewClient(
+		WithURL(s.URL),
+		WithAPIToken("api-token"),
+		WithMiddleware(NewLoggingMiddleware(logger)),
+	)
+
+	resp := map[string]any{}
+	_, err := c.Post(context.Background(), "/users", map[string]string{"user_id": "42", "access_token": "secret"}, &resp)
+	require.NoError(t, err)
+	assert.Equal(t, "42", resp["user_id"])
+
+	assert.Contains(t, b.String(), `\"user_id\":\"42\"`)
+	assert.Contains(t, b.String(), redact.Redacted)
+	assert.NotContains(t, b.String(), "secret")
+	assert.NotContains(t, b.String(), "api-token")
+}
+
+func TestNewLoggingMiddleware_largeBody(t *testing.T) {
+	t.Parallel()
+
+	large := strings.Repeat("a", 2*maxLoggedBodySize)
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"session_token":"secret","data":"` + large + `"}`))
+	}))
+	defer s.Close()
+
+	var b bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&b, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	c := NewClient(
+		WithURL(s.URL),
+		WithMiddleware(NewLoggingMiddleware(logger)),
+	)
+
+	resp := map[string]any{}
+	_, err := c.Get(context.Background(), "/users", nil, &resp)
+	require.NoError(t, err)
+	assert.Equal(t, large, resp["data"])
+
+	assert.Contains(t, b.String(), fmt.Sprintf("<truncated: more than %d bytes>", maxLoggedBodySize))
+	assert.NotContains(t, b.String(), "secret")
+	assert.Less(t, b.Len(), maxLoggedBodySize)
+}
+
+// failingBody fails to be read and records whether it is closed.
+type failingBody struct {
+	closed bool
+}
+
+func (fb *failingBody) Read([]byte) (int, error) {
+	return 0, io.ErrUnexpectedEOF
+}
+
+func (fb *failingBody) Close() error {
+	fb.closed = true
+
+	return nil
+}
+
+func TestNewLoggingMiddleware_bodyError(t *testing.T) {
+	t.Parallel()
+
+	var b bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&b, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	reqBody, respBody := &failingBody{}, &failingBody{}
+
+	var sent bool
+
+	rt := NewLoggingMiddleware(logger)(RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		sent = true
+
+		// The error is left to the transport.
+		_, err := io.ReadAll(req.Body)
+		require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+		require.NoError(t, req.Body.Close())
+
+		return &http.Response{StatusCode: http.StatusOK, Body: respBody}, nil
+	}))
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, "https://example.com/users", reqBody)
+	require.NoError(t, err)
+
+	resp, err := rt.RoundTrip(req)
+	require.NoError(t, err)
+	assert.True(t, sent)
+	assert.True(t, reqBody.closed)
+
+	// The response is returned as is, the error being left to its reader.
+	_, err = io.ReadAll(resp.Body)
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	require.NoError(t, resp.Body.Close())
+	assert.True(t, respBody.closed)
+
+	assert.Contains(t, b.String(), "failed to read sendbird request body")
+	assert.Contains(t, b.String(), "failed to read sendbird response body")
+}
+
+func TestRedactBody(t *testing.T) {
+	t.Parallel()
+
+	fields := map[string]struct{}{"password": {}}
+
+	tests := []struct {
+		name     string
+		body     string
+		expected string
+	}{
+		{name: "empty"},
+		{name: "not JSON", body: "foo", expected: "<text/plain; charset=utf-8>"},
+		{name: "nested", body: `{"a":[{"password":"p"}]}`, expected: `{"a":[{"password":"[REDACTED]"}]}`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, redactBody([]byte(test.body), fields))
+		})
+	}
+}
+
+func TestNewAuditMiddleware(t *testing.T) {
+	t.Parallel()
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"code":400201,"message":"not found","error":true}`))
+	}))
+	defer s.Close()
+
+	var records []AuditRecord
+
+	c := NewClient(
+		WithURL(s.URL),
+		WithPath(""),
+		WithMiddleware(
+			NewAuditMiddleware(func(_ context.Context, record AuditRecord) {
+				records = append(records, record)
+			}),
+			NewRequestIDMiddleware(func() string { return "request-id" }),
+		),
+	)
+
+	_, err := c.Get(context.Background(), "/users/42?limit=1", nil, nil)
+	require.ErrorIs(t, err, ErrResourceNotFound)
+
+	require.Len(t, records, 1)
+	assert.Equal(t, http.MethodGet, records[0].Method)
+	assert.Equal(t, "/users/42", records[0].Path)
+	assert.Equal(t, "limit=1", records[0].Query)
+	assert.Equal(t, "request-id", records[0].RequestID)
+	assert.Equal(t, http.StatusBadRequest, records[0].StatusCode)
+	assert.NoError(t, records[0].Err)
+}
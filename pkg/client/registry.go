@@ -0,0 +1,217 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"sync"
+)
+
+var (
+	// ErrAppNotRegistered is returned when no client is registered for an app ID.
+	ErrAppNotRegistered = errors.New("application not registered")
+	// ErrAppAlreadyRegistered is returned when registering an app ID twice.
+	ErrAppAlreadyRegistered = errors.New("application already registered")
+	// ErrMissingAppID is returned when routing a call without app ID in context.
+	ErrMissingAppID = errors.New("missing application ID in context")
+)
+
+// appIDKey is the context key of the app ID used to route calls.
+type appIDKey struct{}
+
+// ContextWithAppID returns a copy of ctx routing the calls made through a
+// Registry to the client of appID.
+func ContextWithAppID(ctx context.Context, appID string) context.Context {
+	return context.WithValue(ctx, appIDKey{}, appID)
+}
+
+// AppIDFromContext returns the app ID set by ContextWithAppID, if any.
+func AppIDFromContext(ctx context.Context) (string, bool) {
+	appID, ok := ctx.Value(appIDKey{}).(string)
+
+	return appID, ok && appID != ""
+}
+
+// Registry manages the clients of several sendbird applications. The clients
+// share the same http client, hence the same connection pool, and their API
+// tokens can be rotated at runtime.
+//
+// A Registry is itself a Client routing each call to the client of the app ID
+// set in the context with ContextWithAppID, so that services can be created
+// once for all the applications.
+type Registry struct {
+	mu      sync.RWMutex
+	opts    []Option
+	clients map[string]*client
+}
+
+var _ Client = (*Registry)(nil)
+
+// NewRegistry creates a new registry. The given options are applied to every
+// registered client before its own options. Unless WithHTTPClient is given,
+// the clients share a new http client using http.DefaultTransport.
+func NewRegistry(opts ...Option) *Registry {
+	shared := []Option{WithHTTPClient(&http.Client{Transport: http.DefaultTransport})}
+
+	return &Registry{
+		opts:    append(shared, opts...),
+		clients: map[string]*client{},
+	}
+}
+
+// Register creates and registers the client of appID, authenticated with
+// apiToken. The given options are applied after the ones of the registry.
+func (r *Registry) Register(appID, apiToken string, opts ...Option) (Client, error) {
+	if appID == "" {
+		return nil, errors.New("app ID is required")
+	}
+
+	if apiToken == "" {
+		return nil, errors.New("API token is required")
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.clients[appID]; ok {
+		return nil, fmt.Errorf("%w: %s", ErrAppAlreadyRegistered, appID)
+	}
+
+	c := &client{}
+	c.SetDefault()
+	c = WithAPPID(appID)(c)
+
+	for _, opt := range r.opts {
+		c = opt(c)
+	}
+
+	for _, opt := range opts {
+		c = opt(c)
+	}
+
+	c = WithAPIToken(apiToken)(c)
+
+	if c.err != nil {
+		return nil, fmt.Errorf("invalid option: %w", c.err)
+	}
+
+	r.clients[appID] = c
+
+	return c, nil
+}
+
+// Unregister removes the client of appID from the registry. The client itself
+// keeps working for the services already using it.
+func (r *Registry) Unregister(appID string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.clients, appID)
+}
+
+// Client returns the client of appID.
+func (r *Registry) Client(appID string) (Client, error) {
+	c, err := r.client(appID)
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// AppIDs returns the sorted app IDs of the registered clients.
+func (r *Registry) AppIDs() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	appIDs := make([]string, 0, len(r.clients))
+	for appID := range r.clients {
+		appIDs = append(appIDs, appID)
+	}
+
+	slices.Sort(appIDs)
+
+	return appIDs
+}
+
+// RotateAPIToken replaces the API token of the client of appID. The requests
+// sent after the rotation, including the ones of services created before, use
+// the new token.
+func (r *Registry) RotateAPIToken(appID, apiToken string) error {
+	if apiToken == "" {
+		return errors.New("API token is required")
+	}
+
+	c, err := r.client(appID)
+	if err != nil {
+		return err
+	}
+
+	c.setAPIToken(apiToken)
+
+	return nil
+}
+
+func (r *Registry) client(appID string) (*client, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	c, ok := r.clients[appID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrAppNotRegistered, appID)
+	}
+
+	return c, nil
+}
+
+// route returns the client of the app ID in ctx.
+func (r *Registry) route(ctx context.Context) (*client, error) {
+	appID, ok := AppIDFromContext(ctx)
+	if !ok {
+		return nil, ErrMissingAppID
+	}
+
+	return r.client(appID)
+}
+
+// Get sends a GET request to the sendbird API of the app ID in ctx.
+func (r *Registry) Get(ctx context.Context, path string, obj any, resp any) (any, error) {
+	c, err := r.route(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.Get(ctx, path, obj, resp)
+}
+
+// Post sends a POST request to the sendbird API of the app ID in ctx.
+func (r *Registry) Post(ctx context.Context, path string, obj any, resp any) (any, error) {
+	c, err := r.route(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.Post(ctx, path, obj, resp)
+}
+
+// Put sends a PUT request to the sendbird API of the app ID in ctx.
+func (r *Registry) Put(ctx context.Context, path string, obj any, resp any) (any, error) {
+	c, err := r.route(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.Put(ctx, path, obj, resp)
+}
+
+// Delete sends a DELETE request to the sendbird API of the app ID in ctx.
+func (r *Registry) Delete(ctx context.Context, path string, obj any, resp any) (any, error) {
+	c, err := r.route(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.Delete(ctx, path, obj, resp)
+}
@@ -0,0 +1,138 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistry(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu     sync.Mutex
+		tokens []string
+	)
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		tokens = append(tokens, r.Header.Get("Api-Token"))
+		mu.Unlock()
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer s.Close()
+
+	r := NewRegistry()
+
+	eu, err := r.Register("eu", "eu-token", WithURL(s.URL))
+	require.NoError(t, err)
+
+	_, err = r.Register("us", "us-token", WithURL(s.URL))
+	require.NoError(t, err)
+
+	_, err = r.Register("us", "us-token")
+	require.ErrorIs(t, err, ErrAppAlreadyRegistered)
+
+	_, err = r.Register("", "token")
+	require.Error(t, err)
+
+	assert.Equal(t, []string{"eu", "us"}, r.AppIDs())
+
+	euClient, err := r.Client("eu")
+	require.NoError(t, err)
+	assert.Equal(t, eu, euClient)
+
+	_, err = r.Client("asia")
+	require.ErrorIs(t, err, ErrAppNotRegistered)
+
+	_, err = r.Get(context.Background(), "/foo", nil, nil)
+	require.ErrorIs(t, err, ErrMissingAppID)
+
+	_, err = r.Get(ContextWithAppID(context.Background(), "us"), "/foo", nil, nil)
+	require.NoError(t, err)
+
+	require.NoError(t, r.RotateAPIToken("eu", "eu-token-2"))
+	require.ErrorIs(t, r.RotateAPIToken("asia", "token"), ErrAppNotRegistered)
+
+	_, err = eu.Post(context.Background(), "/foo", nil, nil)
+	require.NoError(t, err)
+
+	_, err = r.Delete(ContextWithAppID(context.Background(), "eu"), "/foo", nil, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"us-token", "eu-token-2", "eu-token-2"}, tokens)
+
+	r.Unregister("us")
+	assert.Equal(t, []string{"eu"}, r.AppIDs())
+}
+
+func TestRegistry_sharedHTTPClient(t *testing.T) {
+	t.Parallel()
+
+	r := NewRegistry()
+
+	eu, err := r.Register("eu", "eu-token")
+	require.NoError(t, err)
+
+	us, err := r.Register("us", "us-token")
+	require.NoError(t, err)
+
+	euClient, ok := eu.(*client)
+	require.True(t, ok)
+	usClient, ok := us.(*client)
+	require.True(t, ok)
+
+	assert.Same(t, euClient.httpClient, usClient.httpClient)
+	assert.Equal(t, "api-eu.sendbird.com", euClient.baseURL.Host)
+	assert.Equal(t, "api-us.sendbird.com", usClient.baseURL.Host)
+}
+
+func TestRegistry_invalidURL(t *testing.T) {
+	t.Parallel()
+
+	r := NewRegistry(WithURL("::"))
+
+	_, err := r.Register("app-id", "api-token")
+	require.ErrorContains(t, err, `invalid option: invalid url "::"`)
+	assert.Empty(t, r.AppIDs())
+}
+
+func TestRegistry_rotateConcurrently(t *testing.T) {
+	t.Parallel()
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer s.Close()
+
+	r := NewRegistry(WithURL(s.URL))
+
+	c, err := r.Register("eu", "token")
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(2)
+
+		go func() {
+			defer wg.Done()
+
+			_, err := c.Get(context.Background(), "/foo", nil, nil)
+			assert.NoError(t, err)
+		}()
+
+		go func() {
+			defer wg.Done()
+
+			assert.NoError(t, r.RotateAPIToken("eu", "new-token"))
+		}()
+	}
+
+	wg.Wait()
+}
@@ -0,0 +1,111 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// ErrStopStream can be returned by the callback of a stream to stop it early
+// without error. The fields following the streamed array are then not decoded.
+var ErrStopStream = errors.New("stop stream")
+
+// maxDrainSize is the maximum number of bytes discarded from the rest of a
+// stopped stream. Beyond it, the connection is closed instead of being reused.
+const maxDrainSize = 256 << 10
+
+// StreamDecoder is implemented by the responses decoding the body themselves
+// as it is read, instead of having it decoded at once. When the response given
+// to a request implements it, DecodeStream is called with a decoder reading
+// the body of a successful response.
+type StreamDecoder interface {
+	DecodeStream(dec *json.Decoder) error
+}
+
+// StreamArray decodes the JSON object read by dec, calling fn with each item
+// of the array of the given field as soon as it is decoded, so that only one
+// item is held in memory at a time. The other fields present in fields are
+// decoded into their value, which must be a pointer, and the remaining ones
+// are skipped. Decoding stops at the first error returned by fn, which is
+// returned unless it is ErrStopStream.
+func StreamArray[T any](dec *json.Decoder, field string, fn func(T) error, fields map[string]any) error {
+	if err := expectDelim(dec, '{'); err != nil {
+		return err
+	}
+
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return fmt.Errorf("failed to read key: %w", err)
+		}
+
+		key, ok := tok.(string)
+		if !ok {
+			return fmt.Errorf("unexpected key %v", tok)
+		}
+
+		switch {
+		case key == field:
+			err := streamItems(dec, fn)
+			if errors.Is(err, ErrStopStream) {
+				return nil
+			}
+
+			if err != nil {
+				return fmt.Errorf("failed to stream %q: %w", field, err)
+			}
+		case fields[key] != nil:
+			if err := dec.Decode(fields[key]); err != nil {
+				return fmt.Errorf("failed to decode %q: %w", key, err)
+			}
+		default:
+			var skip json.RawMessage
+			if err := dec.Decode(&skip); err != nil {
+				return fmt.Errorf("failed to skip %q: %w", key, err)
+			}
+		}
+	}
+
+	return expectDelim(dec, '}')
+}
+
+func streamItems[T any](dec *json.Decoder, fn func(T) error) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return fmt.Errorf("failed to read array: %w", err)
+	}
+
+	if tok == nil {
+		return nil
+	}
+
+	if tok != json.Delim('[') {
+		return fmt.Errorf("expected array, got %v", tok)
+	}
+
+	for dec.More() {
+		var item T
+		if err := dec.Decode(&item); err != nil {
+			return fmt.Errorf("failed to decode item: %w", err)
+		}
+
+		if err := fn(item); err != nil {
+			return err
+		}
+	}
+
+	return expectDelim(dec, ']')
+}
+
+func expectDelim(dec *json.Decoder, delim json.Delim) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return fmt.Errorf("failed to read %q: %w", rune(delim), err)
+	}
+
+	if tok != delim {
+		return fmt.Errorf("expected %q, got %v", rune(delim), tok)
+	}
+
+	return nil
+}
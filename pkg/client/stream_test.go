@@ -0,0 +1,226 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type item struct {
+	ID int `json:"id"`
+}
+
+type itemStream struct {
+	items []item
+	next  string
+}
+
+func (is *itemStream) DecodeStream(dec *json.Decoder) error {
+	return StreamArray(dec, "items", func(i item) error {
+		is.items = append(is.items, i)
+
+		return nil
+	}, map[string]any{"next": &is.next})
+}
+
+func TestStreamArray(t *testing.T) {
+	t.Parallel()
+
+	errCallback := errors.New("callback error")
+
+	tests := []struct {
+		name          string
+		body          string
+		fn            func(item) error
+		expectedItems []item
+		expectedNext  string
+		expectedErr   string
+	}{
+		{
+			name:          "items and fields",
+			body:          `{"skipped":{"a":[1,2]},"items":[{"id":1},{"id":2}],"next":"token"}`,
+			expectedItems: []item{{ID: 1}, {ID: 2}},
+			expectedNext:  "token",
+		},
+		{
+			name:         "null items",
+			body:         `{"items":null,"next":"token"}`,
+			expectedNext: "token",
+		},
+		{
+			name:          "stop stream",
+			body:          `{"items":[{"id":1},{"id":2}],"next":"token"}`,
+			fn:            func(item) error { return ErrStopStream },
+			expectedItems: []item{{ID: 1}},
+		},
+		{
+			name:          "callback error",
+			body:          `{"items":[{"id":1},{"id":2}]}`,
+			fn:            func(item) error { return errCallback },
+			expectedItems: []item{{ID: 1}},
+			expectedErr:   "callback error",
+		},
+		{
+			name:        "not an object",
+			body:        `[]`,
+			expectedErr: "expected '{'",
+		},
+		{
+			name:        "not an array",
+			body:        `{"items":{}}`,
+			expectedErr: "expected array",
+		},
+		{
+			name:        "invalid item",
+			body:        `{"items":[{"id":"1"}]}`,
+			expectedErr: "failed to decode item",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				items []item
+				next  string
+			)
+
+			err := StreamArray(json.NewDecoder(strings.NewReader(test.body)), "items", func(i item) error {
+				items = append(items, i)
+				if test.fn != nil {
+					return test.fn(i)
+				}
+
+				return nil
+			}, map[string]any{"next": &next})
+
+			if test.expectedErr != "" {
+				require.ErrorContains(t, err, test.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, test.expectedItems, items)
+			assert.Equal(t, test.expectedNext, next)
+		})
+	}
+}
+
+func TestDo_streamDecoder(t *testing.T) {
+	t.Parallel()
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"items":[{"id":1},{"id":2}],"next":"token"}`))
+	}))
+	defer s.Close()
+
+	c := NewClient(WithURL(s.URL))
+
+	stream := &itemStream{}
+	resp, err := c.Get(context.Background(), "/items", nil, stream)
+	require.NoError(t, err)
+	assert.Equal(t, stream, resp)
+	assert.Equal(t, []item{{ID: 1}, {ID: 2}}, stream.items)
+	assert.Equal(t, "token", stream.next)
+}
+
+type firstItemStream struct {
+	items []item
+}
+
+func (fs *firstItemStream) DecodeStream(dec *json.Decoder) error {
+	return StreamArray(dec, "items", func(i item) error {
+		fs.items = append(fs.items, i)
+
+		return ErrStopStream
+	}, nil)
+}
+
+// eofBody records whether the body it wraps was read until io.EOF before
+// being closed.
+type eofBody struct {
+	io.ReadCloser
+	eof    bool
+	closed chan bool
+}
+
+func (b *eofBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if errors.Is(err, io.EOF) {
+		b.eof = true
+	}
+
+	return n, err
+}
+
+func (b *eofBody) Close() error {
+	b.closed <- b.eof
+
+	return b.ReadCloser.Close()
+}
+
+func TestDo_stopStream(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		opts []Option
+	}{
+		{
+			name: "without middleware",
+		},
+		{
+			name: "with logging middleware",
+			opts: []Option{WithMiddleware(NewLoggingMiddleware(slog.New(slog.NewTextHandler(io.Discard, nil))))},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			items := make([]string, 0, 10000)
+			for i := range 10000 {
+				items = append(items, fmt.Sprintf(`{"id":%d}`, i+1))
+			}
+
+			s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write([]byte(`{"items":[` + strings.Join(items, ",") + `],"next":"token"}`))
+			}))
+			defer s.Close()
+
+			closed := make(chan bool, 1)
+			httpClient := &http.Client{Transport: RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				resp, err := http.DefaultTransport.RoundTrip(req)
+				if err != nil {
+					return nil, err
+				}
+
+				resp.Body = &eofBody{ReadCloser: resp.Body, closed: closed}
+
+				return resp, nil
+			})}
+
+			c := NewClient(append([]Option{WithURL(s.URL), WithHTTPClient(httpClient)}, test.opts...)...)
+
+			stream := &firstItemStream{}
+			_, err := c.Get(context.Background(), "/items", nil, stream)
+			require.NoError(t, err)
+			assert.Equal(t, []item{{ID: 1}}, stream.items)
+			assert.True(t, <-closed, "the body should be drained before being closed")
+		})
+	}
+}
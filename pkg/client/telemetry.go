@@ -0,0 +1,168 @@
+package client
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	metricnoop "go.opentelemetry.io/otel/metric/noop"
+	"go.opentelemetry.io/otel/trace"
+	tracenoop "go.opentelemetry.io/otel/trace/noop"
+)
+
+// instrumentationName is the name of the tracer and meter of the client.
+const instrumentationName = "github.com/yumi-ia/sendbird-go/pkg/client"
+
+// telemetry holds the OpenTelemetry instruments of the client.
+type telemetry struct {
+	tracer   trace.Tracer
+	duration metric.Float64Histogram
+	errors   metric.Int64Counter
+	retries  metric.Int64Counter
+}
+
+// newTelemetry creates the instruments of the client from the given providers.
+// Instruments that fail to be created fall back to their no-op implementation.
+func newTelemetry(tp trace.TracerProvider, mp metric.MeterProvider) *telemetry {
+	meter := mp.Meter(instrumentationName)
+	noopMeter := metricnoop.Meter{}
+
+	duration, err := meter.Float64Histogram("sendbird.client.request.duration",
+		metric.WithDescription("Duration of the requests to the sendbird API."),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		duration, _ = noopMeter.Float64Histogram("")
+	}
+
+	errs, err := meter.Int64Counter("sendbird.client.request.errors",
+		metric.WithDescription("Number of requests to the sendbird API that failed."),
+		metric.WithUnit("{request}"),
+	)
+	if err != nil {
+		errs, _ = noopMeter.Int64Counter("")
+	}
+
+	retries, err := meter.Int64Counter("sendbird.client.request.retries",
+		metric.WithDescription("Number of retried requests to the sendbird API."),
+		metric.WithUnit("{retry}"),
+	)
+	if err != nil {
+		retries, _ = noopMeter.Int64Counter("")
+	}
+
+	return &telemetry{
+		tracer:   tp.Tracer(instrumentationName),
+		duration: duration,
+		errors:   errs,
+		retries:  retries,
+	}
+}
+
+// defaultTelemetry returns the no-op telemetry used when no provider is set.
+func defaultTelemetry() *telemetry {
+	return newTelemetry(tracenoop.NewTracerProvider(), metricnoop.NewMeterProvider())
+}
+
+// start starts the span of a request and returns the attributes shared by the
+// span and the metrics.
+func (t *telemetry) start(ctx context.Context, method, path, host string) (context.Context, trace.Span, []attribute.KeyValue) {
+	template := pathTemplate(path)
+
+	attrs := []attribute.KeyValue{
+		attribute.String("http.request.method", method),
+		attribute.String("url.template", template),
+		attribute.String("server.address", host),
+	}
+
+	ctx, span := t.tracer.Start(ctx, method+" "+template,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attrs...),
+	)
+
+	return ctx, span, attrs
+}
+
+// end ends the span of a request and records its metrics.
+func (t *telemetry) end(ctx context.Context, span trace.Span, attrs []attribute.KeyValue, seconds float64, status int, err error) {
+	if status != 0 {
+		attrs = append(attrs, attribute.Int("http.response.status_code", status))
+	}
+
+	if code, ok := errorCode(err); ok {
+		attrs = append(attrs, attribute.Int("sendbird.error.code", code))
+	}
+
+	span.SetAttributes(attrs...)
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		t.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+	}
+
+	t.duration.Record(ctx, seconds, metric.WithAttributes(attrs...))
+	span.End()
+}
+
+// retry records a retry of a request.
+func (t *telemetry) retry(ctx context.Context, span trace.Span, attrs []attribute.KeyValue, attempt int, err error) {
+	span.AddEvent("retry", trace.WithAttributes(
+		attribute.Int("http.request.resend_count", attempt),
+		attribute.String("exception.message", err.Error()),
+	))
+	t.retries.Add(ctx, 1, metric.WithAttributes(attrs...))
+}
+
+// pathPlaceholders maps a path segment to the placeholder replacing the
+// segment following it in a path template.
+var pathPlaceholders = map[string]string{ //nolint:gochecknoglobals
+	"group_channels":                  "{channel_url}",
+	"open_channels":                   "{channel_url}",
+	"channels":                        "{channel_url}",
+	"migration":                       "{channel_url}",
+	"users":                           "{user_id}",
+	"bots":                            "{bot_userid}",
+	"announcements":                   "{unique_id}",
+	"announcement_open_rate":          "{unique_id}",
+	"gdpr":                            "{request_id}",
+	"emojis":                          "{emoji_key}",
+	"emoji_categories":                "{emoji_category_id}",
+	"settings_by_channel_custom_type": "{custom_type}",
+	"metadata":                        "{key}",
+}
+
+// pathTemplate returns the path without its query and with its identifiers,
+// such as channel URLs and user IDs, masked to keep a low cardinality.
+func pathTemplate(path string) string {
+	path, _, _ = strings.Cut(path, "?")
+
+	segments := strings.Split(path, "/")
+	for i := 1; i < len(segments); i++ {
+		if segments[i] == "" {
+			continue
+		}
+
+		prev := segments[i-1]
+
+		switch {
+		case pathPlaceholders[prev] != "":
+			segments[i] = pathPlaceholders[prev]
+		case prev == "messages" && isNumber(segments[i]):
+			segments[i] = "{message_id}"
+		case i >= 2 && segments[i-2] == "export":
+			segments[i] = "{request_id}"
+		}
+	}
+
+	return strings.Join(segments, "/")
+}
+
+func isNumber(s string) bool {
+	_, err := strconv.Atoi(s)
+
+	return err == nil
+}
@@ -0,0 +1,246 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestPathTemplate(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		path     string
+		expected string
+	}{
+		{path: "/users", expected: "/users"},
+		{path: "/users/42/my_group_channels?limit=10", expected: "/users/{user_id}/my_group_channels"},
+		{path: "/group_channels/channel-url", expected: "/group_channels/{channel_url}"},
+		{path: "/group_channels/channel-url/messages/42", expected: "/group_channels/{channel_url}/messages/{message_id}"},
+		{path: "/group_channels/channel-url/messages/mark_as_read", expected: "/group_channels/{channel_url}/messages/mark_as_read"},
+		{path: "/open_channels/channel-url/messages", expected: "/open_channels/{channel_url}/messages"},
+		{path: "/bots/bot-id/channels/channel-url", expected: "/bots/{bot_userid}/channels/{channel_url}"},
+		{path: "/export/messages/request-id", expected: "/export/messages/{request_id}"},
+		{path: "/applications/settings_global", expected: "/applications/settings_global"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.path, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expected, pathTemplate(tc.path))
+		})
+	}
+}
+
+func TestTelemetry(t *testing.T) {
+	t.Parallel()
+
+	var calls int
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write([]byte(`{"code":500910,"message":"rate limit exceeded","error":true}`))
+
+			return
+		}
+
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"code":400201,"message":"not found","error":true}`))
+	}))
+	defer s.Close()
+
+	recorder := tracetest.NewSpanRecorder()
+	reader := sdkmetric.NewManualReader()
+
+	c := NewClient(
+		WithURL(s.URL),
+		WithTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))),
+		WithMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))),
+		WithRetry(1, time.Millisecond),
+	)
+
+	_, err := c.Get(context.Background(), "/group_channels/channel-url?show_member=true", nil, nil)
+	require.ErrorIs(t, err, ErrResourceNotFound)
+	assert.Equal(t, 2, calls)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+
+	span := spans[0]
+	assert.Equal(t, "GET /group_channels/{channel_url}", span.Name())
+	assert.Equal(t, codes.Error, span.Status().Code)
+	assert.Contains(t, span.Attributes(), attribute.Int("http.response.status_code", http.StatusBadRequest))
+	assert.Contains(t, span.Attributes(), attribute.Int("sendbird.error.code", 400201))
+	assert.Contains(t, span.Attributes(), attribute.String("url.template", "/group_channels/{channel_url}"))
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+	require.Len(t, rm.ScopeMetrics, 1)
+
+	metrics := map[string]metricdata.Aggregation{}
+	for _, m := range rm.ScopeMetrics[0].Metrics {
+		metrics[m.Name] = m.Data
+	}
+
+	duration, ok := metrics["sendbird.client.request.duration"].(metricdata.Histogram[float64])
+	require.True(t, ok)
+	require.Len(t, duration.DataPoints, 1)
+	assert.Equal(t, uint64(1), duration.DataPoints[0].Count)
+
+	errs, ok := metrics["sendbird.client.request.errors"].(metricdata.Sum[int64])
+	require.True(t, ok)
+	require.Len(t, errs.DataPoints, 1)
+	assert.Equal(t, int64(1), errs.DataPoints[0].Value)
+
+	retries, ok := metrics["sendbird.client.request.retries"].(metricdata.Sum[int64])
+	require.True(t, ok)
+	require.Len(t, retries.DataPoints, 1)
+	assert.Equal(t, int64(1), retries.DataPoints[0].Value)
+}
+
+func TestRetry(t *testing.T) {
+	t.Parallel()
+
+	rateLimit := `{"code":500910,"message":"rate limit exceeded","error":true}`
+
+	tests := []struct {
+		name          string
+		method        string
+		statusCode    int
+		body          string
+		maxRetries    int
+		retryPost     bool
+		expectedCalls int
+		expectedErr   error
+	}{
+		{
+			name:          "no retry by default",
+			method:        http.MethodGet,
+			statusCode:    http.StatusServiceUnavailable,
+			body:          `{"code":503,"message":"unavailable","error":true}`,
+			expectedCalls: 1,
+			expectedErr:   ErrAPIServiceUnavailable,
+		},
+		{
+			name:          "retry on rate limit",
+			method:        http.MethodGet,
+			statusCode:    http.StatusTooManyRequests,
+			body:          rateLimit,
+			maxRetries:    2,
+			expectedCalls: 3,
+			expectedErr:   ErrRateLimitExceeded,
+		},
+		{
+			name:          "retry delete",
+			method:        http.MethodDelete,
+			statusCode:    http.StatusTooManyRequests,
+			body:          rateLimit,
+			maxRetries:    1,
+			expectedCalls: 2,
+			expectedErr:   ErrRateLimitExceeded,
+		},
+		{
+			name:          "no retry on post",
+			method:        http.MethodPost,
+			statusCode:    http.StatusTooManyRequests,
+			body:          rateLimit,
+			maxRetries:    2,
+			expectedCalls: 1,
+			expectedErr:   ErrRateLimitExceeded,
+		},
+		{
+			name:          "retry on post when enabled",
+			method:        http.MethodPost,
+			statusCode:    http.StatusTooManyRequests,
+			body:          rateLimit,
+			maxRetries:    2,
+			retryPost:     true,
+			expectedCalls: 3,
+			expectedErr:   ErrRateLimitExceeded,
+		},
+		{
+			name:          "no retry on bad request",
+			method:        http.MethodGet,
+			statusCode:    http.StatusBadRequest,
+			body:          `{"code":400111,"message":"invalid value","error":true}`,
+			maxRetries:    2,
+			expectedCalls: 1,
+			expectedErr:   ErrInvalidValue,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls int
+
+			s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls++
+
+				assert.Equal(t, test.method, r.Method)
+				w.WriteHeader(test.statusCode)
+				_, _ = w.Write([]byte(test.body))
+			}))
+			defer s.Close()
+
+			c := NewClient(
+				WithURL(s.URL),
+				WithRetry(test.maxRetries, time.Millisecond),
+				WithRetryPost(test.retryPost),
+			)
+
+			var err error
+
+			switch test.method {
+			case http.MethodGet:
+				_, err = c.Get(context.Background(), "/foo/bar", nil, nil)
+			case http.MethodDelete:
+				_, err = c.Delete(context.Background(), "/foo/bar", nil, nil)
+			case http.MethodPost:
+				_, err = c.Post(context.Background(), "/foo/bar", map[string]string{"foo": "bar"}, nil)
+			}
+
+			require.ErrorIs(t, err, test.expectedErr)
+			assert.Equal(t, test.expectedCalls, calls)
+		})
+	}
+}
+
+func TestRetry_logsFailure(t *testing.T) {
+	t.Parallel()
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"code":400111,"message":"invalid value","error":true}`))
+	}))
+	defer s.Close()
+
+	var buf bytes.Buffer
+
+	c := NewClient(
+		WithURL(s.URL),
+		WithLogger(slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))),
+	)
+
+	_, err := c.Get(context.Background(), "/foo/bar", nil, nil)
+	require.ErrorIs(t, err, ErrInvalidValue)
+	assert.Contains(t, buf.String(), `msg="request failed" method=GET path=/foo/bar`)
+	assert.Contains(t, buf.String(), "status=400")
+}
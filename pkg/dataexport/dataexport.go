@@ -0,0 +1,33 @@
+// Package dataexport package provides the interface for the data export
+// service.
+// It provides the methods to interact with the sendbird API.
+// See https://sendbird.com/docs/chat/platform-api/v3/data-export/data-export-overview.
+package dataexport
+
+import (
+	"context"
+
+	"github.com/yumi-ia/sendbird-go/pkg/client"
+)
+
+type DataExport interface {
+	// RegisterExport registers a job exporting the data of a type, such as
+	// messages or channels, to a ZIP file.
+	// See https://sendbird.com/docs/chat/platform-api/v3/data-export/register-and-schedule-a-data-export
+	RegisterExport(ctx context.Context, dataType DataType, registerExportRequest RegisterExportRequest) (*RegisterExportResponse, error)
+	// ListExports lists the export jobs of a type of data.
+	// See https://sendbird.com/docs/chat/platform-api/v3/data-export/list-data-exports-by-message-channel-or-user
+	ListExports(ctx context.Context, dataType DataType, listExportsRequest ListExportsRequest) (*ListExportsResponse, error)
+	// GetExport retrieves an export job, including its status and the
+	// resulting file once done.
+	// See https://sendbird.com/docs/chat/platform-api/v3/data-export/get-a-data-export
+	GetExport(ctx context.Context, dataType DataType, requestID string) (*GetExportResponse, error)
+}
+
+type dataExport struct {
+	client client.Client
+}
+
+func NewDataExport(c client.Client) DataExport {
+	return &dataExport{client: c}
+}
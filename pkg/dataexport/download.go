@@ -0,0 +1,71 @@
+package dataexport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/yumi-ia/sendbird-go/pkg/utils/wait"
+)
+
+// ErrExportNotReady is returned when downloading an export job which isn't
+// done.
+var ErrExportNotReady = errors.New("export is not ready")
+
+// Wait polls an export job every interval until it is over, and returns its
+// last state. It returns early with the context error if ctx is done. The
+// interval must be positive.
+func Wait(ctx context.Context, de DataExport, dataType DataType, requestID string, interval time.Duration) (*GetExportResponse, error) {
+	ger, err := wait.Poll(ctx, interval, func(ctx context.Context) (*GetExportResponse, bool, error) {
+		ger, err := de.GetExport(ctx, dataType, requestID)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return ger, ger.Status.IsTerminal(), nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to wait for export: %w", err)
+	}
+
+	return ger, nil
+}
+
+// Download streams the ZIP file of a done export job to w and returns the
+// number of bytes written. The file is hosted outside of the sendbird API, so
+// it is downloaded with httpClient rather than the API client. If httpClient
+// is nil, http.DefaultClient is used.
+func Download(ctx context.Context, httpClient *http.Client, export *GetExportResponse, w io.Writer) (int64, error) {
+	if export.Status != StatusDone || export.File == nil || export.File.URL == "" {
+		return 0, fmt.Errorf("failed to download export %s with status %q: %w", export.RequestID, export.Status, ErrExportNotReady)
+	}
+
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, export.File.URL, http.NoBody)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	r, err := httpClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to download export: %w", err)
+	}
+	defer r.Body.Close()
+
+	if r.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("failed to download export: unexpected status %d", r.StatusCode)
+	}
+
+	n, err := io.Copy(w, r.Body)
+	if err != nil {
+		return n, fmt.Errorf("failed to write export: %w", err)
+	}
+
+	return n, nil
+}
@@ -0,0 +1,86 @@
+package dataexport
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWait(t *testing.T) {
+	t.Parallel()
+
+	done := &GetExportResponse{RequestID: "request-id", Status: StatusDone}
+
+	dataExport := NewDataExportMock(t).
+		OnGetExport(DataTypeMessages, "request-id").TypedReturns(&GetExportResponse{RequestID: "request-id", Status: StatusExporting}, nil).Once().
+		OnGetExport(DataTypeMessages, "request-id").TypedReturns(done, nil).Once().
+		Parent
+
+	ger, err := Wait(context.Background(), dataExport, DataTypeMessages, "request-id", time.Millisecond)
+	require.NoError(t, err)
+	assert.Equal(t, done, ger)
+}
+
+func TestWait_contextDone(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	dataExport := NewDataExportMock(t).
+		OnGetExport(DataTypeMessages, "request-id").TypedReturns(&GetExportResponse{Status: StatusScheduled}, nil).Once().
+		Parent
+
+	_, err := Wait(ctx, dataExport, DataTypeMessages, "request-id", time.Hour)
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestWait_invalidInterval(t *testing.T) {
+	t.Parallel()
+
+	_, err := Wait(context.Background(), NewDataExportMock(t), DataTypeMessages, "request-id", 0)
+	require.ErrorContains(t, err, "interval must be positive")
+}
+
+func TestDownload(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("zip-content"))
+	}))
+	defer server.Close()
+
+	export := &GetExportResponse{
+		RequestID: "request-id",
+		Status:    StatusDone,
+		File:      &File{URL: server.URL + "/export.zip"},
+	}
+
+	var buf bytes.Buffer
+	n, err := Download(context.Background(), server.Client(), export, &buf)
+	require.NoError(t, err)
+	assert.Equal(t, int64(len("zip-content")), n)
+	assert.Equal(t, "zip-content", buf.String())
+}
+
+func TestDownload_errors(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	_, err := Download(context.Background(), nil, &GetExportResponse{Status: StatusExporting}, &bytes.Buffer{})
+	require.ErrorIs(t, err, ErrExportNotReady)
+
+	export := &GetExportResponse{Status: StatusDone, File: &File{URL: server.URL}}
+	_, err = Download(context.Background(), server.Client(), export, &bytes.Buffer{})
+	require.Error(t, err)
+}
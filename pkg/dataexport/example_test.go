@@ -0,0 +1,19 @@
+package dataexport_test
+
+import (
+	"github.com/yumi-ia/sendbird-go/pkg/client"
+	"github.com/yumi-ia/sendbird-go/pkg/dataexport"
+)
+
+func ExampleNewDataExport() {
+	// Initialize a client.
+	opts := []client.Option{}
+	c := client.NewClient(opts...)
+
+	// Initialize a data export service.
+	de := dataexport.NewDataExport(c)
+
+	// the data export client is ready to be used.
+	_ = de
+	// de.DoWork()
+}
@@ -0,0 +1,92 @@
+package dataexport
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// ListExportsRequest is the request to list export jobs.
+type ListExportsRequest struct {
+	// Token specifies a page token that indicates the starting index of a chunk
+	// of results. If not specified, the index is set as 0.
+	// Optional.
+	Token string
+	// Limit specifies the number of results to return per page. Acceptable
+	// values are 1 to 100, inclusive. (Default: 10)
+	// Optional.
+	Limit *int
+}
+
+// ListExportsResponse is the response to list export jobs.
+type ListExportsResponse struct {
+	// ExportedData is the list of export jobs.
+	ExportedData []ExportResource `json:"exported_data"`
+	// Next is the value for the token parameter to retrieve the next page in the
+	// result.
+	Next string `json:"next"`
+}
+
+func listExportsRequestToMap(ler ListExportsRequest) map[string]string {
+	m := make(map[string]string)
+
+	if ler.Token != "" {
+		m["token"] = ler.Token
+	}
+
+	if ler.Limit != nil {
+		m["limit"] = strconv.Itoa(*ler.Limit)
+	}
+
+	return m
+}
+
+// ListExports lists the export jobs of a type of data.
+// See https://sendbird.com/docs/chat/platform-api/v3/data-export/list-data-exports-by-message-channel-or-user
+func (de *dataExport) ListExports(ctx context.Context, dataType DataType, listExportsRequest ListExportsRequest) (*ListExportsResponse, error) {
+	u := &url.URL{
+		Path: "/export/" + string(dataType),
+	}
+
+	query := u.Query()
+	for k, v := range listExportsRequestToMap(listExportsRequest) {
+		query.Set(k, v)
+	}
+
+	u.RawQuery = query.Encode()
+
+	ler, err := de.client.Get(ctx, u.String(), nil, &ListExportsResponse{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list exports: %w", err)
+	}
+
+	listExportsResponse, ok := ler.(*ListExportsResponse)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast body to ListExportsResponse: %+v", ler)
+	}
+
+	return listExportsResponse, nil
+}
+
+// GetExportResponse is the response to get an export job.
+type GetExportResponse ExportResource
+
+// GetExport retrieves an export job, including its status and the resulting
+// file once done.
+// See https://sendbird.com/docs/chat/platform-api/v3/data-export/get-a-data-export
+func (de *dataExport) GetExport(ctx context.Context, dataType DataType, requestID string) (*GetExportResponse, error) {
+	path := fmt.Sprintf("/export/%s/%s", dataType, requestID)
+
+	ger, err := de.client.Get(ctx, path, nil, &GetExportResponse{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get export: %w", err)
+	}
+
+	getExportResponse, ok := ger.(*GetExportResponse)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast body to GetExportResponse: %+v", ger)
+	}
+
+	return getExportResponse, nil
+}
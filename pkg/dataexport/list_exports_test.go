@@ -0,0 +1,49 @@
+package dataexport
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yumi-ia/sendbird-go/pkg/client"
+)
+
+func TestListExports(t *testing.T) {
+	t.Parallel()
+
+	limit := 5
+	listExportsResponse := &ListExportsResponse{
+		ExportedData: []ExportResource{{RequestID: "request-id", DataType: DataTypeUsers}},
+		Next:         "next",
+	}
+
+	client := client.NewClientMock(t).
+		OnGet("/export/users?limit=5&token=token", nil, &ListExportsResponse{}).TypedReturns(listExportsResponse, nil).Once().
+		Parent
+	dataExport := NewDataExport(client)
+
+	ler, err := dataExport.ListExports(context.Background(), DataTypeUsers, ListExportsRequest{Token: "token", Limit: &limit})
+	require.NoError(t, err)
+	assert.Equal(t, listExportsResponse, ler)
+}
+
+func TestGetExport(t *testing.T) {
+	t.Parallel()
+
+	getExportResponse := &GetExportResponse{
+		RequestID: "request-id",
+		Status:    StatusDone,
+		DataType:  DataTypeChannels,
+		File:      &File{URL: "https://example.com/export.zip", ExpiresAt: 1700000000000},
+	}
+
+	client := client.NewClientMock(t).
+		OnGet("/export/channels/request-id", nil, &GetExportResponse{}).TypedReturns(getExportResponse, nil).Once().
+		Parent
+	dataExport := NewDataExport(client)
+
+	ger, err := dataExport.GetExport(context.Background(), DataTypeChannels, "request-id")
+	require.NoError(t, err)
+	assert.Equal(t, getExportResponse, ger)
+}
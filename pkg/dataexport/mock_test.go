@@ -0,0 +1,4 @@
+package dataexport
+
+// https://github.com/traefik/mocktail
+// mocktail:DataExport
@@ -0,0 +1,97 @@
+package dataexport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// RegisterExportRequest is the request to register an export job.
+type RegisterExportRequest struct {
+	// StartTS specifies the start of the time range of the data to export, in
+	// Unix milliseconds - required.
+	StartTS int64 `json:"start_ts"`
+	// EndTS specifies the end of the time range of the data to export, in Unix
+	// milliseconds - required.
+	EndTS int64 `json:"end_ts"`
+
+	// Format specifies the format of the exported files.
+	// Optional. (Default: FormatJSON)
+	Format Format `json:"format,omitempty"`
+	// Timezone specifies the timezone of the timestamps of the exported files,
+	// such as "Europe/Paris".
+	// Optional. (Default: UTC)
+	Timezone string `json:"timezone,omitempty"`
+	// ChannelURLs restricts the export to the channels with the specified URLs.
+	// Only applies to messages and channels.
+	// Optional.
+	ChannelURLs []string `json:"channel_urls,omitempty"`
+	// ExcludeChannelURLs excludes the channels with the specified URLs from the
+	// export. Only applies to messages and channels.
+	// Optional.
+	ExcludeChannelURLs []string `json:"exclude_channel_urls,omitempty"`
+	// ChannelCustomTypes restricts the export to the channels with the
+	// specified custom types. Only applies to messages and channels.
+	// Optional.
+	ChannelCustomTypes []string `json:"channel_custom_types,omitempty"`
+	// SenderIDs restricts the export to the messages sent by the users with the
+	// specified IDs. Only applies to messages.
+	// Optional.
+	SenderIDs []string `json:"sender_ids,omitempty"`
+	// ExcludeSenderIDs excludes the messages sent by the users with the
+	// specified IDs from the export. Only applies to messages.
+	// Optional.
+	ExcludeSenderIDs []string `json:"exclude_sender_ids,omitempty"`
+	// UserIDs restricts the export to the users with the specified IDs. Only
+	// applies to users.
+	// Optional.
+	UserIDs []string `json:"user_ids,omitempty"`
+	// ShowReadReceipt determines whether to include the read receipts of the
+	// channels. Only applies to channels.
+	// Optional. (Default: false)
+	ShowReadReceipt *bool `json:"show_read_receipt,omitempty"`
+	// ShowChannelMetadata determines whether to include the metadata of the
+	// channels. Only applies to channels.
+	// Optional. (Default: false)
+	ShowChannelMetadata *bool `json:"show_channel_metadata,omitempty"`
+}
+
+func (rer *RegisterExportRequest) Validate() error {
+	switch {
+	case rer.StartTS == 0:
+		return errors.New("start_ts is required")
+	case rer.EndTS == 0:
+		return errors.New("end_ts is required")
+	case rer.StartTS > rer.EndTS:
+		return errors.New("start_ts must be before end_ts")
+	case rer.Format != "" && rer.Format != FormatJSON && rer.Format != FormatCSV:
+		return fmt.Errorf("invalid format %q", rer.Format)
+	}
+
+	return nil
+}
+
+// RegisterExportResponse is the response to register an export job.
+type RegisterExportResponse ExportResource
+
+// RegisterExport registers a job exporting the data of a type, such as
+// messages or channels, to a ZIP file. The job runs asynchronously, use
+// GetExport or Wait to follow its status.
+// See https://sendbird.com/docs/chat/platform-api/v3/data-export/register-and-schedule-a-data-export
+func (de *dataExport) RegisterExport(ctx context.Context, dataType DataType, registerExportRequest RegisterExportRequest) (*RegisterExportResponse, error) {
+	if err := registerExportRequest.Validate(); err != nil {
+		return nil, fmt.Errorf("failed to validate register export request: %w", err)
+	}
+
+	rer, err := de.client.Post(ctx, "/export/"+string(dataType), registerExportRequest, &RegisterExportResponse{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to register export: %w", err)
+	}
+
+	registerExportResponse, ok := rer.(*RegisterExportResponse)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast body to RegisterExportResponse: %+v", rer)
+	}
+
+	return registerExportResponse, nil
+}
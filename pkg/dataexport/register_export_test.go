@@ -0,0 +1,93 @@
+package dataexport
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yumi-ia/sendbird-go/pkg/client"
+)
+
+func TestRegisterExport(t *testing.T) {
+	t.Parallel()
+
+	registerExportRequest := RegisterExportRequest{
+		StartTS:     1700000000000,
+		EndTS:       1700086400000,
+		Format:      FormatCSV,
+		Timezone:    "Europe/Paris",
+		ChannelURLs: []string{"channel-url"},
+		SenderIDs:   []string{"user-id"},
+	}
+
+	registerExportResponse := &RegisterExportResponse{
+		RequestID:   "request-id",
+		Status:      StatusScheduled,
+		DataType:    DataTypeMessages,
+		Format:      FormatCSV,
+		StartTS:     1700000000000,
+		EndTS:       1700086400000,
+		Timezone:    "Europe/Paris",
+		ChannelURLs: []string{"channel-url"},
+		SenderIDs:   []string{"user-id"},
+	}
+
+	client := client.NewClientMock(t).
+		OnPost("/export/messages", registerExportRequest, &RegisterExportResponse{}).TypedReturns(registerExportResponse, nil).Once().
+		Parent
+	dataExport := NewDataExport(client)
+
+	rer, err := dataExport.RegisterExport(context.Background(), DataTypeMessages, registerExportRequest)
+	require.NoError(t, err)
+	assert.Equal(t, registerExportResponse, rer)
+}
+
+func TestRegisterExportRequest_Validate(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		request RegisterExportRequest
+		wantErr bool
+	}{
+		{
+			name:    "valid",
+			request: RegisterExportRequest{StartTS: 1, EndTS: 2, Format: FormatJSON},
+		},
+		{
+			name:    "missing start",
+			request: RegisterExportRequest{EndTS: 2},
+			wantErr: true,
+		},
+		{
+			name:    "missing end",
+			request: RegisterExportRequest{StartTS: 1},
+			wantErr: true,
+		},
+		{
+			name:    "inverted range",
+			request: RegisterExportRequest{StartTS: 2, EndTS: 1},
+			wantErr: true,
+		},
+		{
+			name:    "invalid format",
+			request: RegisterExportRequest{StartTS: 1, EndTS: 2, Format: "xml"},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tc.request.Validate()
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package dataexport
+
+// DataType is the type of data to export.
+type DataType string
+
+const (
+	DataTypeMessages       DataType = "messages"
+	DataTypeChannels       DataType = "channels"
+	DataTypeUsers          DataType = "users"
+	DataTypeFailedWebhooks DataType = "failed_webhooks"
+)
+
+// Format is the format of the exported files.
+type Format string
+
+const (
+	FormatJSON Format = "json"
+	FormatCSV  Format = "csv"
+)
+
+// Status is the status of an export job.
+type Status string
+
+const (
+	StatusScheduled Status = "scheduled"
+	StatusExporting Status = "exporting"
+	StatusDone      Status = "done"
+	StatusNoData    Status = "no_data"
+	StatusFailed    Status = "failed"
+)
+
+// IsTerminal reports whether the export job is over.
+func (s Status) IsTerminal() bool {
+	switch s {
+	case StatusDone, StatusNoData, StatusFailed:
+		return true
+	default:
+		return false
+	}
+}
+
+// File is the file resulting from an export job.
+type File struct {
+	// URL is the URL of the ZIP file.
+	URL string `json:"url"`
+	// ExpiresAt is the time the URL expires at, in Unix milliseconds.
+	ExpiresAt int64 `json:"expires_at"`
+}
+
+// ExportResource is the resource of an export job.
+type ExportResource struct {
+	RequestID   string   `json:"request_id"`
+	Status      Status   `json:"status"`
+	DataType    DataType `json:"data_type"`
+	Format      Format   `json:"format"`
+	StartTS     int64    `json:"start_ts"`
+	EndTS       int64    `json:"end_ts"`
+	Timezone    string   `json:"timezone"`
+	ChannelURLs []string `json:"channel_urls"`
+	SenderIDs   []string `json:"sender_ids"`
+	UserIDs     []string `json:"user_ids"`
+	CreatedAt   int64    `json:"created_at"`
+	File        *File    `json:"file"`
+}
@@ -0,0 +1,179 @@
+package emoji
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ListEmojiCategoriesResponse is the response to list the emoji categories.
+type ListEmojiCategoriesResponse struct {
+	// EmojiHash is the hash of the emojis of the application.
+	EmojiHash string `json:"emoji_hash"`
+	// EmojiCategories is the list of emoji categories with their emojis.
+	EmojiCategories []EmojiCategoryResource `json:"emoji_categories"`
+}
+
+// AddEmojiCategory is an emoji category to add.
+type AddEmojiCategory struct {
+	// Name specifies the name of the category - required.
+	Name string `json:"name"`
+	// URL specifies the URL of the image of the category - required.
+	URL string `json:"url"`
+	// Emojis specifies the emojis of the category.
+	// Optional.
+	Emojis []AddEmoji `json:"emojis,omitempty"`
+}
+
+func (aec *AddEmojiCategory) Validate() error {
+	switch {
+	case aec.Name == "":
+		return errors.New("name is required")
+	case aec.URL == "":
+		return errors.New("URL is required")
+	}
+
+	for _, ae := range aec.Emojis {
+		if err := ae.Validate(); err != nil {
+			return fmt.Errorf("invalid emoji of category %q: %w", aec.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// addEmojiCategoriesRequest is the request to add emoji categories.
+type addEmojiCategoriesRequest struct {
+	EmojiCategories []AddEmojiCategory `json:"emoji_categories"`
+}
+
+// AddEmojiCategoriesResponse is the response to add emoji categories.
+type AddEmojiCategoriesResponse struct {
+	// EmojiCategories is the list of the added emoji categories.
+	EmojiCategories []EmojiCategoryResource `json:"emoji_categories"`
+}
+
+// GetEmojiCategoryResponse is the response to get an emoji category.
+type GetEmojiCategoryResponse EmojiCategoryResource
+
+// UpdateEmojiCategoryRequest is the request to update an emoji category.
+type UpdateEmojiCategoryRequest struct {
+	// Name specifies the new name of the category.
+	// Optional.
+	Name string `json:"name,omitempty"`
+	// URL specifies the URL of the new image of the category.
+	// Optional.
+	URL string `json:"url,omitempty"`
+}
+
+// UpdateEmojiCategoryResponse is the response to update an emoji category.
+type UpdateEmojiCategoryResponse EmojiCategoryResource
+
+// ListEmojiCategories lists the emoji categories of the application with
+// their emojis, along with the emoji hash.
+// See https://sendbird.com/docs/chat/platform-api/v3/message/reactions-and-emojis/list-all-emojis-and-emoji-categories
+func (e *emoji) ListEmojiCategories(ctx context.Context) (*ListEmojiCategoriesResponse, error) {
+	lecr, err := e.client.Get(ctx, "/emoji_categories", nil, &ListEmojiCategoriesResponse{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list emoji categories: %w", err)
+	}
+
+	listEmojiCategoriesResponse, ok := lecr.(*ListEmojiCategoriesResponse)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast body to ListEmojiCategoriesResponse: %+v", lecr)
+	}
+
+	return listEmojiCategoriesResponse, nil
+}
+
+// GetEmojiHash retrieves the emoji hash, which changes whenever an emoji or an
+// emoji category is added, updated or deleted. Comparing it to a previous
+// value tells whether the emojis have to be synchronized again.
+// See https://sendbird.com/docs/chat/platform-api/v3/message/reactions-and-emojis/list-all-emojis-and-emoji-categories
+func (e *emoji) GetEmojiHash(ctx context.Context) (string, error) {
+	lecr, err := e.ListEmojiCategories(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get emoji hash: %w", err)
+	}
+
+	return lecr.EmojiHash, nil
+}
+
+// AddEmojiCategories adds one or more emoji categories, with their emojis.
+// See https://sendbird.com/docs/chat/platform-api/v3/message/reactions-and-emojis/add-emoji-categories
+func (e *emoji) AddEmojiCategories(ctx context.Context, categories []AddEmojiCategory) (*AddEmojiCategoriesResponse, error) {
+	if len(categories) == 0 {
+		return nil, errors.New("categories are required")
+	}
+
+	for _, category := range categories {
+		if err := category.Validate(); err != nil {
+			return nil, fmt.Errorf("failed to validate emoji category: %w", err)
+		}
+	}
+
+	req := addEmojiCategoriesRequest{
+		EmojiCategories: categories,
+	}
+
+	aecr, err := e.client.Post(ctx, "/emoji_categories", req, &AddEmojiCategoriesResponse{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to add emoji categories: %w", err)
+	}
+
+	addEmojiCategoriesResponse, ok := aecr.(*AddEmojiCategoriesResponse)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast body to AddEmojiCategoriesResponse: %+v", aecr)
+	}
+
+	return addEmojiCategoriesResponse, nil
+}
+
+// GetEmojiCategory retrieves an emoji category with its emojis.
+// See https://sendbird.com/docs/chat/platform-api/v3/message/reactions-and-emojis/get-an-emoji-category
+func (e *emoji) GetEmojiCategory(ctx context.Context, categoryID int) (*GetEmojiCategoryResponse, error) {
+	path := fmt.Sprintf("/emoji_categories/%d", categoryID)
+
+	gecr, err := e.client.Get(ctx, path, nil, &GetEmojiCategoryResponse{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get emoji category: %w", err)
+	}
+
+	getEmojiCategoryResponse, ok := gecr.(*GetEmojiCategoryResponse)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast body to GetEmojiCategoryResponse: %+v", gecr)
+	}
+
+	return getEmojiCategoryResponse, nil
+}
+
+// UpdateEmojiCategory updates the name and the URL of an emoji category.
+// See https://sendbird.com/docs/chat/platform-api/v3/message/reactions-and-emojis/update-an-emoji-category-url
+func (e *emoji) UpdateEmojiCategory(ctx context.Context, categoryID int, updateEmojiCategoryRequest UpdateEmojiCategoryRequest) (*UpdateEmojiCategoryResponse, error) {
+	path := fmt.Sprintf("/emoji_categories/%d", categoryID)
+
+	uecr, err := e.client.Put(ctx, path, updateEmojiCategoryRequest, &UpdateEmojiCategoryResponse{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update emoji category: %w", err)
+	}
+
+	updateEmojiCategoryResponse, ok := uecr.(*UpdateEmojiCategoryResponse)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast body to UpdateEmojiCategoryResponse: %+v", uecr)
+	}
+
+	return updateEmojiCategoryResponse, nil
+}
+
+// DeleteEmojiCategory deletes an emoji category with its emojis.
+// See https://sendbird.com/docs/chat/platform-api/v3/message/reactions-and-emojis/delete-an-emoji-category
+func (e *emoji) DeleteEmojiCategory(ctx context.Context, categoryID int) error {
+	path := fmt.Sprintf("/emoji_categories/%d", categoryID)
+
+	_, err := e.client.Delete(ctx, path, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to delete emoji category: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,131 @@
+package emoji
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yumi-ia/sendbird-go/pkg/client"
+)
+
+func TestListEmojiCategories(t *testing.T) {
+	t.Parallel()
+
+	listEmojiCategoriesResponse := &ListEmojiCategoriesResponse{
+		EmojiHash: "emoji-hash",
+		EmojiCategories: []EmojiCategoryResource{{
+			ID:     1,
+			Name:   "smileys",
+			URL:    "https://example.com/smileys.png",
+			Emojis: []EmojiResource{{ID: 1, Key: "smile", URL: "https://example.com/smile.png"}},
+		}},
+	}
+
+	client := client.NewClientMock(t).
+		OnGet("/emoji_categories", nil, &ListEmojiCategoriesResponse{}).TypedReturns(listEmojiCategoriesResponse, nil).Once().
+		Parent
+	emoji := NewEmoji(client)
+
+	lecr, err := emoji.ListEmojiCategories(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, listEmojiCategoriesResponse, lecr)
+}
+
+func TestGetEmojiHash(t *testing.T) {
+	t.Parallel()
+
+	client := client.NewClientMock(t).
+		OnGet("/emoji_categories", nil, &ListEmojiCategoriesResponse{}).TypedReturns(&ListEmojiCategoriesResponse{EmojiHash: "emoji-hash"}, nil).Once().
+		Parent
+	emoji := NewEmoji(client)
+
+	hash, err := emoji.GetEmojiHash(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "emoji-hash", hash)
+}
+
+func TestAddEmojiCategories(t *testing.T) {
+	t.Parallel()
+
+	categories := []AddEmojiCategory{{
+		Name:   "smileys",
+		URL:    "https://example.com/smileys.png",
+		Emojis: []AddEmoji{{Key: "smile", URL: "https://example.com/smile.png"}},
+	}}
+
+	addEmojiCategoriesResponse := &AddEmojiCategoriesResponse{
+		EmojiCategories: []EmojiCategoryResource{{ID: 1, Name: "smileys", URL: "https://example.com/smileys.png"}},
+	}
+
+	client := client.NewClientMock(t).
+		OnPost("/emoji_categories", addEmojiCategoriesRequest{EmojiCategories: categories}, &AddEmojiCategoriesResponse{}).TypedReturns(addEmojiCategoriesResponse, nil).Once().
+		Parent
+	emoji := NewEmoji(client)
+
+	aecr, err := emoji.AddEmojiCategories(context.Background(), categories)
+	require.NoError(t, err)
+	assert.Equal(t, addEmojiCategoriesResponse, aecr)
+}
+
+func TestAddEmojiCategories_invalid(t *testing.T) {
+	t.Parallel()
+
+	emoji := NewEmoji(client.NewClientMock(t))
+
+	_, err := emoji.AddEmojiCategories(context.Background(), nil)
+	require.Error(t, err)
+
+	_, err = emoji.AddEmojiCategories(context.Background(), []AddEmojiCategory{{Name: "smileys"}})
+	require.Error(t, err)
+
+	_, err = emoji.AddEmojiCategories(context.Background(), []AddEmojiCategory{{
+		Name:   "smileys",
+		URL:    "https://example.com/smileys.png",
+		Emojis: []AddEmoji{{Key: "smile"}},
+	}})
+	require.Error(t, err)
+}
+
+func TestGetEmojiCategory(t *testing.T) {
+	t.Parallel()
+
+	getEmojiCategoryResponse := &GetEmojiCategoryResponse{ID: 1, Name: "smileys"}
+
+	client := client.NewClientMock(t).
+		OnGet("/emoji_categories/1", nil, &GetEmojiCategoryResponse{}).TypedReturns(getEmojiCategoryResponse, nil).Once().
+		Parent
+	emoji := NewEmoji(client)
+
+	gecr, err := emoji.GetEmojiCategory(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, getEmojiCategoryResponse, gecr)
+}
+
+func TestUpdateEmojiCategory(t *testing.T) {
+	t.Parallel()
+
+	updateEmojiCategoryRequest := UpdateEmojiCategoryRequest{Name: "faces"}
+	updateEmojiCategoryResponse := &UpdateEmojiCategoryResponse{ID: 1, Name: "faces"}
+
+	client := client.NewClientMock(t).
+		OnPut("/emoji_categories/1", updateEmojiCategoryRequest, &UpdateEmojiCategoryResponse{}).TypedReturns(updateEmojiCategoryResponse, nil).Once().
+		Parent
+	emoji := NewEmoji(client)
+
+	uecr, err := emoji.UpdateEmojiCategory(context.Background(), 1, updateEmojiCategoryRequest)
+	require.NoError(t, err)
+	assert.Equal(t, updateEmojiCategoryResponse, uecr)
+}
+
+func TestDeleteEmojiCategory(t *testing.T) {
+	t.Parallel()
+
+	client := client.NewClientMock(t).
+		OnDelete("/emoji_categories/1", nil, nil).TypedReturns(nil, nil).Once().
+		Parent
+	emoji := NewEmoji(client)
+
+	err := emoji.DeleteEmojiCategory(context.Background(), 1)
+	require.NoError(t, err)
+}
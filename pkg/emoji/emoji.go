@@ -0,0 +1,61 @@
+// Package emoji package provides the interface for the emoji service.
+// It provides the methods to interact with the sendbird API.
+// See https://sendbird.com/docs/chat/platform-api/v3/message/reactions-and-emojis/reactions-and-emojis-overview.
+package emoji
+
+import (
+	"context"
+
+	"github.com/yumi-ia/sendbird-go/pkg/client"
+)
+
+type Emoji interface {
+	// EnableReactions turns the reactions feature of the application on or off.
+	// See https://sendbird.com/docs/chat/platform-api/v3/message/reactions-and-emojis/enable-reactions
+	EnableReactions(ctx context.Context, enabled bool) (*EnableReactionsResponse, error)
+
+	// ListEmojiCategories lists the emoji categories of the application with
+	// their emojis, along with the emoji hash.
+	// See https://sendbird.com/docs/chat/platform-api/v3/message/reactions-and-emojis/list-all-emojis-and-emoji-categories
+	ListEmojiCategories(ctx context.Context) (*ListEmojiCategoriesResponse, error)
+	// GetEmojiHash retrieves the emoji hash, which changes whenever an emoji
+	// or an emoji category is added, updated or deleted.
+	// See https://sendbird.com/docs/chat/platform-api/v3/message/reactions-and-emojis/list-all-emojis-and-emoji-categories
+	GetEmojiHash(ctx context.Context) (string, error)
+	// AddEmojiCategories adds one or more emoji categories, with their emojis.
+	// See https://sendbird.com/docs/chat/platform-api/v3/message/reactions-and-emojis/add-emoji-categories
+	AddEmojiCategories(ctx context.Context, categories []AddEmojiCategory) (*AddEmojiCategoriesResponse, error)
+	// GetEmojiCategory retrieves an emoji category with its emojis.
+	// See https://sendbird.com/docs/chat/platform-api/v3/message/reactions-and-emojis/get-an-emoji-category
+	GetEmojiCategory(ctx context.Context, categoryID int) (*GetEmojiCategoryResponse, error)
+	// UpdateEmojiCategory updates the name and the URL of an emoji category.
+	// See https://sendbird.com/docs/chat/platform-api/v3/message/reactions-and-emojis/update-an-emoji-category-url
+	UpdateEmojiCategory(ctx context.Context, categoryID int, updateEmojiCategoryRequest UpdateEmojiCategoryRequest) (*UpdateEmojiCategoryResponse, error)
+	// DeleteEmojiCategory deletes an emoji category with its emojis.
+	// See https://sendbird.com/docs/chat/platform-api/v3/message/reactions-and-emojis/delete-an-emoji-category
+	DeleteEmojiCategory(ctx context.Context, categoryID int) error
+
+	// ListEmojis lists the emojis of the application.
+	// See https://sendbird.com/docs/chat/platform-api/v3/message/reactions-and-emojis/list-emojis
+	ListEmojis(ctx context.Context) (*ListEmojisResponse, error)
+	// AddEmojis adds one or more emojis to an emoji category.
+	// See https://sendbird.com/docs/chat/platform-api/v3/message/reactions-and-emojis/add-emojis
+	AddEmojis(ctx context.Context, categoryID int, emojis []AddEmoji) (*AddEmojisResponse, error)
+	// GetEmoji retrieves an emoji.
+	// See https://sendbird.com/docs/chat/platform-api/v3/message/reactions-and-emojis/get-an-emoji
+	GetEmoji(ctx context.Context, emojiKey string) (*GetEmojiResponse, error)
+	// UpdateEmoji updates the image URL of an emoji.
+	// See https://sendbird.com/docs/chat/platform-api/v3/message/reactions-and-emojis/update-an-emoji-url
+	UpdateEmoji(ctx context.Context, emojiKey, emojiURL string) (*UpdateEmojiResponse, error)
+	// DeleteEmoji deletes an emoji.
+	// See https://sendbird.com/docs/chat/platform-api/v3/message/reactions-and-emojis/delete-an-emoji
+	DeleteEmoji(ctx context.Context, emojiKey string) error
+}
+
+type emoji struct {
+	client client.Client
+}
+
+func NewEmoji(c client.Client) Emoji {
+	return &emoji{client: c}
+}
@@ -0,0 +1,153 @@
+package emoji
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ListEmojisResponse is the response to list the emojis.
+type ListEmojisResponse struct {
+	// Emojis is the list of emojis.
+	Emojis []EmojiResource `json:"emojis"`
+}
+
+// AddEmoji is an emoji to add.
+type AddEmoji struct {
+	// Key specifies the unique key of the emoji - required.
+	Key string `json:"key"`
+	// URL specifies the URL of the image of the emoji - required.
+	URL string `json:"url"`
+}
+
+func (ae *AddEmoji) Validate() error {
+	switch {
+	case ae.Key == "":
+		return errors.New("key is required")
+	case ae.URL == "":
+		return errors.New("URL is required")
+	}
+
+	return nil
+}
+
+// addEmojisRequest is the request to add emojis.
+type addEmojisRequest struct {
+	EmojiCategoryID int        `json:"emoji_category_id"`
+	Emojis          []AddEmoji `json:"emojis"`
+}
+
+// AddEmojisResponse is the response to add emojis.
+type AddEmojisResponse struct {
+	// Emojis is the list of the added emojis.
+	Emojis []EmojiResource `json:"emojis"`
+}
+
+// GetEmojiResponse is the response to get an emoji.
+type GetEmojiResponse EmojiResource
+
+// updateEmojiRequest is the request to update an emoji.
+type updateEmojiRequest struct {
+	URL string `json:"url"`
+}
+
+// UpdateEmojiResponse is the response to update an emoji.
+type UpdateEmojiResponse EmojiResource
+
+// ListEmojis lists the emojis of the application.
+// See https://sendbird.com/docs/chat/platform-api/v3/message/reactions-and-emojis/list-emojis
+func (e *emoji) ListEmojis(ctx context.Context) (*ListEmojisResponse, error) {
+	ler, err := e.client.Get(ctx, "/emojis", nil, &ListEmojisResponse{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list emojis: %w", err)
+	}
+
+	listEmojisResponse, ok := ler.(*ListEmojisResponse)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast body to ListEmojisResponse: %+v", ler)
+	}
+
+	return listEmojisResponse, nil
+}
+
+// AddEmojis adds one or more emojis to an emoji category.
+// See https://sendbird.com/docs/chat/platform-api/v3/message/reactions-and-emojis/add-emojis
+func (e *emoji) AddEmojis(ctx context.Context, categoryID int, emojis []AddEmoji) (*AddEmojisResponse, error) {
+	if len(emojis) == 0 {
+		return nil, errors.New("emojis are required")
+	}
+
+	for _, ae := range emojis {
+		if err := ae.Validate(); err != nil {
+			return nil, fmt.Errorf("failed to validate emoji: %w", err)
+		}
+	}
+
+	req := addEmojisRequest{
+		EmojiCategoryID: categoryID,
+		Emojis:          emojis,
+	}
+
+	aer, err := e.client.Post(ctx, "/emojis", req, &AddEmojisResponse{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to add emojis: %w", err)
+	}
+
+	addEmojisResponse, ok := aer.(*AddEmojisResponse)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast body to AddEmojisResponse: %+v", aer)
+	}
+
+	return addEmojisResponse, nil
+}
+
+// GetEmoji retrieves an emoji.
+// See https://sendbird.com/docs/chat/platform-api/v3/message/reactions-and-emojis/get-an-emoji
+func (e *emoji) GetEmoji(ctx context.Context, emojiKey string) (*GetEmojiResponse, error) {
+	ger, err := e.client.Get(ctx, "/emojis/"+emojiKey, nil, &GetEmojiResponse{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get emoji: %w", err)
+	}
+
+	getEmojiResponse, ok := ger.(*GetEmojiResponse)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast body to GetEmojiResponse: %+v", ger)
+	}
+
+	return getEmojiResponse, nil
+}
+
+// UpdateEmoji updates the image URL of an emoji.
+// See https://sendbird.com/docs/chat/platform-api/v3/message/reactions-and-emojis/update-an-emoji-url
+func (e *emoji) UpdateEmoji(ctx context.Context, emojiKey, emojiURL string) (*UpdateEmojiResponse, error) {
+	if emojiURL == "" {
+		return nil, errors.New("URL is required")
+	}
+
+	req := updateEmojiRequest{
+		URL: emojiURL,
+	}
+
+	uer, err := e.client.Put(ctx, "/emojis/"+emojiKey, req, &UpdateEmojiResponse{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update emoji: %w", err)
+	}
+
+	updateEmojiResponse, ok := uer.(*UpdateEmojiResponse)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast body to UpdateEmojiResponse: %+v", uer)
+	}
+
+	return updateEmojiResponse, nil
+}
+
+// DeleteEmoji deletes an emoji.
+// See https://sendbird.com/docs/chat/platform-api/v3/message/reactions-and-emojis/delete-an-emoji
+func (e *emoji) DeleteEmoji(ctx context.Context, emojiKey string) error {
+	_, err := e.client.Delete(ctx, "/emojis/"+emojiKey, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to delete emoji: %w", err)
+	}
+
+	return nil
+}
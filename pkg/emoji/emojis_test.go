@@ -0,0 +1,99 @@
+package emoji
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yumi-ia/sendbird-go/pkg/client"
+)
+
+func TestListEmojis(t *testing.T) {
+	t.Parallel()
+
+	listEmojisResponse := &ListEmojisResponse{
+		Emojis: []EmojiResource{{ID: 1, Key: "smile", URL: "https://example.com/smile.png"}},
+	}
+
+	client := client.NewClientMock(t).
+		OnGet("/emojis", nil, &ListEmojisResponse{}).TypedReturns(listEmojisResponse, nil).Once().
+		Parent
+	emoji := NewEmoji(client)
+
+	ler, err := emoji.ListEmojis(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, listEmojisResponse, ler)
+}
+
+func TestAddEmojis(t *testing.T) {
+	t.Parallel()
+
+	emojis := []AddEmoji{{Key: "smile", URL: "https://example.com/smile.png"}}
+	addEmojisResponse := &AddEmojisResponse{
+		Emojis: []EmojiResource{{ID: 1, Key: "smile", URL: "https://example.com/smile.png"}},
+	}
+
+	client := client.NewClientMock(t).
+		OnPost("/emojis", addEmojisRequest{EmojiCategoryID: 1, Emojis: emojis}, &AddEmojisResponse{}).TypedReturns(addEmojisResponse, nil).Once().
+		Parent
+	emoji := NewEmoji(client)
+
+	aer, err := emoji.AddEmojis(context.Background(), 1, emojis)
+	require.NoError(t, err)
+	assert.Equal(t, addEmojisResponse, aer)
+}
+
+func TestAddEmojis_invalid(t *testing.T) {
+	t.Parallel()
+
+	emoji := NewEmoji(client.NewClientMock(t))
+
+	_, err := emoji.AddEmojis(context.Background(), 1, nil)
+	require.Error(t, err)
+
+	_, err = emoji.AddEmojis(context.Background(), 1, []AddEmoji{{URL: "https://example.com/smile.png"}})
+	require.Error(t, err)
+}
+
+func TestGetEmoji(t *testing.T) {
+	t.Parallel()
+
+	getEmojiResponse := &GetEmojiResponse{ID: 1, Key: "smile", URL: "https://example.com/smile.png"}
+
+	client := client.NewClientMock(t).
+		OnGet("/emojis/smile", nil, &GetEmojiResponse{}).TypedReturns(getEmojiResponse, nil).Once().
+		Parent
+	emoji := NewEmoji(client)
+
+	ger, err := emoji.GetEmoji(context.Background(), "smile")
+	require.NoError(t, err)
+	assert.Equal(t, getEmojiResponse, ger)
+}
+
+func TestUpdateEmoji(t *testing.T) {
+	t.Parallel()
+
+	updateEmojiResponse := &UpdateEmojiResponse{ID: 1, Key: "smile", URL: "https://example.com/smile-v2.png"}
+
+	client := client.NewClientMock(t).
+		OnPut("/emojis/smile", updateEmojiRequest{URL: "https://example.com/smile-v2.png"}, &UpdateEmojiResponse{}).TypedReturns(updateEmojiResponse, nil).Once().
+		Parent
+	emoji := NewEmoji(client)
+
+	uer, err := emoji.UpdateEmoji(context.Background(), "smile", "https://example.com/smile-v2.png")
+	require.NoError(t, err)
+	assert.Equal(t, updateEmojiResponse, uer)
+}
+
+func TestDeleteEmoji(t *testing.T) {
+	t.Parallel()
+
+	client := client.NewClientMock(t).
+		OnDelete("/emojis/smile", nil, nil).TypedReturns(nil, nil).Once().
+		Parent
+	emoji := NewEmoji(client)
+
+	err := emoji.DeleteEmoji(context.Background(), "smile")
+	require.NoError(t, err)
+}
@@ -0,0 +1,19 @@
+package emoji_test
+
+import (
+	"github.com/yumi-ia/sendbird-go/pkg/client"
+	"github.com/yumi-ia/sendbird-go/pkg/emoji"
+)
+
+func ExampleNewEmoji() {
+	// Initialize a client.
+	opts := []client.Option{}
+	c := client.NewClient(opts...)
+
+	// Initialize an emoji service.
+	e := emoji.NewEmoji(c)
+
+	// the emoji client is ready to be used.
+	_ = e
+	// e.DoWork()
+}
@@ -0,0 +1,4 @@
+package emoji
+
+// https://github.com/traefik/mocktail
+// mocktail:Emoji
@@ -0,0 +1,39 @@
+package emoji
+
+import (
+	"context"
+	"fmt"
+)
+
+// enableReactionsRequest is the request to turn the reactions feature on or
+// off.
+type enableReactionsRequest struct {
+	Enabled bool `json:"enabled"`
+}
+
+// EnableReactionsResponse is the response to turn the reactions feature on or
+// off.
+type EnableReactionsResponse struct {
+	// Reactions indicates whether the reactions feature is turned on.
+	Reactions bool `json:"reactions"`
+}
+
+// EnableReactions turns the reactions feature of the application on or off.
+// See https://sendbird.com/docs/chat/platform-api/v3/message/reactions-and-emojis/enable-reactions
+func (e *emoji) EnableReactions(ctx context.Context, enabled bool) (*EnableReactionsResponse, error) {
+	req := enableReactionsRequest{
+		Enabled: enabled,
+	}
+
+	ers, err := e.client.Put(ctx, "/applications/settings/use_reactions", req, &EnableReactionsResponse{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to enable reactions: %w", err)
+	}
+
+	enableReactionsResponse, ok := ers.(*EnableReactionsResponse)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast body to EnableReactionsResponse: %+v", ers)
+	}
+
+	return enableReactionsResponse, nil
+}
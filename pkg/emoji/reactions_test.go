@@ -0,0 +1,25 @@
+package emoji
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yumi-ia/sendbird-go/pkg/client"
+)
+
+func TestEnableReactions(t *testing.T) {
+	t.Parallel()
+
+	enableReactionsResponse := &EnableReactionsResponse{Reactions: true}
+
+	client := client.NewClientMock(t).
+		OnPut("/applications/settings/use_reactions", enableReactionsRequest{Enabled: true}, &EnableReactionsResponse{}).TypedReturns(enableReactionsResponse, nil).Once().
+		Parent
+	emoji := NewEmoji(client)
+
+	ers, err := emoji.EnableReactions(context.Background(), true)
+	require.NoError(t, err)
+	assert.Equal(t, enableReactionsResponse, ers)
+}
@@ -0,0 +1,16 @@
+package emoji
+
+// EmojiResource is the resource of an emoji.
+type EmojiResource struct {
+	ID  int    `json:"id"`
+	Key string `json:"key"`
+	URL string `json:"url"`
+}
+
+// EmojiCategoryResource is the resource of an emoji category.
+type EmojiCategoryResource struct {
+	ID     int             `json:"id"`
+	Name   string          `json:"name"`
+	URL    string          `json:"url"`
+	Emojis []EmojiResource `json:"emojis"`
+}
@@ -0,0 +1,19 @@
+package gdpr_test
+
+import (
+	"github.com/yumi-ia/sendbird-go/pkg/client"
+	"github.com/yumi-ia/sendbird-go/pkg/gdpr"
+)
+
+func ExampleNewGDPR() {
+	// Initialize a client.
+	opts := []client.Option{}
+	c := client.NewClient(opts...)
+
+	// Initialize a GDPR service.
+	g := gdpr.NewGDPR(c)
+
+	// the GDPR client is ready to be used.
+	_ = g
+	// g.DoWork()
+}
@@ -0,0 +1,35 @@
+// Package gdpr package provides the interface for the GDPR service.
+// It provides the methods to interact with the sendbird API.
+// See https://sendbird.com/docs/chat/platform-api/v3/privacy/privacy-overview.
+package gdpr
+
+import (
+	"context"
+
+	"github.com/yumi-ia/sendbird-go/pkg/client"
+)
+
+type GDPR interface {
+	// RegisterRequest registers a GDPR request to access or delete the data of
+	// a user.
+	// See https://sendbird.com/docs/chat/platform-api/v3/privacy/gdpr/register-a-gdpr-request
+	RegisterRequest(ctx context.Context, registerRequestRequest RegisterRequestRequest) (*RegisterRequestResponse, error)
+	// ListRequests lists the GDPR requests of the application.
+	// See https://sendbird.com/docs/chat/platform-api/v3/privacy/gdpr/list-gdpr-requests
+	ListRequests(ctx context.Context, listRequestsRequest ListRequestsRequest) (*ListRequestsResponse, error)
+	// GetRequest retrieves a GDPR request, including its status and, for
+	// access requests, the URL of the resulting file once done.
+	// See https://sendbird.com/docs/chat/platform-api/v3/privacy/gdpr/get-a-gdpr-request
+	GetRequest(ctx context.Context, requestID string) (*GetRequestResponse, error)
+	// CancelRequest cancels a GDPR request which hasn't started yet.
+	// See https://sendbird.com/docs/chat/platform-api/v3/privacy/gdpr/cancel-a-gdpr-request
+	CancelRequest(ctx context.Context, requestID string) error
+}
+
+type gdpr struct {
+	client client.Client
+}
+
+func NewGDPR(c client.Client) GDPR {
+	return &gdpr{client: c}
+}
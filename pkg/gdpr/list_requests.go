@@ -0,0 +1,101 @@
+package gdpr
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// ListRequestsRequest is the request to list GDPR requests.
+type ListRequestsRequest struct {
+	// Token specifies a page token that indicates the starting index of a chunk
+	// of results. If not specified, the index is set as 0.
+	// Optional.
+	Token string
+	// Limit specifies the number of results to return per page. Acceptable
+	// values are 1 to 100, inclusive. (Default: 10)
+	// Optional.
+	Limit *int
+}
+
+// ListRequestsResponse is the response to list GDPR requests.
+type ListRequestsResponse struct {
+	// Requests is the list of GDPR requests.
+	Requests []RequestResource `json:"requests"`
+	// Next is the value for the token parameter to retrieve the next page in the
+	// result.
+	Next string `json:"next"`
+}
+
+func listRequestsRequestToMap(lrr ListRequestsRequest) map[string]string {
+	m := make(map[string]string)
+
+	if lrr.Token != "" {
+		m["token"] = lrr.Token
+	}
+
+	if lrr.Limit != nil {
+		m["limit"] = strconv.Itoa(*lrr.Limit)
+	}
+
+	return m
+}
+
+// ListRequests lists the GDPR requests of the application.
+// See https://sendbird.com/docs/chat/platform-api/v3/privacy/gdpr/list-gdpr-requests
+func (g *gdpr) ListRequests(ctx context.Context, listRequestsRequest ListRequestsRequest) (*ListRequestsResponse, error) {
+	u := &url.URL{
+		Path: "/privacy/gdpr",
+	}
+
+	query := u.Query()
+	for k, v := range listRequestsRequestToMap(listRequestsRequest) {
+		query.Set(k, v)
+	}
+
+	u.RawQuery = query.Encode()
+
+	lrr, err := g.client.Get(ctx, u.String(), nil, &ListRequestsResponse{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list GDPR requests: %w", err)
+	}
+
+	listRequestsResponse, ok := lrr.(*ListRequestsResponse)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast body to ListRequestsResponse: %+v", lrr)
+	}
+
+	return listRequestsResponse, nil
+}
+
+// GetRequestResponse is the response to get a GDPR request.
+type GetRequestResponse RequestResource
+
+// GetRequest retrieves a GDPR request, including its status and, for access
+// requests, the URL of the resulting file once done.
+// See https://sendbird.com/docs/chat/platform-api/v3/privacy/gdpr/get-a-gdpr-request
+func (g *gdpr) GetRequest(ctx context.Context, requestID string) (*GetRequestResponse, error) {
+	grr, err := g.client.Get(ctx, "/privacy/gdpr/"+requestID, nil, &GetRequestResponse{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get GDPR request: %w", err)
+	}
+
+	getRequestResponse, ok := grr.(*GetRequestResponse)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast body to GetRequestResponse: %+v", grr)
+	}
+
+	return getRequestResponse, nil
+}
+
+// CancelRequest cancels a GDPR request which hasn't started yet.
+// See https://sendbird.com/docs/chat/platform-api/v3/privacy/gdpr/cancel-a-gdpr-request
+func (g *gdpr) CancelRequest(ctx context.Context, requestID string) error {
+	_, err := g.client.Delete(ctx, "/privacy/gdpr/"+requestID, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to cancel GDPR request: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package gdpr
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yumi-ia/sendbird-go/pkg/client"
+)
+
+func TestListRequests(t *testing.T) {
+	t.Parallel()
+
+	limit := 5
+	listRequestsResponse := &ListRequestsResponse{
+		Requests: []RequestResource{{RequestID: "request-id", Status: StatusProcessing}},
+		Next:     "next",
+	}
+
+	client := client.NewClientMock(t).
+		OnGet("/privacy/gdpr?limit=5&token=token", nil, &ListRequestsResponse{}).TypedReturns(listRequestsResponse, nil).Once().
+		Parent
+	gdpr := NewGDPR(client)
+
+	lrr, err := gdpr.ListRequests(context.Background(), ListRequestsRequest{Token: "token", Limit: &limit})
+	require.NoError(t, err)
+	assert.Equal(t, listRequestsResponse, lrr)
+}
+
+func TestGetRequest(t *testing.T) {
+	t.Parallel()
+
+	getRequestResponse := &GetRequestResponse{
+		RequestID: "request-id",
+		Action:    ActionAccess,
+		Status:    StatusDone,
+		Files:     &File{URL: "https://example.com/gdpr.zip", ExpiresAt: 1700000000000},
+	}
+
+	client := client.NewClientMock(t).
+		OnGet("/privacy/gdpr/request-id", nil, &GetRequestResponse{}).TypedReturns(getRequestResponse, nil).Once().
+		Parent
+	gdpr := NewGDPR(client)
+
+	grr, err := gdpr.GetRequest(context.Background(), "request-id")
+	require.NoError(t, err)
+	assert.Equal(t, getRequestResponse, grr)
+}
+
+func TestCancelRequest(t *testing.T) {
+	t.Parallel()
+
+	client := client.NewClientMock(t).
+		OnDelete("/privacy/gdpr/request-id", nil, nil).TypedReturns(nil, nil).Once().
+		Parent
+	gdpr := NewGDPR(client)
+
+	err := gdpr.CancelRequest(context.Background(), "request-id")
+	require.NoError(t, err)
+}
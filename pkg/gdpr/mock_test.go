@@ -0,0 +1,4 @@
+package gdpr
+
+// https://github.com/traefik/mocktail
+// mocktail:GDPR
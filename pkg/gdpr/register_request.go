@@ -0,0 +1,59 @@
+package gdpr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// RegisterRequestRequest is the request to register a GDPR request.
+type RegisterRequestRequest struct {
+	// Action specifies the action of the request - required.
+	Action Action `json:"action"`
+	// UserID specifies the ID of the user whose data is accessed or deleted -
+	// required.
+	UserID string `json:"user_id"`
+
+	// ChannelDeleteOption determines which channels of the user are deleted.
+	// Only applies to delete requests.
+	// Optional. (Default: ChannelDeleteOptionDoNotDelete)
+	ChannelDeleteOption ChannelDeleteOption `json:"channel_delete_option,omitempty"`
+}
+
+func (rrr *RegisterRequestRequest) Validate() error {
+	switch {
+	case rrr.Action != ActionAccess && rrr.Action != ActionDelete:
+		return fmt.Errorf("invalid action %q", rrr.Action)
+	case rrr.UserID == "":
+		return errors.New("user ID is required")
+	case rrr.Action == ActionAccess && rrr.ChannelDeleteOption != "":
+		return errors.New("channel delete option only applies to delete requests")
+	}
+
+	return nil
+}
+
+// RegisterRequestResponse is the response to register a GDPR request.
+type RegisterRequestResponse RequestResource
+
+// RegisterRequest registers a GDPR request to access or delete the data of a
+// user. The request runs asynchronously, use GetRequest or Wait to follow its
+// status.
+// See https://sendbird.com/docs/chat/platform-api/v3/privacy/gdpr/register-a-gdpr-request
+func (g *gdpr) RegisterRequest(ctx context.Context, registerRequestRequest RegisterRequestRequest) (*RegisterRequestResponse, error) {
+	if err := registerRequestRequest.Validate(); err != nil {
+		return nil, fmt.Errorf("failed to validate register request request: %w", err)
+	}
+
+	rrr, err := g.client.Post(ctx, "/privacy/gdpr", registerRequestRequest, &RegisterRequestResponse{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to register GDPR request: %w", err)
+	}
+
+	registerRequestResponse, ok := rrr.(*RegisterRequestResponse)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast body to RegisterRequestResponse: %+v", rrr)
+	}
+
+	return registerRequestResponse, nil
+}
@@ -0,0 +1,85 @@
+package gdpr
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yumi-ia/sendbird-go/pkg/client"
+)
+
+func TestRegisterRequest(t *testing.T) {
+	t.Parallel()
+
+	registerRequestRequest := RegisterRequestRequest{
+		Action:              ActionDelete,
+		UserID:              "user-id",
+		ChannelDeleteOption: ChannelDeleteOptionOneOnOneOnly,
+	}
+
+	registerRequestResponse := &RegisterRequestResponse{
+		RequestID:           "request-id",
+		Action:              ActionDelete,
+		Status:              StatusScheduled,
+		UserID:              "user-id",
+		ChannelDeleteOption: ChannelDeleteOptionOneOnOneOnly,
+	}
+
+	client := client.NewClientMock(t).
+		OnPost("/privacy/gdpr", registerRequestRequest, &RegisterRequestResponse{}).TypedReturns(registerRequestResponse, nil).Once().
+		Parent
+	gdpr := NewGDPR(client)
+
+	rrr, err := gdpr.RegisterRequest(context.Background(), registerRequestRequest)
+	require.NoError(t, err)
+	assert.Equal(t, registerRequestResponse, rrr)
+}
+
+func TestRegisterRequestRequest_Validate(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		request RegisterRequestRequest
+		wantErr bool
+	}{
+		{
+			name:    "access",
+			request: RegisterRequestRequest{Action: ActionAccess, UserID: "user-id"},
+		},
+		{
+			name:    "delete",
+			request: RegisterRequestRequest{Action: ActionDelete, UserID: "user-id", ChannelDeleteOption: ChannelDeleteOptionIncludeAll},
+		},
+		{
+			name:    "invalid action",
+			request: RegisterRequestRequest{Action: "update", UserID: "user-id"},
+			wantErr: true,
+		},
+		{
+			name:    "missing user ID",
+			request: RegisterRequestRequest{Action: ActionAccess},
+			wantErr: true,
+		},
+		{
+			name:    "channel delete option on access",
+			request: RegisterRequestRequest{Action: ActionAccess, UserID: "user-id", ChannelDeleteOption: ChannelDeleteOptionIncludeAll},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tc.request.Validate()
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package gdpr
+
+// Action is the action of a GDPR request.
+type Action string
+
+const (
+	// ActionAccess exports the data of the user to a ZIP file.
+	ActionAccess Action = "access"
+	// ActionDelete deletes the data of the user.
+	ActionDelete Action = "delete"
+)
+
+// ChannelDeleteOption determines which channels of the user are deleted by a
+// delete request.
+type ChannelDeleteOption string
+
+const (
+	// ChannelDeleteOptionDoNotDelete doesn't delete any channel.
+	ChannelDeleteOptionDoNotDelete ChannelDeleteOption = "do_not_delete"
+	// ChannelDeleteOptionOneOnOneOnly deletes the 1-to-1 group channels of the
+	// user.
+	ChannelDeleteOptionOneOnOneOnly ChannelDeleteOption = "1_on_1_only"
+	// ChannelDeleteOptionIncludeAll deletes all the group channels of the user.
+	ChannelDeleteOptionIncludeAll ChannelDeleteOption = "include_all"
+)
+
+// Status is the status of a GDPR request.
+type Status string
+
+const (
+	StatusScheduled  Status = "scheduled"
+	StatusProcessing Status = "processing"
+	StatusDone       Status = "done"
+	StatusNoData     Status = "no_data"
+)
+
+// IsTerminal reports whether the GDPR request is over.
+func (s Status) IsTerminal() bool {
+	return s == StatusDone || s == StatusNoData
+}
+
+// File is the file resulting from an access request.
+type File struct {
+	// URL is the URL of the ZIP file.
+	URL string `json:"url"`
+	// ExpiresAt is the time the URL expires at, in Unix milliseconds.
+	ExpiresAt int64 `json:"expires_at"`
+}
+
+// RequestResource is the resource of a GDPR request.
+type RequestResource struct {
+	RequestID           string              `json:"request_id"`
+	Action              Action              `json:"action"`
+	Status              Status              `json:"status"`
+	UserID              string              `json:"user_id"`
+	ChannelDeleteOption ChannelDeleteOption `json:"channel_delete_option"`
+	Files               *File               `json:"files"`
+	CreatedAt           int64               `json:"created_at"`
+}
@@ -0,0 +1,29 @@
+package gdpr
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/yumi-ia/sendbird-go/pkg/utils/wait"
+)
+
+// Wait polls a GDPR request every interval until it is over, and returns its
+// last state. It returns early with the context error if ctx is done, which
+// makes it easy to bound the wait with the legal deadline. The interval must
+// be positive.
+func Wait(ctx context.Context, g GDPR, requestID string, interval time.Duration) (*GetRequestResponse, error) {
+	grr, err := wait.Poll(ctx, interval, func(ctx context.Context) (*GetRequestResponse, bool, error) {
+		grr, err := g.GetRequest(ctx, requestID)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return grr, grr.Status.IsTerminal(), nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to wait for GDPR request: %w", err)
+	}
+
+	return grr, nil
+}
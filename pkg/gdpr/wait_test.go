@@ -0,0 +1,47 @@
+package gdpr
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWait(t *testing.T) {
+	t.Parallel()
+
+	done := &GetRequestResponse{RequestID: "request-id", Status: StatusNoData}
+
+	gdpr := NewGDPRMock(t).
+		OnGetRequest("request-id").TypedReturns(&GetRequestResponse{RequestID: "request-id", Status: StatusScheduled}, nil).Once().
+		OnGetRequest("request-id").TypedReturns(&GetRequestResponse{RequestID: "request-id", Status: StatusProcessing}, nil).Once().
+		OnGetRequest("request-id").TypedReturns(done, nil).Once().
+		Parent
+
+	grr, err := Wait(context.Background(), gdpr, "request-id", time.Millisecond)
+	require.NoError(t, err)
+	assert.Equal(t, done, grr)
+}
+
+func TestWait_deadline(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	gdpr := NewGDPRMock(t).
+		OnGetRequest("request-id").TypedReturns(&GetRequestResponse{Status: StatusProcessing}, nil).Once().
+		Parent
+
+	_, err := Wait(ctx, gdpr, "request-id", time.Hour)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestWait_invalidInterval(t *testing.T) {
+	t.Parallel()
+
+	_, err := Wait(context.Background(), NewGDPRMock(t), "request-id", 0)
+	require.ErrorContains(t, err, "interval must be positive")
+}
@@ -0,0 +1,34 @@
+package message
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/yumi-ia/sendbird-go/pkg/utils/payload"
+)
+
+// SendTyped sends a message to a channel with data encoded to JSON as the
+// Data of the message, overriding the one of sendMessageRequest.
+// See https://sendbird.com/docs/chat/platform-api/v3/message/messaging-basics/send-a-message
+func SendTyped[T any](ctx context.Context, m Message, channelType ChannelType, channelURL string, sendMessageRequest SendMessageRequest, data T) (*SendMessageResponse, error) {
+	encoded, err := payload.EncodeData(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send message: %w", err)
+	}
+
+	sendMessageRequest.Data = encoded
+
+	return m.SendMessage(ctx, channelType, channelURL, sendMessageRequest)
+}
+
+// DecodeData decodes the JSON Data of a message into a T. It returns an error
+// wrapping payload.ErrEmptyData if the message has no data.
+func DecodeData[T any](messageResource MessageResource) (T, error) {
+	return payload.DecodeData[T](messageResource.Data)
+}
+
+// DecodeUserMetadata decodes the metadata of a user of a message, such as its
+// sender, into a T.
+func DecodeUserMetadata[T any](user User) (T, error) {
+	return payload.DecodeMetadata[T](user.Metadata)
+}
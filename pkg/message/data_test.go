@@ -0,0 +1,63 @@
+package message
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/yumi-ia/sendbird-go/pkg/utils/payload"
+)
+
+type reaction struct {
+	Emoji string `json:"emoji"`
+	Count int    `json:"count"`
+}
+
+func TestSendTyped(t *testing.T) {
+	t.Parallel()
+
+	m := NewMessageMock(t).
+		OnSendMessageRaw(ChannelTypeGroup, "channel_url", mock.Anything).
+		TypedRun(func(_ ChannelType, _ string, smr SendMessageRequest) {
+			assert.Equal(t, "Hello", smr.Message)
+			assert.JSONEq(t, `{"emoji": "smile", "count": 2}`, smr.Data)
+		}).
+		TypedReturns(&SendMessageResponse{MessageID: 42}, nil).Once().
+		Parent
+
+	resp, err := SendTyped(context.Background(), m, ChannelTypeGroup, "channel_url", SendMessageRequest{
+		MessageType: MessageTypeText,
+		UserID:      "user_id",
+		Message:     "Hello",
+		Data:        "overridden",
+	}, reaction{Emoji: "smile", Count: 2})
+	require.NoError(t, err)
+	assert.Equal(t, 42, resp.MessageID)
+
+	_, err = SendTyped(context.Background(), m, ChannelTypeGroup, "channel_url", SendMessageRequest{}, func() {})
+	require.ErrorContains(t, err, "failed to send message: failed to encode data")
+}
+
+func TestDecodeData(t *testing.T) {
+	t.Parallel()
+
+	r, err := DecodeData[reaction](MessageResource{Data: `{"emoji": "smile", "count": 2}`})
+	require.NoError(t, err)
+	assert.Equal(t, reaction{Emoji: "smile", Count: 2}, r)
+
+	_, err = DecodeData[reaction](MessageResource{})
+	require.ErrorIs(t, err, payload.ErrEmptyData)
+
+	_, err = DecodeData[reaction](MessageResource{Data: `{"count": "two"}`})
+	require.ErrorContains(t, err, "failed to decode data into message.reaction")
+}
+
+func TestDecodeUserMetadata(t *testing.T) {
+	t.Parallel()
+
+	metadata, err := DecodeUserMetadata[map[string]string](User{Metadata: map[string]interface{}{"team": "blue"}})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"team": "blue"}, metadata)
+}
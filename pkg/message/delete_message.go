@@ -0,0 +1,19 @@
+package message
+
+import (
+	"context"
+	"fmt"
+)
+
+// DeleteMessage deletes a message from a channel.
+// See https://sendbird.com/docs/chat/platform-api/v3/message/messaging-basics/delete-a-message
+func (m *message) DeleteMessage(ctx context.Context, channelType ChannelType, channelURL string, messageID int) error {
+	path := fmt.Sprintf("/%s/%s/messages/%d", channelType, channelURL, messageID)
+
+	_, err := m.client.Delete(ctx, path, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to delete message: %w", err)
+	}
+
+	return nil
+}
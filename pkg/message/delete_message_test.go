@@ -0,0 +1,21 @@
+package message
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yumi-ia/sendbird-go/pkg/client"
+)
+
+func TestDeleteMessage(t *testing.T) {
+	t.Parallel()
+
+	client := client.NewClientMock(t).
+		OnDelete("/group_channels/channel-url/messages/42", nil, nil).TypedReturns(nil, nil).Once().
+		Parent
+	message := NewMessage(client)
+
+	err := message.DeleteMessage(context.Background(), ChannelTypeGroup, "channel-url", 42)
+	require.NoError(t, err)
+}
@@ -0,0 +1,181 @@
+package message
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+const (
+	// defaultDeleteConcurrency is the default number of messages deleted
+	// concurrently by DeleteMessages.
+	defaultDeleteConcurrency = 5
+	// listMessagesPageSize is the maximum number of messages retrieved per call
+	// to ListMessages.
+	listMessagesPageSize = 200
+)
+
+// DeleteMessagesRequest is the request to delete messages in bulk. Either
+// MessageIDs or a time range must be specified.
+type DeleteMessagesRequest struct {
+	// MessageIDs specifies the IDs of the messages to delete.
+	// Optional.
+	MessageIDs []int
+	// MessageTSFrom specifies the start of the time range of the messages to
+	// delete, in Unix milliseconds, inclusive. Must be specified with
+	// MessageTSTo.
+	// Optional.
+	MessageTSFrom *int64
+	// MessageTSTo specifies the end of the time range of the messages to
+	// delete, in Unix milliseconds, inclusive. Must be specified with
+	// MessageTSFrom.
+	// Optional.
+	MessageTSTo *int64
+	// Concurrency specifies the maximum number of messages deleted at the same
+	// time.
+	// Optional. (Default: 5)
+	Concurrency int
+}
+
+func (dmr *DeleteMessagesRequest) Validate() error {
+	hasRange := dmr.MessageTSFrom != nil || dmr.MessageTSTo != nil
+
+	switch {
+	case len(dmr.MessageIDs) == 0 && !hasRange:
+		return errors.New("message IDs or a time range is required")
+	case len(dmr.MessageIDs) > 0 && hasRange:
+		return errors.New("message IDs and a time range are mutually exclusive")
+	case hasRange && (dmr.MessageTSFrom == nil || dmr.MessageTSTo == nil):
+		return errors.New("message_ts_from and message_ts_to must be specified together")
+	case hasRange && *dmr.MessageTSFrom > *dmr.MessageTSTo:
+		return errors.New("message_ts_from must be before message_ts_to")
+	case dmr.Concurrency < 0:
+		return errors.New("concurrency must be positive")
+	}
+
+	return nil
+}
+
+// DeleteMessages deletes messages of a channel in bulk, either by ID or by
+// time range, with at most Concurrency deletions at the same time. Every
+// message is attempted until ctx is done; the errors of the failed deletions
+// are joined into the returned error.
+// See https://sendbird.com/docs/chat/platform-api/v3/message/messaging-basics/delete-a-message
+func (m *message) DeleteMessages(ctx context.Context, channelType ChannelType, channelURL string, deleteMessagesRequest DeleteMessagesRequest) error {
+	if err := deleteMessagesRequest.Validate(); err != nil {
+		return fmt.Errorf("failed to validate delete messages request: %w", err)
+	}
+
+	messageIDs := deleteMessagesRequest.MessageIDs
+	if len(messageIDs) == 0 {
+		var err error
+
+		messageIDs, err = m.listMessageIDs(ctx, channelType, channelURL, *deleteMessagesRequest.MessageTSFrom, *deleteMessagesRequest.MessageTSTo)
+		if err != nil {
+			return err
+		}
+	}
+
+	concurrency := deleteMessagesRequest.Concurrency
+	if concurrency == 0 {
+		concurrency = defaultDeleteConcurrency
+	}
+
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs []error
+	)
+
+	sem := make(chan struct{}, concurrency)
+
+	for i, messageID := range messageIDs {
+		if err := acquire(ctx, sem); err != nil {
+			mu.Lock()
+			errs = append(errs, fmt.Errorf("%d messages not deleted: %w", len(messageIDs)-i, err))
+			mu.Unlock()
+
+			break
+		}
+
+		wg.Add(1)
+
+		go func(messageID int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			if err := m.DeleteMessage(ctx, channelType, channelURL, messageID); err != nil {
+				mu.Lock()
+				errs = append(errs, fmt.Errorf("message %d: %w", messageID, err))
+				mu.Unlock()
+			}
+		}(messageID)
+	}
+
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
+
+// acquire acquires a slot of sem, unless ctx is done first.
+func acquire(ctx context.Context, sem chan struct{}) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case sem <- struct{}{}:
+		return nil
+	}
+}
+
+// listMessageIDs lists the IDs of the messages of a channel sent between from
+// and to, both inclusive. The first page starts at from; the next ones start
+// after the last message listed, by ID, so that no message is skipped when
+// more than a page of messages share the same timestamp.
+func (m *message) listMessageIDs(ctx context.Context, channelType ChannelType, channelURL string, from, to int64) ([]int, error) {
+	prevLimit, nextLimit := 0, listMessagesPageSize
+	include := true
+
+	listMessagesRequest := ListMessagesRequest{
+		MessageTS: from,
+		PrevLimit: &prevLimit,
+		NextLimit: &nextLimit,
+		Include:   &include,
+	}
+
+	var messageIDs []int
+
+	for {
+		lmr, err := m.ListMessages(ctx, channelType, channelURL, listMessagesRequest)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list messages to delete: %w", err)
+		}
+
+		for _, mr := range lmr.Messages {
+			if mr.CreatedAt > to {
+				return messageIDs, nil
+			}
+
+			messageIDs = append(messageIDs, mr.MessageID)
+		}
+
+		// A short page is the last one.
+		if len(lmr.Messages) < listMessagesPageSize {
+			return messageIDs, nil
+		}
+
+		exclude := false
+		listMessagesRequest = ListMessagesRequest{
+			MessageID: lmr.Messages[len(lmr.Messages)-1].MessageID,
+			PrevLimit: &prevLimit,
+			NextLimit: &nextLimit,
+			Include:   &exclude,
+		}
+	}
+}
@@ -0,0 +1,200 @@
+package message
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/yumi-ia/sendbird-go/pkg/client"
+)
+
+func deletePath(messageID int) string {
+	return fmt.Sprintf("/group_channels/channel-url/messages/%d", messageID)
+}
+
+func TestDeleteMessages(t *testing.T) {
+	t.Parallel()
+
+	client := client.NewClientMock(t).
+		OnDelete(deletePath(1), nil, nil).TypedReturns(nil, nil).Once().
+		OnDelete(deletePath(2), nil, nil).TypedReturns(nil, nil).Once().
+		OnDelete(deletePath(3), nil, nil).TypedReturns(nil, nil).Once().
+		Parent
+	message := NewMessage(client)
+
+	err := message.DeleteMessages(context.Background(), ChannelTypeGroup, "channel-url", DeleteMessagesRequest{
+		MessageIDs:  []int{1, 2, 3},
+		Concurrency: 2,
+	})
+	require.NoError(t, err)
+}
+
+func TestDeleteMessages_boundedConcurrency(t *testing.T) {
+	t.Parallel()
+
+	var running, maxRunning atomic.Int32
+
+	client := client.NewClientMock(t).
+		OnDeleteRaw(mock.Anything, nil, nil).
+		TypedRun(func(string, any, any) {
+			n := running.Add(1)
+			for {
+				m := maxRunning.Load()
+				if n <= m || maxRunning.CompareAndSwap(m, n) {
+					break
+				}
+			}
+			time.Sleep(time.Millisecond)
+			running.Add(-1)
+		}).
+		TypedReturns(nil, nil).Times(10).
+		Parent
+	message := NewMessage(client)
+
+	err := message.DeleteMessages(context.Background(), ChannelTypeGroup, "channel-url", DeleteMessagesRequest{
+		MessageIDs:  []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+		Concurrency: 3,
+	})
+	require.NoError(t, err)
+	assert.LessOrEqual(t, maxRunning.Load(), int32(3))
+}
+
+func TestDeleteMessages_aggregatedErrors(t *testing.T) {
+	t.Parallel()
+
+	errNotFound := errors.New("not found")
+	errForbidden := errors.New("forbidden")
+
+	client := client.NewClientMock(t).
+		OnDelete(deletePath(1), nil, nil).TypedReturns(nil, errNotFound).Once().
+		OnDelete(deletePath(2), nil, nil).TypedReturns(nil, nil).Once().
+		OnDelete(deletePath(3), nil, nil).TypedReturns(nil, errForbidden).Once().
+		Parent
+	message := NewMessage(client)
+
+	err := message.DeleteMessages(context.Background(), ChannelTypeGroup, "channel-url", DeleteMessagesRequest{
+		MessageIDs: []int{1, 2, 3},
+	})
+	require.ErrorIs(t, err, errNotFound)
+	require.ErrorIs(t, err, errForbidden)
+	assert.Contains(t, err.Error(), "message 1")
+	assert.Contains(t, err.Error(), "message 3")
+}
+
+func TestDeleteMessages_canceled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	message := NewMessage(client.NewClientMock(t))
+
+	err := message.DeleteMessages(ctx, ChannelTypeGroup, "channel-url", DeleteMessagesRequest{
+		MessageIDs: []int{1, 2, 3},
+	})
+	require.ErrorIs(t, err, context.Canceled)
+	assert.Contains(t, err.Error(), "3 messages not deleted")
+}
+
+func TestDeleteMessages_timeRange(t *testing.T) {
+	t.Parallel()
+
+	// A full page of messages sharing the same timestamp, followed by more
+	// messages at that timestamp.
+	firstPage := make([]MessageResource, 0, listMessagesPageSize)
+	for i := range listMessagesPageSize {
+		firstPage = append(firstPage, MessageResource{MessageID: i + 1, CreatedAt: 100})
+	}
+
+	secondPage := []MessageResource{
+		{MessageID: 1000, CreatedAt: 100},
+		{MessageID: 1001, CreatedAt: 101},
+		{MessageID: 1002, CreatedAt: 10000},
+	}
+
+	firstPath, err := listMessagesPath(ChannelTypeGroup, "channel-url", ListMessagesRequest{MessageTS: 100, PrevLimit: ptr(0), NextLimit: ptr(listMessagesPageSize), Include: ptr(true)})
+	require.NoError(t, err)
+
+	secondPath, err := listMessagesPath(ChannelTypeGroup, "channel-url", ListMessagesRequest{MessageID: listMessagesPageSize, PrevLimit: ptr(0), NextLimit: ptr(listMessagesPageSize), Include: ptr(false)})
+	require.NoError(t, err)
+
+	var deleted atomic.Int32
+
+	client := client.NewClientMock(t).
+		OnGet(firstPath, nil, &ListMessagesResponse{}).TypedReturns(&ListMessagesResponse{Messages: firstPage}, nil).Once().
+		OnGet(secondPath, nil, &ListMessagesResponse{}).TypedReturns(&ListMessagesResponse{Messages: secondPage}, nil).Once().
+		OnDeleteRaw(mock.Anything, nil, nil).TypedRun(func(string, any, any) { deleted.Add(1) }).TypedReturns(nil, nil).
+		Parent
+	message := NewMessage(client)
+
+	err = message.DeleteMessages(context.Background(), ChannelTypeGroup, "channel-url", DeleteMessagesRequest{
+		MessageTSFrom: ptr(int64(100)),
+		MessageTSTo:   ptr(int64(5000)),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int32(listMessagesPageSize+2), deleted.Load())
+}
+
+func TestDeleteMessagesRequest_Validate(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		request DeleteMessagesRequest
+		wantErr bool
+	}{
+		{
+			name:    "message IDs",
+			request: DeleteMessagesRequest{MessageIDs: []int{1}},
+		},
+		{
+			name:    "time range",
+			request: DeleteMessagesRequest{MessageTSFrom: ptr(int64(1)), MessageTSTo: ptr(int64(2))},
+		},
+		{
+			name:    "nothing to delete",
+			request: DeleteMessagesRequest{},
+			wantErr: true,
+		},
+		{
+			name:    "both message IDs and time range",
+			request: DeleteMessagesRequest{MessageIDs: []int{1}, MessageTSFrom: ptr(int64(1)), MessageTSTo: ptr(int64(2))},
+			wantErr: true,
+		},
+		{
+			name:    "half-open time range",
+			request: DeleteMessagesRequest{MessageTSFrom: ptr(int64(1))},
+			wantErr: true,
+		},
+		{
+			name:    "inverted time range",
+			request: DeleteMessagesRequest{MessageTSFrom: ptr(int64(2)), MessageTSTo: ptr(int64(1))},
+			wantErr: true,
+		},
+		{
+			name:    "negative concurrency",
+			request: DeleteMessagesRequest{MessageIDs: []int{1}, Concurrency: -1},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tc.request.Validate()
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
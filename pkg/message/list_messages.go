@@ -2,10 +2,12 @@ package message
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
 
+	"github.com/yumi-ia/sendbird-go/pkg/client"
 	strconvSlice "github.com/yumi-ia/sendbird-go/pkg/utils/strconv"
 )
 
@@ -211,19 +213,12 @@ func listMessagesRequestToMap(lmr ListMessagesRequest) map[string]string {
 // ListMessages retrieves a list of messages in a channel.
 // See https://sendbird.com/docs/chat/platform-api/v3/message/messaging-basics/list-messages
 func (m *message) ListMessages(ctx context.Context, channelType ChannelType, channelURL string, listMessagesRequest ListMessagesRequest) (*ListMessagesResponse, error) {
-	u, err := url.Parse(fmt.Sprintf("/%s/%s/messages", channelType, channelURL))
+	path, err := listMessagesPath(channelType, channelURL, listMessagesRequest)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse URL: %w", err)
+		return nil, err
 	}
 
-	query := u.Query()
-	for k, v := range listMessagesRequestToMap(listMessagesRequest) {
-		query.Set(k, v)
-	}
-
-	u.RawQuery = query.Encode()
-
-	lmr, err := m.client.Get(ctx, u.String(), nil, &ListMessagesResponse{})
+	lmr, err := m.client.Get(ctx, path, nil, &ListMessagesResponse{})
 	if err != nil {
 		return nil, fmt.Errorf("failed to send message: %w", err)
 	}
@@ -235,3 +230,46 @@ func (m *message) ListMessages(ctx context.Context, channelType ChannelType, cha
 
 	return listMessagesResponse, nil
 }
+
+// messagesStream decodes the messages of a list response one by one.
+type messagesStream struct {
+	fn func(MessageResource) error
+}
+
+func (ms *messagesStream) DecodeStream(dec *json.Decoder) error {
+	return client.StreamArray(dec, "messages", ms.fn, nil)
+}
+
+// ListMessagesStream retrieves a list of messages in a channel like
+// ListMessages, but calls fn with each message as soon as it is decoded from
+// the response instead of holding the whole page in memory. Returning
+// client.ErrStopStream from fn stops the listing without error.
+// See https://sendbird.com/docs/chat/platform-api/v3/message/messaging-basics/list-messages
+func (m *message) ListMessagesStream(ctx context.Context, channelType ChannelType, channelURL string, listMessagesRequest ListMessagesRequest, fn func(MessageResource) error) error {
+	path, err := listMessagesPath(channelType, channelURL, listMessagesRequest)
+	if err != nil {
+		return err
+	}
+
+	if _, err := m.client.Get(ctx, path, nil, &messagesStream{fn: fn}); err != nil {
+		return fmt.Errorf("failed to list messages: %w", err)
+	}
+
+	return nil
+}
+
+func listMessagesPath(channelType ChannelType, channelURL string, listMessagesRequest ListMessagesRequest) (string, error) {
+	u, err := url.Parse(fmt.Sprintf("/%s/%s/messages", channelType, channelURL))
+	if err != nil {
+		return "", fmt.Errorf("failed to parse URL: %w", err)
+	}
+
+	query := u.Query()
+	for k, v := range listMessagesRequestToMap(listMessagesRequest) {
+		query.Set(k, v)
+	}
+
+	u.RawQuery = query.Encode()
+
+	return u.String(), nil
+}
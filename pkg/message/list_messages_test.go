@@ -2,9 +2,12 @@ package message
 
 import (
 	"context"
+	"encoding/json"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/yumi-ia/sendbird-go/pkg/client"
 )
@@ -72,3 +75,34 @@ func TestListMessages(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, listMessagesResponse, cur)
 }
+
+func TestListMessagesStream(t *testing.T) {
+	t.Parallel()
+
+	url := "/group_channels/url/messages?message_id=0&message_ts=42&next_limit=200"
+	body := `{"messages":[{"message_id":1},{"message_id":2},{"message_id":3}]}`
+
+	client := client.NewClientMock(t).
+		OnGetRaw(url, nil, mock.Anything).
+		ReturnsFn(func(_ string, _, resp any) (any, error) {
+			sd, ok := resp.(client.StreamDecoder)
+			require.True(t, ok)
+
+			return resp, sd.DecodeStream(json.NewDecoder(strings.NewReader(body)))
+		}).Once().
+		Parent
+	message := NewMessage(client)
+
+	var ids []int
+
+	err := message.ListMessagesStream(context.Background(), ChannelTypeGroup, "url", ListMessagesRequest{
+		MessageTS: 42,
+		NextLimit: ptr(200),
+	}, func(mr MessageResource) error {
+		ids = append(ids, mr.MessageID)
+
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, ids)
+}
@@ -17,10 +17,30 @@ type Message interface {
 	// ListMessages retrieves a list of messages in a channel.
 	// See https://sendbird.com/docs/chat/platform-api/v3/message/messaging-basics/list-messages
 	ListMessages(ctx context.Context, channelType ChannelType, channelURL string, listMessagesRequest ListMessagesRequest) (*ListMessagesResponse, error)
+	// ListMessagesStream retrieves a list of messages in a channel, calling fn
+	// with each message as soon as it is decoded from the response.
+	// See https://sendbird.com/docs/chat/platform-api/v3/message/messaging-basics/list-messages
+	ListMessagesStream(ctx context.Context, channelType ChannelType, channelURL string, listMessagesRequest ListMessagesRequest, fn func(MessageResource) error) error
 
 	// MigrateMessages migrates messages to a channel.
 	// See https://sendbird.com/docs/chat/platform-api/v3/message/migration/migrate-messages
 	MigrateMessages(ctx context.Context, channelURL string, migrateMessagesRequest MigrateMessagesRequest) error
+
+	// SearchMessages searches messages across the channels of the application.
+	// See https://sendbird.com/docs/chat/platform-api/v3/message/search/search-messages
+	SearchMessages(ctx context.Context, searchMessagesRequest SearchMessagesRequest) (*SearchMessagesResponse, error)
+
+	// TranslateMessage translates an existing message into specific languages.
+	// See https://sendbird.com/docs/chat/platform-api/v3/message/translation/translate-a-message
+	TranslateMessage(ctx context.Context, channelType ChannelType, channelURL string, messageID int, targetLanguages []string) (*TranslateMessageResponse, error)
+
+	// DeleteMessage deletes a message from a channel.
+	// See https://sendbird.com/docs/chat/platform-api/v3/message/messaging-basics/delete-a-message
+	DeleteMessage(ctx context.Context, channelType ChannelType, channelURL string, messageID int) error
+	// DeleteMessages deletes messages of a channel in bulk, either by ID or by
+	// time range, with a bounded number of concurrent deletions.
+	// See https://sendbird.com/docs/chat/platform-api/v3/message/messaging-basics/delete-a-message
+	DeleteMessages(ctx context.Context, channelType ChannelType, channelURL string, deleteMessagesRequest DeleteMessagesRequest) error
 }
 
 type message struct {
@@ -6,16 +6,43 @@ import (
 	"fmt"
 )
 
-type TextMessage struct {
-	// UserID specifies the user ID of the sender - required.
-	UserID string `json:"user_id"`
+// MigrationMessage is a message to migrate. Its fields depend on its type:
+// text messages have a sender and a content, file messages have a sender and
+// a file, and admin messages have a content but no sender.
+type MigrationMessage struct {
+	// UserID specifies the user ID of the sender - required for text and file
+	// messages.
+	UserID string `json:"user_id,omitempty"`
 
 	// MessageType specifies the type of the message - required.
 	MessageType MessageType `json:"message_type"`
 
-	// Message specifies the content of the message - required.
+	// Message specifies the content of the message - required for text and
+	// admin messages.
 	Message string `json:"message,omitempty"`
 
+	// URL specifies the URL of the file hosted on the server of your own or
+	// other third-party companies - required for file messages.
+	URL string `json:"url,omitempty"`
+
+	// FileName specifies the name of the file - optional.
+	FileName string `json:"file_name,omitempty"`
+
+	// FileSize specifies the size of the file in bytes - optional.
+	FileSize int `json:"file_size,omitempty"`
+
+	// FileType specifies the media type of the file, such as image/png -
+	// optional.
+	FileType string `json:"file_type,omitempty"`
+
+	// Thumbnails specifies an array of URLs of thumbnail images of the file -
+	// optional.
+	Thumbnails []string `json:"thumbnails,omitempty"`
+
+	// RequireAuth determines whether to require an authentication key to
+	// access the file - optional.
+	RequireAuth bool `json:"require_auth,omitempty"`
+
 	// Timestamp specifies the time when the message was sent in Unix milliseconds format - required.
 	Timestamp int64 `json:"timestamp,omitempty"`
 
@@ -40,10 +67,63 @@ type TextMessage struct {
 	DedupID string `json:"dedup_id,omitempty"`
 }
 
+// TextMessage is the former name of MigrationMessage.
+//
+// Deprecated: use MigrationMessage, which also supports file and admin
+// messages.
+type TextMessage = MigrationMessage
+
+func (mm *MigrationMessage) Validate() error {
+	if mm.MessageType == "" {
+		return errors.New("message_type cannot be empty")
+	}
+
+	if mm.Timestamp == 0 {
+		return errors.New("timestamp cannot be empty")
+	}
+
+	switch mm.MessageType {
+	case MessageTypeText:
+		if mm.UserID == "" {
+			return errors.New("user_id cannot be empty")
+		}
+
+		if mm.Message == "" {
+			return errors.New("message cannot be empty")
+		}
+	case MessageTypeFile:
+		if mm.UserID == "" {
+			return errors.New("user_id cannot be empty")
+		}
+
+		if mm.URL == "" {
+			return errors.New("url cannot be empty for file message")
+		}
+
+		if mm.FileSize < 0 {
+			return errors.New("file_size cannot be negative")
+		}
+
+		for _, thumbnail := range mm.Thumbnails {
+			if thumbnail == "" {
+				return errors.New("thumbnail URL cannot be empty")
+			}
+		}
+	case MessageTypeAdminMessage:
+		if mm.Message == "" {
+			return errors.New("message cannot be empty")
+		}
+	default:
+		return fmt.Errorf("unsupported message_type %q", mm.MessageType)
+	}
+
+	return nil
+}
+
 // MigrateMessagesRequest is the request to migrate messages to a channel.
 type MigrateMessagesRequest struct {
 	// Messages specifies an array of messages to migrate - required.
-	Messages []TextMessage `json:"messages"`
+	Messages []MigrationMessage `json:"messages"`
 
 	// UpdateReadTS determines whether to update the read receipt time for all channel members when message.timestamp
 	// of the latest migrated message is prior to their read receipt time
@@ -55,21 +135,9 @@ func (smr *MigrateMessagesRequest) Validate() error {
 		return errors.New("messages cannot be empty")
 	}
 
-	for _, message := range smr.Messages {
-		if message.UserID == "" {
-			return errors.New("user_id cannot be empty")
-		}
-
-		if message.MessageType == "" {
-			return errors.New("message_type cannot be empty")
-		}
-
-		if message.Message == "" {
-			return errors.New("message cannot be empty")
-		}
-
-		if message.Timestamp == 0 {
-			return errors.New("timestamp cannot be empty")
+	for i, message := range smr.Messages {
+		if err := message.Validate(); err != nil {
+			return fmt.Errorf("invalid message %d: %w", i, err)
 		}
 	}
 
@@ -75,6 +75,105 @@ func TestValidateMMR(t *testing.T) {
 			},
 			assertErr: assert.Error,
 		},
+		{
+			name: "invalid request - unsupported message_type",
+			request: MigrateMessagesRequest{
+				Messages: []MigrationMessage{
+					{
+						UserID:      "42",
+						MessageType: "POLL",
+						Message:     "Hello, World!",
+						Timestamp:   1609459200000,
+					},
+				},
+			},
+			assertErr: assert.Error,
+		},
+		{
+			name: "valid request - file message",
+			request: MigrateMessagesRequest{
+				Messages: []MigrationMessage{
+					{
+						UserID:      "42",
+						MessageType: MessageTypeFile,
+						URL:         "https://example.com/cat.png",
+						FileName:    "cat.png",
+						FileSize:    1024,
+						FileType:    "image/png",
+						Thumbnails:  []string{"https://example.com/cat_small.png"},
+						Timestamp:   1609459200000,
+					},
+				},
+			},
+			assertErr: assert.NoError,
+		},
+		{
+			name: "invalid request - file message missing url",
+			request: MigrateMessagesRequest{
+				Messages: []MigrationMessage{
+					{
+						UserID:      "42",
+						MessageType: MessageTypeFile,
+						FileName:    "cat.png",
+						Timestamp:   1609459200000,
+					},
+				},
+			},
+			assertErr: assert.Error,
+		},
+		{
+			name: "invalid request - file message missing user_id",
+			request: MigrateMessagesRequest{
+				Messages: []MigrationMessage{
+					{
+						MessageType: MessageTypeFile,
+						URL:         "https://example.com/cat.png",
+						Timestamp:   1609459200000,
+					},
+				},
+			},
+			assertErr: assert.Error,
+		},
+		{
+			name: "invalid request - file message empty thumbnail",
+			request: MigrateMessagesRequest{
+				Messages: []MigrationMessage{
+					{
+						UserID:      "42",
+						MessageType: MessageTypeFile,
+						URL:         "https://example.com/cat.png",
+						Thumbnails:  []string{""},
+						Timestamp:   1609459200000,
+					},
+				},
+			},
+			assertErr: assert.Error,
+		},
+		{
+			name: "valid request - admin message without user_id",
+			request: MigrateMessagesRequest{
+				Messages: []MigrationMessage{
+					{
+						MessageType: MessageTypeAdminMessage,
+						Message:     "Welcome!",
+						Timestamp:   1609459200000,
+					},
+				},
+			},
+			assertErr: assert.NoError,
+		},
+		{
+			name: "invalid request - admin message missing message",
+			request: MigrateMessagesRequest{
+				Messages: []MigrationMessage{
+					{
+						MessageType: MessageTypeAdminMessage,
+						Timestamp:   1609459200000,
+					},
+				},
+			},
+			assertErr: assert.Error,
+		},
 	}
 
 	for _, test := range tests {
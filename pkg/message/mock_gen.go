@@ -25,6 +25,304 @@ func NewMessageMock(tb testing.TB) *messageMock {
 	return m
 }
 
+func (_m *messageMock) DeleteMessage(_ context.Context, channelType ChannelType, channelURL string, messageID int) error {
+	_ret := _m.Called(channelType, channelURL, messageID)
+
+	if _rf, ok := _ret.Get(0).(func(ChannelType, string, int) error); ok {
+		return _rf(channelType, channelURL, messageID)
+	}
+
+	_ra0 := _ret.Error(0)
+
+	return _ra0
+}
+
+func (_m *messageMock) OnDeleteMessage(channelType ChannelType, channelURL string, messageID int) *messageDeleteMessageCall {
+	return &messageDeleteMessageCall{Call: _m.Mock.On("DeleteMessage", channelType, channelURL, messageID), Parent: _m}
+}
+
+func (_m *messageMock) OnDeleteMessageRaw(channelType interface{}, channelURL interface{}, messageID interface{}) *messageDeleteMessageCall {
+	return &messageDeleteMessageCall{Call: _m.Mock.On("DeleteMessage", channelType, channelURL, messageID), Parent: _m}
+}
+
+type messageDeleteMessageCall struct {
+	*mock.Call
+	Parent *messageMock
+}
+
+func (_c *messageDeleteMessageCall) Panic(msg string) *messageDeleteMessageCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *messageDeleteMessageCall) Once() *messageDeleteMessageCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *messageDeleteMessageCall) Twice() *messageDeleteMessageCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *messageDeleteMessageCall) Times(i int) *messageDeleteMessageCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *messageDeleteMessageCall) WaitUntil(w <-chan time.Time) *messageDeleteMessageCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *messageDeleteMessageCall) After(d time.Duration) *messageDeleteMessageCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *messageDeleteMessageCall) Run(fn func(args mock.Arguments)) *messageDeleteMessageCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *messageDeleteMessageCall) Maybe() *messageDeleteMessageCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *messageDeleteMessageCall) TypedReturns(a error) *messageDeleteMessageCall {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *messageDeleteMessageCall) ReturnsFn(fn func(ChannelType, string, int) error) *messageDeleteMessageCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *messageDeleteMessageCall) TypedRun(fn func(ChannelType, string, int)) *messageDeleteMessageCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_channelType, _ := args.Get(0).(ChannelType)
+		_channelURL := args.String(1)
+		_messageID := args.Int(2)
+		fn(_channelType, _channelURL, _messageID)
+	})
+	return _c
+}
+
+func (_c *messageDeleteMessageCall) OnDeleteMessage(channelType ChannelType, channelURL string, messageID int) *messageDeleteMessageCall {
+	return _c.Parent.OnDeleteMessage(channelType, channelURL, messageID)
+}
+
+func (_c *messageDeleteMessageCall) OnDeleteMessages(channelType ChannelType, channelURL string, deleteMessagesRequest DeleteMessagesRequest) *messageDeleteMessagesCall {
+	return _c.Parent.OnDeleteMessages(channelType, channelURL, deleteMessagesRequest)
+}
+
+func (_c *messageDeleteMessageCall) OnListMessages(channelType ChannelType, channelURL string, listMessagesRequest ListMessagesRequest) *messageListMessagesCall {
+	return _c.Parent.OnListMessages(channelType, channelURL, listMessagesRequest)
+}
+
+func (_c *messageDeleteMessageCall) OnListMessagesStream(channelType ChannelType, channelURL string, listMessagesRequest ListMessagesRequest, fn func(MessageResource) error) *messageListMessagesStreamCall {
+	return _c.Parent.OnListMessagesStream(channelType, channelURL, listMessagesRequest, fn)
+}
+
+func (_c *messageDeleteMessageCall) OnMigrateMessages(channelURL string, migrateMessagesRequest MigrateMessagesRequest) *messageMigrateMessagesCall {
+	return _c.Parent.OnMigrateMessages(channelURL, migrateMessagesRequest)
+}
+
+func (_c *messageDeleteMessageCall) OnSearchMessages(searchMessagesRequest SearchMessagesRequest) *messageSearchMessagesCall {
+	return _c.Parent.OnSearchMessages(searchMessagesRequest)
+}
+
+func (_c *messageDeleteMessageCall) OnSendMessage(channelType ChannelType, channelURL string, sendMessageRequest SendMessageRequest) *messageSendMessageCall {
+	return _c.Parent.OnSendMessage(channelType, channelURL, sendMessageRequest)
+}
+
+func (_c *messageDeleteMessageCall) OnTranslateMessage(channelType ChannelType, channelURL string, messageID int, targetLanguages []string) *messageTranslateMessageCall {
+	return _c.Parent.OnTranslateMessage(channelType, channelURL, messageID, targetLanguages)
+}
+
+func (_c *messageDeleteMessageCall) OnDeleteMessageRaw(channelType interface{}, channelURL interface{}, messageID interface{}) *messageDeleteMessageCall {
+	return _c.Parent.OnDeleteMessageRaw(channelType, channelURL, messageID)
+}
+
+func (_c *messageDeleteMessageCall) OnDeleteMessagesRaw(channelType interface{}, channelURL interface{}, deleteMessagesRequest interface{}) *messageDeleteMessagesCall {
+	return _c.Parent.OnDeleteMessagesRaw(channelType, channelURL, deleteMessagesRequest)
+}
+
+func (_c *messageDeleteMessageCall) OnListMessagesRaw(channelType interface{}, channelURL interface{}, listMessagesRequest interface{}) *messageListMessagesCall {
+	return _c.Parent.OnListMessagesRaw(channelType, channelURL, listMessagesRequest)
+}
+
+func (_c *messageDeleteMessageCall) OnListMessagesStreamRaw(channelType interface{}, channelURL interface{}, listMessagesRequest interface{}, fn interface{}) *messageListMessagesStreamCall {
+	return _c.Parent.OnListMessagesStreamRaw(channelType, channelURL, listMessagesRequest, fn)
+}
+
+func (_c *messageDeleteMessageCall) OnMigrateMessagesRaw(channelURL interface{}, migrateMessagesRequest interface{}) *messageMigrateMessagesCall {
+	return _c.Parent.OnMigrateMessagesRaw(channelURL, migrateMessagesRequest)
+}
+
+func (_c *messageDeleteMessageCall) OnSearchMessagesRaw(searchMessagesRequest interface{}) *messageSearchMessagesCall {
+	return _c.Parent.OnSearchMessagesRaw(searchMessagesRequest)
+}
+
+func (_c *messageDeleteMessageCall) OnSendMessageRaw(channelType interface{}, channelURL interface{}, sendMessageRequest interface{}) *messageSendMessageCall {
+	return _c.Parent.OnSendMessageRaw(channelType, channelURL, sendMessageRequest)
+}
+
+func (_c *messageDeleteMessageCall) OnTranslateMessageRaw(channelType interface{}, channelURL interface{}, messageID interface{}, targetLanguages interface{}) *messageTranslateMessageCall {
+	return _c.Parent.OnTranslateMessageRaw(channelType, channelURL, messageID, targetLanguages)
+}
+
+func (_m *messageMock) DeleteMessages(_ context.Context, channelType ChannelType, channelURL string, deleteMessagesRequest DeleteMessagesRequest) error {
+	_ret := _m.Called(channelType, channelURL, deleteMessagesRequest)
+
+	if _rf, ok := _ret.Get(0).(func(ChannelType, string, DeleteMessagesRequest) error); ok {
+		return _rf(channelType, channelURL, deleteMessagesRequest)
+	}
+
+	_ra0 := _ret.Error(0)
+
+	return _ra0
+}
+
+func (_m *messageMock) OnDeleteMessages(channelType ChannelType, channelURL string, deleteMessagesRequest DeleteMessagesRequest) *messageDeleteMessagesCall {
+	return &messageDeleteMessagesCall{Call: _m.Mock.On("DeleteMessages", channelType, channelURL, deleteMessagesRequest), Parent: _m}
+}
+
+func (_m *messageMock) OnDeleteMessagesRaw(channelType interface{}, channelURL interface{}, deleteMessagesRequest interface{}) *messageDeleteMessagesCall {
+	return &messageDeleteMessagesCall{Call: _m.Mock.On("DeleteMessages", channelType, channelURL, deleteMessagesRequest), Parent: _m}
+}
+
+type messageDeleteMessagesCall struct {
+	*mock.Call
+	Parent *messageMock
+}
+
+func (_c *messageDeleteMessagesCall) Panic(msg string) *messageDeleteMessagesCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *messageDeleteMessagesCall) Once() *messageDeleteMessagesCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *messageDeleteMessagesCall) Twice() *messageDeleteMessagesCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *messageDeleteMessagesCall) Times(i int) *messageDeleteMessagesCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *messageDeleteMessagesCall) WaitUntil(w <-chan time.Time) *messageDeleteMessagesCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *messageDeleteMessagesCall) After(d time.Duration) *messageDeleteMessagesCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *messageDeleteMessagesCall) Run(fn func(args mock.Arguments)) *messageDeleteMessagesCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *messageDeleteMessagesCall) Maybe() *messageDeleteMessagesCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *messageDeleteMessagesCall) TypedReturns(a error) *messageDeleteMessagesCall {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *messageDeleteMessagesCall) ReturnsFn(fn func(ChannelType, string, DeleteMessagesRequest) error) *messageDeleteMessagesCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *messageDeleteMessagesCall) TypedRun(fn func(ChannelType, string, DeleteMessagesRequest)) *messageDeleteMessagesCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_channelType, _ := args.Get(0).(ChannelType)
+		_channelURL := args.String(1)
+		_deleteMessagesRequest, _ := args.Get(2).(DeleteMessagesRequest)
+		fn(_channelType, _channelURL, _deleteMessagesRequest)
+	})
+	return _c
+}
+
+func (_c *messageDeleteMessagesCall) OnDeleteMessage(channelType ChannelType, channelURL string, messageID int) *messageDeleteMessageCall {
+	return _c.Parent.OnDeleteMessage(channelType, channelURL, messageID)
+}
+
+func (_c *messageDeleteMessagesCall) OnDeleteMessages(channelType ChannelType, channelURL string, deleteMessagesRequest DeleteMessagesRequest) *messageDeleteMessagesCall {
+	return _c.Parent.OnDeleteMessages(channelType, channelURL, deleteMessagesRequest)
+}
+
+func (_c *messageDeleteMessagesCall) OnListMessages(channelType ChannelType, channelURL string, listMessagesRequest ListMessagesRequest) *messageListMessagesCall {
+	return _c.Parent.OnListMessages(channelType, channelURL, listMessagesRequest)
+}
+
+func (_c *messageDeleteMessagesCall) OnListMessagesStream(channelType ChannelType, channelURL string, listMessagesRequest ListMessagesRequest, fn func(MessageResource) error) *messageListMessagesStreamCall {
+	return _c.Parent.OnListMessagesStream(channelType, channelURL, listMessagesRequest, fn)
+}
+
+func (_c *messageDeleteMessagesCall) OnMigrateMessages(channelURL string, migrateMessagesRequest MigrateMessagesRequest) *messageMigrateMessagesCall {
+	return _c.Parent.OnMigrateMessages(channelURL, migrateMessagesRequest)
+}
+
+func (_c *messageDeleteMessagesCall) OnSearchMessages(searchMessagesRequest SearchMessagesRequest) *messageSearchMessagesCall {
+	return _c.Parent.OnSearchMessages(searchMessagesRequest)
+}
+
+func (_c *messageDeleteMessagesCall) OnSendMessage(channelType ChannelType, channelURL string, sendMessageRequest SendMessageRequest) *messageSendMessageCall {
+	return _c.Parent.OnSendMessage(channelType, channelURL, sendMessageRequest)
+}
+
+func (_c *messageDeleteMessagesCall) OnTranslateMessage(channelType ChannelType, channelURL string, messageID int, targetLanguages []string) *messageTranslateMessageCall {
+	return _c.Parent.OnTranslateMessage(channelType, channelURL, messageID, targetLanguages)
+}
+
+func (_c *messageDeleteMessagesCall) OnDeleteMessageRaw(channelType interface{}, channelURL interface{}, messageID interface{}) *messageDeleteMessageCall {
+	return _c.Parent.OnDeleteMessageRaw(channelType, channelURL, messageID)
+}
+
+func (_c *messageDeleteMessagesCall) OnDeleteMessagesRaw(channelType interface{}, channelURL interface{}, deleteMessagesRequest interface{}) *messageDeleteMessagesCall {
+	return _c.Parent.OnDeleteMessagesRaw(channelType, channelURL, deleteMessagesRequest)
+}
+
+func (_c *messageDeleteMessagesCall) OnListMessagesRaw(channelType interface{}, channelURL interface{}, listMessagesRequest interface{}) *messageListMessagesCall {
+	return _c.Parent.OnListMessagesRaw(channelType, channelURL, listMessagesRequest)
+}
+
+func (_c *messageDeleteMessagesCall) OnListMessagesStreamRaw(channelType interface{}, channelURL interface{}, listMessagesRequest interface{}, fn interface{}) *messageListMessagesStreamCall {
+	return _c.Parent.OnListMessagesStreamRaw(channelType, channelURL, listMessagesRequest, fn)
+}
+
+func (_c *messageDeleteMessagesCall) OnMigrateMessagesRaw(channelURL interface{}, migrateMessagesRequest interface{}) *messageMigrateMessagesCall {
+	return _c.Parent.OnMigrateMessagesRaw(channelURL, migrateMessagesRequest)
+}
+
+func (_c *messageDeleteMessagesCall) OnSearchMessagesRaw(searchMessagesRequest interface{}) *messageSearchMessagesCall {
+	return _c.Parent.OnSearchMessagesRaw(searchMessagesRequest)
+}
+
+func (_c *messageDeleteMessagesCall) OnSendMessageRaw(channelType interface{}, channelURL interface{}, sendMessageRequest interface{}) *messageSendMessageCall {
+	return _c.Parent.OnSendMessageRaw(channelType, channelURL, sendMessageRequest)
+}
+
+func (_c *messageDeleteMessagesCall) OnTranslateMessageRaw(channelType interface{}, channelURL interface{}, messageID interface{}, targetLanguages interface{}) *messageTranslateMessageCall {
+	return _c.Parent.OnTranslateMessageRaw(channelType, channelURL, messageID, targetLanguages)
+}
+
 func (_m *messageMock) ListMessages(_ context.Context, channelType ChannelType, channelURL string, listMessagesRequest ListMessagesRequest) (*ListMessagesResponse, error) {
 	_ret := _m.Called(channelType, channelURL, listMessagesRequest)
 
@@ -32,217 +330,595 @@ func (_m *messageMock) ListMessages(_ context.Context, channelType ChannelType,
 		return _rf(channelType, channelURL, listMessagesRequest)
 	}
 
-	_ra0, _ := _ret.Get(0).(*ListMessagesResponse)
-	_rb1 := _ret.Error(1)
+	_ra0, _ := _ret.Get(0).(*ListMessagesResponse)
+	_rb1 := _ret.Error(1)
+
+	return _ra0, _rb1
+}
+
+func (_m *messageMock) OnListMessages(channelType ChannelType, channelURL string, listMessagesRequest ListMessagesRequest) *messageListMessagesCall {
+	return &messageListMessagesCall{Call: _m.Mock.On("ListMessages", channelType, channelURL, listMessagesRequest), Parent: _m}
+}
+
+func (_m *messageMock) OnListMessagesRaw(channelType interface{}, channelURL interface{}, listMessagesRequest interface{}) *messageListMessagesCall {
+	return &messageListMessagesCall{Call: _m.Mock.On("ListMessages", channelType, channelURL, listMessagesRequest), Parent: _m}
+}
+
+type messageListMessagesCall struct {
+	*mock.Call
+	Parent *messageMock
+}
+
+func (_c *messageListMessagesCall) Panic(msg string) *messageListMessagesCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *messageListMessagesCall) Once() *messageListMessagesCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *messageListMessagesCall) Twice() *messageListMessagesCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *messageListMessagesCall) Times(i int) *messageListMessagesCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *messageListMessagesCall) WaitUntil(w <-chan time.Time) *messageListMessagesCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *messageListMessagesCall) After(d time.Duration) *messageListMessagesCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *messageListMessagesCall) Run(fn func(args mock.Arguments)) *messageListMessagesCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *messageListMessagesCall) Maybe() *messageListMessagesCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *messageListMessagesCall) TypedReturns(a *ListMessagesResponse, b error) *messageListMessagesCall {
+	_c.Call = _c.Return(a, b)
+	return _c
+}
+
+func (_c *messageListMessagesCall) ReturnsFn(fn func(ChannelType, string, ListMessagesRequest) (*ListMessagesResponse, error)) *messageListMessagesCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *messageListMessagesCall) TypedRun(fn func(ChannelType, string, ListMessagesRequest)) *messageListMessagesCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_channelType, _ := args.Get(0).(ChannelType)
+		_channelURL := args.String(1)
+		_listMessagesRequest, _ := args.Get(2).(ListMessagesRequest)
+		fn(_channelType, _channelURL, _listMessagesRequest)
+	})
+	return _c
+}
+
+func (_c *messageListMessagesCall) OnDeleteMessage(channelType ChannelType, channelURL string, messageID int) *messageDeleteMessageCall {
+	return _c.Parent.OnDeleteMessage(channelType, channelURL, messageID)
+}
+
+func (_c *messageListMessagesCall) OnDeleteMessages(channelType ChannelType, channelURL string, deleteMessagesRequest DeleteMessagesRequest) *messageDeleteMessagesCall {
+	return _c.Parent.OnDeleteMessages(channelType, channelURL, deleteMessagesRequest)
+}
+
+func (_c *messageListMessagesCall) OnListMessages(channelType ChannelType, channelURL string, listMessagesRequest ListMessagesRequest) *messageListMessagesCall {
+	return _c.Parent.OnListMessages(channelType, channelURL, listMessagesRequest)
+}
+
+func (_c *messageListMessagesCall) OnListMessagesStream(channelType ChannelType, channelURL string, listMessagesRequest ListMessagesRequest, fn func(MessageResource) error) *messageListMessagesStreamCall {
+	return _c.Parent.OnListMessagesStream(channelType, channelURL, listMessagesRequest, fn)
+}
+
+func (_c *messageListMessagesCall) OnMigrateMessages(channelURL string, migrateMessagesRequest MigrateMessagesRequest) *messageMigrateMessagesCall {
+	return _c.Parent.OnMigrateMessages(channelURL, migrateMessagesRequest)
+}
+
+func (_c *messageListMessagesCall) OnSearchMessages(searchMessagesRequest SearchMessagesRequest) *messageSearchMessagesCall {
+	return _c.Parent.OnSearchMessages(searchMessagesRequest)
+}
+
+func (_c *messageListMessagesCall) OnSendMessage(channelType ChannelType, channelURL string, sendMessageRequest SendMessageRequest) *messageSendMessageCall {
+	return _c.Parent.OnSendMessage(channelType, channelURL, sendMessageRequest)
+}
+
+func (_c *messageListMessagesCall) OnTranslateMessage(channelType ChannelType, channelURL string, messageID int, targetLanguages []string) *messageTranslateMessageCall {
+	return _c.Parent.OnTranslateMessage(channelType, channelURL, messageID, targetLanguages)
+}
+
+func (_c *messageListMessagesCall) OnDeleteMessageRaw(channelType interface{}, channelURL interface{}, messageID interface{}) *messageDeleteMessageCall {
+	return _c.Parent.OnDeleteMessageRaw(channelType, channelURL, messageID)
+}
+
+func (_c *messageListMessagesCall) OnDeleteMessagesRaw(channelType interface{}, channelURL interface{}, deleteMessagesRequest interface{}) *messageDeleteMessagesCall {
+	return _c.Parent.OnDeleteMessagesRaw(channelType, channelURL, deleteMessagesRequest)
+}
+
+func (_c *messageListMessagesCall) OnListMessagesRaw(channelType interface{}, channelURL interface{}, listMessagesRequest interface{}) *messageListMessagesCall {
+	return _c.Parent.OnListMessagesRaw(channelType, channelURL, listMessagesRequest)
+}
+
+func (_c *messageListMessagesCall) OnListMessagesStreamRaw(channelType interface{}, channelURL interface{}, listMessagesRequest interface{}, fn interface{}) *messageListMessagesStreamCall {
+	return _c.Parent.OnListMessagesStreamRaw(channelType, channelURL, listMessagesRequest, fn)
+}
+
+func (_c *messageListMessagesCall) OnMigrateMessagesRaw(channelURL interface{}, migrateMessagesRequest interface{}) *messageMigrateMessagesCall {
+	return _c.Parent.OnMigrateMessagesRaw(channelURL, migrateMessagesRequest)
+}
+
+func (_c *messageListMessagesCall) OnSearchMessagesRaw(searchMessagesRequest interface{}) *messageSearchMessagesCall {
+	return _c.Parent.OnSearchMessagesRaw(searchMessagesRequest)
+}
+
+func (_c *messageListMessagesCall) OnSendMessageRaw(channelType interface{}, channelURL interface{}, sendMessageRequest interface{}) *messageSendMessageCall {
+	return _c.Parent.OnSendMessageRaw(channelType, channelURL, sendMessageRequest)
+}
+
+func (_c *messageListMessagesCall) OnTranslateMessageRaw(channelType interface{}, channelURL interface{}, messageID interface{}, targetLanguages interface{}) *messageTranslateMessageCall {
+	return _c.Parent.OnTranslateMessageRaw(channelType, channelURL, messageID, targetLanguages)
+}
+
+func (_m *messageMock) ListMessagesStream(_ context.Context, channelType ChannelType, channelURL string, listMessagesRequest ListMessagesRequest, fn func(MessageResource) error) error {
+	_ret := _m.Called(channelType, channelURL, listMessagesRequest, fn)
+
+	if _rf, ok := _ret.Get(0).(func(ChannelType, string, ListMessagesRequest, func(MessageResource) error) error); ok {
+		return _rf(channelType, channelURL, listMessagesRequest, fn)
+	}
+
+	_ra0 := _ret.Error(0)
+
+	return _ra0
+}
+
+func (_m *messageMock) OnListMessagesStream(channelType ChannelType, channelURL string, listMessagesRequest ListMessagesRequest, fn func(MessageResource) error) *messageListMessagesStreamCall {
+	return &messageListMessagesStreamCall{Call: _m.Mock.On("ListMessagesStream", channelType, channelURL, listMessagesRequest, fn), Parent: _m}
+}
+
+func (_m *messageMock) OnListMessagesStreamRaw(channelType interface{}, channelURL interface{}, listMessagesRequest interface{}, fn interface{}) *messageListMessagesStreamCall {
+	return &messageListMessagesStreamCall{Call: _m.Mock.On("ListMessagesStream", channelType, channelURL, listMessagesRequest, fn), Parent: _m}
+}
+
+type messageListMessagesStreamCall struct {
+	*mock.Call
+	Parent *messageMock
+}
+
+func (_c *messageListMessagesStreamCall) Panic(msg string) *messageListMessagesStreamCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *messageListMessagesStreamCall) Once() *messageListMessagesStreamCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *messageListMessagesStreamCall) Twice() *messageListMessagesStreamCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *messageListMessagesStreamCall) Times(i int) *messageListMessagesStreamCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *messageListMessagesStreamCall) WaitUntil(w <-chan time.Time) *messageListMessagesStreamCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *messageListMessagesStreamCall) After(d time.Duration) *messageListMessagesStreamCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *messageListMessagesStreamCall) Run(fn func(args mock.Arguments)) *messageListMessagesStreamCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *messageListMessagesStreamCall) Maybe() *messageListMessagesStreamCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *messageListMessagesStreamCall) TypedReturns(a error) *messageListMessagesStreamCall {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *messageListMessagesStreamCall) ReturnsFn(fn func(ChannelType, string, ListMessagesRequest, func(MessageResource) error) error) *messageListMessagesStreamCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *messageListMessagesStreamCall) TypedRun(fn func(ChannelType, string, ListMessagesRequest, func(MessageResource) error)) *messageListMessagesStreamCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_channelType, _ := args.Get(0).(ChannelType)
+		_channelURL := args.String(1)
+		_listMessagesRequest, _ := args.Get(2).(ListMessagesRequest)
+		_fn, _ := args.Get(3).(func(MessageResource) error)
+		fn(_channelType, _channelURL, _listMessagesRequest, _fn)
+	})
+	return _c
+}
+
+func (_c *messageListMessagesStreamCall) OnDeleteMessage(channelType ChannelType, channelURL string, messageID int) *messageDeleteMessageCall {
+	return _c.Parent.OnDeleteMessage(channelType, channelURL, messageID)
+}
+
+func (_c *messageListMessagesStreamCall) OnDeleteMessages(channelType ChannelType, channelURL string, deleteMessagesRequest DeleteMessagesRequest) *messageDeleteMessagesCall {
+	return _c.Parent.OnDeleteMessages(channelType, channelURL, deleteMessagesRequest)
+}
+
+func (_c *messageListMessagesStreamCall) OnListMessages(channelType ChannelType, channelURL string, listMessagesRequest ListMessagesRequest) *messageListMessagesCall {
+	return _c.Parent.OnListMessages(channelType, channelURL, listMessagesRequest)
+}
+
+func (_c *messageListMessagesStreamCall) OnListMessagesStream(channelType ChannelType, channelURL string, listMessagesRequest ListMessagesRequest, fn func(MessageResource) error) *messageListMessagesStreamCall {
+	return _c.Parent.OnListMessagesStream(channelType, channelURL, listMessagesRequest, fn)
+}
+
+func (_c *messageListMessagesStreamCall) OnMigrateMessages(channelURL string, migrateMessagesRequest MigrateMessagesRequest) *messageMigrateMessagesCall {
+	return _c.Parent.OnMigrateMessages(channelURL, migrateMessagesRequest)
+}
+
+func (_c *messageListMessagesStreamCall) OnSearchMessages(searchMessagesRequest SearchMessagesRequest) *messageSearchMessagesCall {
+	return _c.Parent.OnSearchMessages(searchMessagesRequest)
+}
+
+func (_c *messageListMessagesStreamCall) OnSendMessage(channelType ChannelType, channelURL string, sendMessageRequest SendMessageRequest) *messageSendMessageCall {
+	return _c.Parent.OnSendMessage(channelType, channelURL, sendMessageRequest)
+}
+
+func (_c *messageListMessagesStreamCall) OnTranslateMessage(channelType ChannelType, channelURL string, messageID int, targetLanguages []string) *messageTranslateMessageCall {
+	return _c.Parent.OnTranslateMessage(channelType, channelURL, messageID, targetLanguages)
+}
+
+func (_c *messageListMessagesStreamCall) OnDeleteMessageRaw(channelType interface{}, channelURL interface{}, messageID interface{}) *messageDeleteMessageCall {
+	return _c.Parent.OnDeleteMessageRaw(channelType, channelURL, messageID)
+}
+
+func (_c *messageListMessagesStreamCall) OnDeleteMessagesRaw(channelType interface{}, channelURL interface{}, deleteMessagesRequest interface{}) *messageDeleteMessagesCall {
+	return _c.Parent.OnDeleteMessagesRaw(channelType, channelURL, deleteMessagesRequest)
+}
+
+func (_c *messageListMessagesStreamCall) OnListMessagesRaw(channelType interface{}, channelURL interface{}, listMessagesRequest interface{}) *messageListMessagesCall {
+	return _c.Parent.OnListMessagesRaw(channelType, channelURL, listMessagesRequest)
+}
+
+func (_c *messageListMessagesStreamCall) OnListMessagesStreamRaw(channelType interface{}, channelURL interface{}, listMessagesRequest interface{}, fn interface{}) *messageListMessagesStreamCall {
+	return _c.Parent.OnListMessagesStreamRaw(channelType, channelURL, listMessagesRequest, fn)
+}
+
+func (_c *messageListMessagesStreamCall) OnMigrateMessagesRaw(channelURL interface{}, migrateMessagesRequest interface{}) *messageMigrateMessagesCall {
+	return _c.Parent.OnMigrateMessagesRaw(channelURL, migrateMessagesRequest)
+}
+
+func (_c *messageListMessagesStreamCall) OnSearchMessagesRaw(searchMessagesRequest interface{}) *messageSearchMessagesCall {
+	return _c.Parent.OnSearchMessagesRaw(searchMessagesRequest)
+}
+
+func (_c *messageListMessagesStreamCall) OnSendMessageRaw(channelType interface{}, channelURL interface{}, sendMessageRequest interface{}) *messageSendMessageCall {
+	return _c.Parent.OnSendMessageRaw(channelType, channelURL, sendMessageRequest)
+}
+
+func (_c *messageListMessagesStreamCall) OnTranslateMessageRaw(channelType interface{}, channelURL interface{}, messageID interface{}, targetLanguages interface{}) *messageTranslateMessageCall {
+	return _c.Parent.OnTranslateMessageRaw(channelType, channelURL, messageID, targetLanguages)
+}
+
+func (_m *messageMock) MigrateMessages(_ context.Context, channelURL string, migrateMessagesRequest MigrateMessagesRequest) error {
+	_ret := _m.Called(channelURL, migrateMessagesRequest)
+
+	if _rf, ok := _ret.Get(0).(func(string, MigrateMessagesRequest) error); ok {
+		return _rf(channelURL, migrateMessagesRequest)
+	}
+
+	_ra0 := _ret.Error(0)
 
-	return _ra0, _rb1
+	return _ra0
 }
 
-func (_m *messageMock) OnListMessages(channelType ChannelType, channelURL string, listMessagesRequest ListMessagesRequest) *messageListMessagesCall {
-	return &messageListMessagesCall{Call: _m.Mock.On("ListMessages", channelType, channelURL, listMessagesRequest), Parent: _m}
+func (_m *messageMock) OnMigrateMessages(channelURL string, migrateMessagesRequest MigrateMessagesRequest) *messageMigrateMessagesCall {
+	return &messageMigrateMessagesCall{Call: _m.Mock.On("MigrateMessages", channelURL, migrateMessagesRequest), Parent: _m}
 }
 
-func (_m *messageMock) OnListMessagesRaw(channelType interface{}, channelURL interface{}, listMessagesRequest interface{}) *messageListMessagesCall {
-	return &messageListMessagesCall{Call: _m.Mock.On("ListMessages", channelType, channelURL, listMessagesRequest), Parent: _m}
+func (_m *messageMock) OnMigrateMessagesRaw(channelURL interface{}, migrateMessagesRequest interface{}) *messageMigrateMessagesCall {
+	return &messageMigrateMessagesCall{Call: _m.Mock.On("MigrateMessages", channelURL, migrateMessagesRequest), Parent: _m}
 }
 
-type messageListMessagesCall struct {
+type messageMigrateMessagesCall struct {
 	*mock.Call
 	Parent *messageMock
 }
 
-func (_c *messageListMessagesCall) Panic(msg string) *messageListMessagesCall {
+func (_c *messageMigrateMessagesCall) Panic(msg string) *messageMigrateMessagesCall {
 	_c.Call = _c.Call.Panic(msg)
 	return _c
 }
 
-func (_c *messageListMessagesCall) Once() *messageListMessagesCall {
+func (_c *messageMigrateMessagesCall) Once() *messageMigrateMessagesCall {
 	_c.Call = _c.Call.Once()
 	return _c
 }
 
-func (_c *messageListMessagesCall) Twice() *messageListMessagesCall {
+func (_c *messageMigrateMessagesCall) Twice() *messageMigrateMessagesCall {
 	_c.Call = _c.Call.Twice()
 	return _c
 }
 
-func (_c *messageListMessagesCall) Times(i int) *messageListMessagesCall {
+func (_c *messageMigrateMessagesCall) Times(i int) *messageMigrateMessagesCall {
 	_c.Call = _c.Call.Times(i)
 	return _c
 }
 
-func (_c *messageListMessagesCall) WaitUntil(w <-chan time.Time) *messageListMessagesCall {
+func (_c *messageMigrateMessagesCall) WaitUntil(w <-chan time.Time) *messageMigrateMessagesCall {
 	_c.Call = _c.Call.WaitUntil(w)
 	return _c
 }
 
-func (_c *messageListMessagesCall) After(d time.Duration) *messageListMessagesCall {
+func (_c *messageMigrateMessagesCall) After(d time.Duration) *messageMigrateMessagesCall {
 	_c.Call = _c.Call.After(d)
 	return _c
 }
 
-func (_c *messageListMessagesCall) Run(fn func(args mock.Arguments)) *messageListMessagesCall {
+func (_c *messageMigrateMessagesCall) Run(fn func(args mock.Arguments)) *messageMigrateMessagesCall {
 	_c.Call = _c.Call.Run(fn)
 	return _c
 }
 
-func (_c *messageListMessagesCall) Maybe() *messageListMessagesCall {
+func (_c *messageMigrateMessagesCall) Maybe() *messageMigrateMessagesCall {
 	_c.Call = _c.Call.Maybe()
 	return _c
 }
 
-func (_c *messageListMessagesCall) TypedReturns(a *ListMessagesResponse, b error) *messageListMessagesCall {
-	_c.Call = _c.Return(a, b)
+func (_c *messageMigrateMessagesCall) TypedReturns(a error) *messageMigrateMessagesCall {
+	_c.Call = _c.Return(a)
 	return _c
 }
 
-func (_c *messageListMessagesCall) ReturnsFn(fn func(ChannelType, string, ListMessagesRequest) (*ListMessagesResponse, error)) *messageListMessagesCall {
+func (_c *messageMigrateMessagesCall) ReturnsFn(fn func(string, MigrateMessagesRequest) error) *messageMigrateMessagesCall {
 	_c.Call = _c.Return(fn)
 	return _c
 }
 
-func (_c *messageListMessagesCall) TypedRun(fn func(ChannelType, string, ListMessagesRequest)) *messageListMessagesCall {
+func (_c *messageMigrateMessagesCall) TypedRun(fn func(string, MigrateMessagesRequest)) *messageMigrateMessagesCall {
 	_c.Call = _c.Call.Run(func(args mock.Arguments) {
-		_channelType, _ := args.Get(0).(ChannelType)
-		_channelURL := args.String(1)
-		_listMessagesRequest, _ := args.Get(2).(ListMessagesRequest)
-		fn(_channelType, _channelURL, _listMessagesRequest)
+		_channelURL := args.String(0)
+		_migrateMessagesRequest, _ := args.Get(1).(MigrateMessagesRequest)
+		fn(_channelURL, _migrateMessagesRequest)
 	})
 	return _c
 }
 
-func (_c *messageListMessagesCall) OnListMessages(channelType ChannelType, channelURL string, listMessagesRequest ListMessagesRequest) *messageListMessagesCall {
+func (_c *messageMigrateMessagesCall) OnDeleteMessage(channelType ChannelType, channelURL string, messageID int) *messageDeleteMessageCall {
+	return _c.Parent.OnDeleteMessage(channelType, channelURL, messageID)
+}
+
+func (_c *messageMigrateMessagesCall) OnDeleteMessages(channelType ChannelType, channelURL string, deleteMessagesRequest DeleteMessagesRequest) *messageDeleteMessagesCall {
+	return _c.Parent.OnDeleteMessages(channelType, channelURL, deleteMessagesRequest)
+}
+
+func (_c *messageMigrateMessagesCall) OnListMessages(channelType ChannelType, channelURL string, listMessagesRequest ListMessagesRequest) *messageListMessagesCall {
 	return _c.Parent.OnListMessages(channelType, channelURL, listMessagesRequest)
 }
 
-func (_c *messageListMessagesCall) OnMigrateMessages(channelURL string, migrateMessagesRequest MigrateMessagesRequest) *messageMigrateMessagesCall {
+func (_c *messageMigrateMessagesCall) OnListMessagesStream(channelType ChannelType, channelURL string, listMessagesRequest ListMessagesRequest, fn func(MessageResource) error) *messageListMessagesStreamCall {
+	return _c.Parent.OnListMessagesStream(channelType, channelURL, listMessagesRequest, fn)
+}
+
+func (_c *messageMigrateMessagesCall) OnMigrateMessages(channelURL string, migrateMessagesRequest MigrateMessagesRequest) *messageMigrateMessagesCall {
 	return _c.Parent.OnMigrateMessages(channelURL, migrateMessagesRequest)
 }
 
-func (_c *messageListMessagesCall) OnSendMessage(channelType ChannelType, channelURL string, sendMessageRequest SendMessageRequest) *messageSendMessageCall {
+func (_c *messageMigrateMessagesCall) OnSearchMessages(searchMessagesRequest SearchMessagesRequest) *messageSearchMessagesCall {
+	return _c.Parent.OnSearchMessages(searchMessagesRequest)
+}
+
+func (_c *messageMigrateMessagesCall) OnSendMessage(channelType ChannelType, channelURL string, sendMessageRequest SendMessageRequest) *messageSendMessageCall {
 	return _c.Parent.OnSendMessage(channelType, channelURL, sendMessageRequest)
 }
 
-func (_c *messageListMessagesCall) OnListMessagesRaw(channelType interface{}, channelURL interface{}, listMessagesRequest interface{}) *messageListMessagesCall {
+func (_c *messageMigrateMessagesCall) OnTranslateMessage(channelType ChannelType, channelURL string, messageID int, targetLanguages []string) *messageTranslateMessageCall {
+	return _c.Parent.OnTranslateMessage(channelType, channelURL, messageID, targetLanguages)
+}
+
+func (_c *messageMigrateMessagesCall) OnDeleteMessageRaw(channelType interface{}, channelURL interface{}, messageID interface{}) *messageDeleteMessageCall {
+	return _c.Parent.OnDeleteMessageRaw(channelType, channelURL, messageID)
+}
+
+func (_c *messageMigrateMessagesCall) OnDeleteMessagesRaw(channelType interface{}, channelURL interface{}, deleteMessagesRequest interface{}) *messageDeleteMessagesCall {
+	return _c.Parent.OnDeleteMessagesRaw(channelType, channelURL, deleteMessagesRequest)
+}
+
+func (_c *messageMigrateMessagesCall) OnListMessagesRaw(channelType interface{}, channelURL interface{}, listMessagesRequest interface{}) *messageListMessagesCall {
 	return _c.Parent.OnListMessagesRaw(channelType, channelURL, listMessagesRequest)
 }
 
-func (_c *messageListMessagesCall) OnMigrateMessagesRaw(channelURL interface{}, migrateMessagesRequest interface{}) *messageMigrateMessagesCall {
+func (_c *messageMigrateMessagesCall) OnListMessagesStreamRaw(channelType interface{}, channelURL interface{}, listMessagesRequest interface{}, fn interface{}) *messageListMessagesStreamCall {
+	return _c.Parent.OnListMessagesStreamRaw(channelType, channelURL, listMessagesRequest, fn)
+}
+
+func (_c *messageMigrateMessagesCall) OnMigrateMessagesRaw(channelURL interface{}, migrateMessagesRequest interface{}) *messageMigrateMessagesCall {
 	return _c.Parent.OnMigrateMessagesRaw(channelURL, migrateMessagesRequest)
 }
 
-func (_c *messageListMessagesCall) OnSendMessageRaw(channelType interface{}, channelURL interface{}, sendMessageRequest interface{}) *messageSendMessageCall {
+func (_c *messageMigrateMessagesCall) OnSearchMessagesRaw(searchMessagesRequest interface{}) *messageSearchMessagesCall {
+	return _c.Parent.OnSearchMessagesRaw(searchMessagesRequest)
+}
+
+func (_c *messageMigrateMessagesCall) OnSendMessageRaw(channelType interface{}, channelURL interface{}, sendMessageRequest interface{}) *messageSendMessageCall {
 	return _c.Parent.OnSendMessageRaw(channelType, channelURL, sendMessageRequest)
 }
 
-func (_m *messageMock) MigrateMessages(_ context.Context, channelURL string, migrateMessagesRequest MigrateMessagesRequest) error {
-	_ret := _m.Called(channelURL, migrateMessagesRequest)
+func (_c *messageMigrateMessagesCall) OnTranslateMessageRaw(channelType interface{}, channelURL interface{}, messageID interface{}, targetLanguages interface{}) *messageTranslateMessageCall {
+	return _c.Parent.OnTranslateMessageRaw(channelType, channelURL, messageID, targetLanguages)
+}
 
-	if _rf, ok := _ret.Get(0).(func(string, MigrateMessagesRequest) error); ok {
-		return _rf(channelURL, migrateMessagesRequest)
+func (_m *messageMock) SearchMessages(_ context.Context, searchMessagesRequest SearchMessagesRequest) (*SearchMessagesResponse, error) {
+	_ret := _m.Called(searchMessagesRequest)
+
+	if _rf, ok := _ret.Get(0).(func(SearchMessagesRequest) (*SearchMessagesResponse, error)); ok {
+		return _rf(searchMessagesRequest)
 	}
 
-	_ra0 := _ret.Error(0)
+	_ra0, _ := _ret.Get(0).(*SearchMessagesResponse)
+	_rb1 := _ret.Error(1)
 
-	return _ra0
+	return _ra0, _rb1
 }
 
-func (_m *messageMock) OnMigrateMessages(channelURL string, migrateMessagesRequest MigrateMessagesRequest) *messageMigrateMessagesCall {
-	return &messageMigrateMessagesCall{Call: _m.Mock.On("MigrateMessages", channelURL, migrateMessagesRequest), Parent: _m}
+func (_m *messageMock) OnSearchMessages(searchMessagesRequest SearchMessagesRequest) *messageSearchMessagesCall {
+	return &messageSearchMessagesCall{Call: _m.Mock.On("SearchMessages", searchMessagesRequest), Parent: _m}
 }
 
-func (_m *messageMock) OnMigrateMessagesRaw(channelURL interface{}, migrateMessagesRequest interface{}) *messageMigrateMessagesCall {
-	return &messageMigrateMessagesCall{Call: _m.Mock.On("MigrateMessages", channelURL, migrateMessagesRequest), Parent: _m}
+func (_m *messageMock) OnSearchMessagesRaw(searchMessagesRequest interface{}) *messageSearchMessagesCall {
+	return &messageSearchMessagesCall{Call: _m.Mock.On("SearchMessages", searchMessagesRequest), Parent: _m}
 }
 
-type messageMigrateMessagesCall struct {
+type messageSearchMessagesCall struct {
 	*mock.Call
 	Parent *messageMock
 }
 
-func (_c *messageMigrateMessagesCall) Panic(msg string) *messageMigrateMessagesCall {
+func (_c *messageSearchMessagesCall) Panic(msg string) *messageSearchMessagesCall {
 	_c.Call = _c.Call.Panic(msg)
 	return _c
 }
 
-func (_c *messageMigrateMessagesCall) Once() *messageMigrateMessagesCall {
+func (_c *messageSearchMessagesCall) Once() *messageSearchMessagesCall {
 	_c.Call = _c.Call.Once()
 	return _c
 }
 
-func (_c *messageMigrateMessagesCall) Twice() *messageMigrateMessagesCall {
+func (_c *messageSearchMessagesCall) Twice() *messageSearchMessagesCall {
 	_c.Call = _c.Call.Twice()
 	return _c
 }
 
-func (_c *messageMigrateMessagesCall) Times(i int) *messageMigrateMessagesCall {
+func (_c *messageSearchMessagesCall) Times(i int) *messageSearchMessagesCall {
 	_c.Call = _c.Call.Times(i)
 	return _c
 }
 
-func (_c *messageMigrateMessagesCall) WaitUntil(w <-chan time.Time) *messageMigrateMessagesCall {
+func (_c *messageSearchMessagesCall) WaitUntil(w <-chan time.Time) *messageSearchMessagesCall {
 	_c.Call = _c.Call.WaitUntil(w)
 	return _c
 }
 
-func (_c *messageMigrateMessagesCall) After(d time.Duration) *messageMigrateMessagesCall {
+func (_c *messageSearchMessagesCall) After(d time.Duration) *messageSearchMessagesCall {
 	_c.Call = _c.Call.After(d)
 	return _c
 }
 
-func (_c *messageMigrateMessagesCall) Run(fn func(args mock.Arguments)) *messageMigrateMessagesCall {
+func (_c *messageSearchMessagesCall) Run(fn func(args mock.Arguments)) *messageSearchMessagesCall {
 	_c.Call = _c.Call.Run(fn)
 	return _c
 }
 
-func (_c *messageMigrateMessagesCall) Maybe() *messageMigrateMessagesCall {
+func (_c *messageSearchMessagesCall) Maybe() *messageSearchMessagesCall {
 	_c.Call = _c.Call.Maybe()
 	return _c
 }
 
-func (_c *messageMigrateMessagesCall) TypedReturns(a error) *messageMigrateMessagesCall {
-	_c.Call = _c.Return(a)
+func (_c *messageSearchMessagesCall) TypedReturns(a *SearchMessagesResponse, b error) *messageSearchMessagesCall {
+	_c.Call = _c.Return(a, b)
 	return _c
 }
 
-func (_c *messageMigrateMessagesCall) ReturnsFn(fn func(string, MigrateMessagesRequest) error) *messageMigrateMessagesCall {
+func (_c *messageSearchMessagesCall) ReturnsFn(fn func(SearchMessagesRequest) (*SearchMessagesResponse, error)) *messageSearchMessagesCall {
 	_c.Call = _c.Return(fn)
 	return _c
 }
 
-func (_c *messageMigrateMessagesCall) TypedRun(fn func(string, MigrateMessagesRequest)) *messageMigrateMessagesCall {
+func (_c *messageSearchMessagesCall) TypedRun(fn func(SearchMessagesRequest)) *messageSearchMessagesCall {
 	_c.Call = _c.Call.Run(func(args mock.Arguments) {
-		_channelURL := args.String(0)
-		_migrateMessagesRequest, _ := args.Get(1).(MigrateMessagesRequest)
-		fn(_channelURL, _migrateMessagesRequest)
+		_searchMessagesRequest, _ := args.Get(0).(SearchMessagesRequest)
+		fn(_searchMessagesRequest)
 	})
 	return _c
 }
 
-func (_c *messageMigrateMessagesCall) OnListMessages(channelType ChannelType, channelURL string, listMessagesRequest ListMessagesRequest) *messageListMessagesCall {
+func (_c *messageSearchMessagesCall) OnDeleteMessage(channelType ChannelType, channelURL string, messageID int) *messageDeleteMessageCall {
+	return _c.Parent.OnDeleteMessage(channelType, channelURL, messageID)
+}
+
+func (_c *messageSearchMessagesCall) OnDeleteMessages(channelType ChannelType, channelURL string, deleteMessagesRequest DeleteMessagesRequest) *messageDeleteMessagesCall {
+	return _c.Parent.OnDeleteMessages(channelType, channelURL, deleteMessagesRequest)
+}
+
+func (_c *messageSearchMessagesCall) OnListMessages(channelType ChannelType, channelURL string, listMessagesRequest ListMessagesRequest) *messageListMessagesCall {
 	return _c.Parent.OnListMessages(channelType, channelURL, listMessagesRequest)
 }
 
-func (_c *messageMigrateMessagesCall) OnMigrateMessages(channelURL string, migrateMessagesRequest MigrateMessagesRequest) *messageMigrateMessagesCall {
+func (_c *messageSearchMessagesCall) OnListMessagesStream(channelType ChannelType, channelURL string, listMessagesRequest ListMessagesRequest, fn func(MessageResource) error) *messageListMessagesStreamCall {
+	return _c.Parent.OnListMessagesStream(channelType, channelURL, listMessagesRequest, fn)
+}
+
+func (_c *messageSearchMessagesCall) OnMigrateMessages(channelURL string, migrateMessagesRequest MigrateMessagesRequest) *messageMigrateMessagesCall {
 	return _c.Parent.OnMigrateMessages(channelURL, migrateMessagesRequest)
 }
 
-func (_c *messageMigrateMessagesCall) OnSendMessage(channelType ChannelType, channelURL string, sendMessageRequest SendMessageRequest) *messageSendMessageCall {
+func (_c *messageSearchMessagesCall) OnSearchMessages(searchMessagesRequest SearchMessagesRequest) *messageSearchMessagesCall {
+	return _c.Parent.OnSearchMessages(searchMessagesRequest)
+}
+
+func (_c *messageSearchMessagesCall) OnSendMessage(channelType ChannelType, channelURL string, sendMessageRequest SendMessageRequest) *messageSendMessageCall {
 	return _c.Parent.OnSendMessage(channelType, channelURL, sendMessageRequest)
 }
 
-func (_c *messageMigrateMessagesCall) OnListMessagesRaw(channelType interface{}, channelURL interface{}, listMessagesRequest interface{}) *messageListMessagesCall {
+func (_c *messageSearchMessagesCall) OnTranslateMessage(channelType ChannelType, channelURL string, messageID int, targetLanguages []string) *messageTranslateMessageCall {
+	return _c.Parent.OnTranslateMessage(channelType, channelURL, messageID, targetLanguages)
+}
+
+func (_c *messageSearchMessagesCall) OnDeleteMessageRaw(channelType interface{}, channelURL interface{}, messageID interface{}) *messageDeleteMessageCall {
+	return _c.Parent.OnDeleteMessageRaw(channelType, channelURL, messageID)
+}
+
+func (_c *messageSearchMessagesCall) OnDeleteMessagesRaw(channelType interface{}, channelURL interface{}, deleteMessagesRequest interface{}) *messageDeleteMessagesCall {
+	return _c.Parent.OnDeleteMessagesRaw(channelType, channelURL, deleteMessagesRequest)
+}
+
+func (_c *messageSearchMessagesCall) OnListMessagesRaw(channelType interface{}, channelURL interface{}, listMessagesRequest interface{}) *messageListMessagesCall {
 	return _c.Parent.OnListMessagesRaw(channelType, channelURL, listMessagesRequest)
 }
 
-func (_c *messageMigrateMessagesCall) OnMigrateMessagesRaw(channelURL interface{}, migrateMessagesRequest interface{}) *messageMigrateMessagesCall {
+func (_c *messageSearchMessagesCall) OnListMessagesStreamRaw(channelType interface{}, channelURL interface{}, listMessagesRequest interface{}, fn interface{}) *messageListMessagesStreamCall {
+	return _c.Parent.OnListMessagesStreamRaw(channelType, channelURL, listMessagesRequest, fn)
+}
+
+func (_c *messageSearchMessagesCall) OnMigrateMessagesRaw(channelURL interface{}, migrateMessagesRequest interface{}) *messageMigrateMessagesCall {
 	return _c.Parent.OnMigrateMessagesRaw(channelURL, migrateMessagesRequest)
 }
 
-func (_c *messageMigrateMessagesCall) OnSendMessageRaw(channelType interface{}, channelURL interface{}, sendMessageRequest interface{}) *messageSendMessageCall {
+func (_c *messageSearchMessagesCall) OnSearchMessagesRaw(searchMessagesRequest interface{}) *messageSearchMessagesCall {
+	return _c.Parent.OnSearchMessagesRaw(searchMessagesRequest)
+}
+
+func (_c *messageSearchMessagesCall) OnSendMessageRaw(channelType interface{}, channelURL interface{}, sendMessageRequest interface{}) *messageSendMessageCall {
 	return _c.Parent.OnSendMessageRaw(channelType, channelURL, sendMessageRequest)
 }
 
+func (_c *messageSearchMessagesCall) OnTranslateMessageRaw(channelType interface{}, channelURL interface{}, messageID interface{}, targetLanguages interface{}) *messageTranslateMessageCall {
+	return _c.Parent.OnTranslateMessageRaw(channelType, channelURL, messageID, targetLanguages)
+}
+
 func (_m *messageMock) SendMessage(_ context.Context, channelType ChannelType, channelURL string, sendMessageRequest SendMessageRequest) (*SendMessageResponse, error) {
 	_ret := _m.Called(channelType, channelURL, sendMessageRequest)
 
@@ -329,26 +1005,217 @@ func (_c *messageSendMessageCall) TypedRun(fn func(ChannelType, string, SendMess
 	return _c
 }
 
+func (_c *messageSendMessageCall) OnDeleteMessage(channelType ChannelType, channelURL string, messageID int) *messageDeleteMessageCall {
+	return _c.Parent.OnDeleteMessage(channelType, channelURL, messageID)
+}
+
+func (_c *messageSendMessageCall) OnDeleteMessages(channelType ChannelType, channelURL string, deleteMessagesRequest DeleteMessagesRequest) *messageDeleteMessagesCall {
+	return _c.Parent.OnDeleteMessages(channelType, channelURL, deleteMessagesRequest)
+}
+
 func (_c *messageSendMessageCall) OnListMessages(channelType ChannelType, channelURL string, listMessagesRequest ListMessagesRequest) *messageListMessagesCall {
 	return _c.Parent.OnListMessages(channelType, channelURL, listMessagesRequest)
 }
 
+func (_c *messageSendMessageCall) OnListMessagesStream(channelType ChannelType, channelURL string, listMessagesRequest ListMessagesRequest, fn func(MessageResource) error) *messageListMessagesStreamCall {
+	return _c.Parent.OnListMessagesStream(channelType, channelURL, listMessagesRequest, fn)
+}
+
 func (_c *messageSendMessageCall) OnMigrateMessages(channelURL string, migrateMessagesRequest MigrateMessagesRequest) *messageMigrateMessagesCall {
 	return _c.Parent.OnMigrateMessages(channelURL, migrateMessagesRequest)
 }
 
+func (_c *messageSendMessageCall) OnSearchMessages(searchMessagesRequest SearchMessagesRequest) *messageSearchMessagesCall {
+	return _c.Parent.OnSearchMessages(searchMessagesRequest)
+}
+
 func (_c *messageSendMessageCall) OnSendMessage(channelType ChannelType, channelURL string, sendMessageRequest SendMessageRequest) *messageSendMessageCall {
 	return _c.Parent.OnSendMessage(channelType, channelURL, sendMessageRequest)
 }
 
+func (_c *messageSendMessageCall) OnTranslateMessage(channelType ChannelType, channelURL string, messageID int, targetLanguages []string) *messageTranslateMessageCall {
+	return _c.Parent.OnTranslateMessage(channelType, channelURL, messageID, targetLanguages)
+}
+
+func (_c *messageSendMessageCall) OnDeleteMessageRaw(channelType interface{}, channelURL interface{}, messageID interface{}) *messageDeleteMessageCall {
+	return _c.Parent.OnDeleteMessageRaw(channelType, channelURL, messageID)
+}
+
+func (_c *messageSendMessageCall) OnDeleteMessagesRaw(channelType interface{}, channelURL interface{}, deleteMessagesRequest interface{}) *messageDeleteMessagesCall {
+	return _c.Parent.OnDeleteMessagesRaw(channelType, channelURL, deleteMessagesRequest)
+}
+
 func (_c *messageSendMessageCall) OnListMessagesRaw(channelType interface{}, channelURL interface{}, listMessagesRequest interface{}) *messageListMessagesCall {
 	return _c.Parent.OnListMessagesRaw(channelType, channelURL, listMessagesRequest)
 }
 
+func (_c *messageSendMessageCall) OnListMessagesStreamRaw(channelType interface{}, channelURL interface{}, listMessagesRequest interface{}, fn interface{}) *messageListMessagesStreamCall {
+	return _c.Parent.OnListMessagesStreamRaw(channelType, channelURL, listMessagesRequest, fn)
+}
+
 func (_c *messageSendMessageCall) OnMigrateMessagesRaw(channelURL interface{}, migrateMessagesRequest interface{}) *messageMigrateMessagesCall {
 	return _c.Parent.OnMigrateMessagesRaw(channelURL, migrateMessagesRequest)
 }
 
+func (_c *messageSendMessageCall) OnSearchMessagesRaw(searchMessagesRequest interface{}) *messageSearchMessagesCall {
+	return _c.Parent.OnSearchMessagesRaw(searchMessagesRequest)
+}
+
 func (_c *messageSendMessageCall) OnSendMessageRaw(channelType interface{}, channelURL interface{}, sendMessageRequest interface{}) *messageSendMessageCall {
 	return _c.Parent.OnSendMessageRaw(channelType, channelURL, sendMessageRequest)
 }
+
+func (_c *messageSendMessageCall) OnTranslateMessageRaw(channelType interface{}, channelURL interface{}, messageID interface{}, targetLanguages interface{}) *messageTranslateMessageCall {
+	return _c.Parent.OnTranslateMessageRaw(channelType, channelURL, messageID, targetLanguages)
+}
+
+func (_m *messageMock) TranslateMessage(_ context.Context, channelType ChannelType, channelURL string, messageID int, targetLanguages []string) (*TranslateMessageResponse, error) {
+	_ret := _m.Called(channelType, channelURL, messageID, targetLanguages)
+
+	if _rf, ok := _ret.Get(0).(func(ChannelType, string, int, []string) (*TranslateMessageResponse, error)); ok {
+		return _rf(channelType, channelURL, messageID, targetLanguages)
+	}
+
+	_ra0, _ := _ret.Get(0).(*TranslateMessageResponse)
+	_rb1 := _ret.Error(1)
+
+	return _ra0, _rb1
+}
+
+func (_m *messageMock) OnTranslateMessage(channelType ChannelType, channelURL string, messageID int, targetLanguages []string) *messageTranslateMessageCall {
+	return &messageTranslateMessageCall{Call: _m.Mock.On("TranslateMessage", channelType, channelURL, messageID, targetLanguages), Parent: _m}
+}
+
+func (_m *messageMock) OnTranslateMessageRaw(channelType interface{}, channelURL interface{}, messageID interface{}, targetLanguages interface{}) *messageTranslateMessageCall {
+	return &messageTranslateMessageCall{Call: _m.Mock.On("TranslateMessage", channelType, channelURL, messageID, targetLanguages), Parent: _m}
+}
+
+type messageTranslateMessageCall struct {
+	*mock.Call
+	Parent *messageMock
+}
+
+func (_c *messageTranslateMessageCall) Panic(msg string) *messageTranslateMessageCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *messageTranslateMessageCall) Once() *messageTranslateMessageCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *messageTranslateMessageCall) Twice() *messageTranslateMessageCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *messageTranslateMessageCall) Times(i int) *messageTranslateMessageCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *messageTranslateMessageCall) WaitUntil(w <-chan time.Time) *messageTranslateMessageCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *messageTranslateMessageCall) After(d time.Duration) *messageTranslateMessageCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *messageTranslateMessageCall) Run(fn func(args mock.Arguments)) *messageTranslateMessageCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *messageTranslateMessageCall) Maybe() *messageTranslateMessageCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *messageTranslateMessageCall) TypedReturns(a *TranslateMessageResponse, b error) *messageTranslateMessageCall {
+	_c.Call = _c.Return(a, b)
+	return _c
+}
+
+func (_c *messageTranslateMessageCall) ReturnsFn(fn func(ChannelType, string, int, []string) (*TranslateMessageResponse, error)) *messageTranslateMessageCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *messageTranslateMessageCall) TypedRun(fn func(ChannelType, string, int, []string)) *messageTranslateMessageCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_channelType, _ := args.Get(0).(ChannelType)
+		_channelURL := args.String(1)
+		_messageID := args.Int(2)
+		_targetLanguages, _ := args.Get(3).([]string)
+		fn(_channelType, _channelURL, _messageID, _targetLanguages)
+	})
+	return _c
+}
+
+func (_c *messageTranslateMessageCall) OnDeleteMessage(channelType ChannelType, channelURL string, messageID int) *messageDeleteMessageCall {
+	return _c.Parent.OnDeleteMessage(channelType, channelURL, messageID)
+}
+
+func (_c *messageTranslateMessageCall) OnDeleteMessages(channelType ChannelType, channelURL string, deleteMessagesRequest DeleteMessagesRequest) *messageDeleteMessagesCall {
+	return _c.Parent.OnDeleteMessages(channelType, channelURL, deleteMessagesRequest)
+}
+
+func (_c *messageTranslateMessageCall) OnListMessages(channelType ChannelType, channelURL string, listMessagesRequest ListMessagesRequest) *messageListMessagesCall {
+	return _c.Parent.OnListMessages(channelType, channelURL, listMessagesRequest)
+}
+
+func (_c *messageTranslateMessageCall) OnListMessagesStream(channelType ChannelType, channelURL string, listMessagesRequest ListMessagesRequest, fn func(MessageResource) error) *messageListMessagesStreamCall {
+	return _c.Parent.OnListMessagesStream(channelType, channelURL, listMessagesRequest, fn)
+}
+
+func (_c *messageTranslateMessageCall) OnMigrateMessages(channelURL string, migrateMessagesRequest MigrateMessagesRequest) *messageMigrateMessagesCall {
+	return _c.Parent.OnMigrateMessages(channelURL, migrateMessagesRequest)
+}
+
+func (_c *messageTranslateMessageCall) OnSearchMessages(searchMessagesRequest SearchMessagesRequest) *messageSearchMessagesCall {
+	return _c.Parent.OnSearchMessages(searchMessagesRequest)
+}
+
+func (_c *messageTranslateMessageCall) OnSendMessage(channelType ChannelType, channelURL string, sendMessageRequest SendMessageRequest) *messageSendMessageCall {
+	return _c.Parent.OnSendMessage(channelType, channelURL, sendMessageRequest)
+}
+
+func (_c *messageTranslateMessageCall) OnTranslateMessage(channelType ChannelType, channelURL string, messageID int, targetLanguages []string) *messageTranslateMessageCall {
+	return _c.Parent.OnTranslateMessage(channelType, channelURL, messageID, targetLanguages)
+}
+
+func (_c *messageTranslateMessageCall) OnDeleteMessageRaw(channelType interface{}, channelURL interface{}, messageID interface{}) *messageDeleteMessageCall {
+	return _c.Parent.OnDeleteMessageRaw(channelType, channelURL, messageID)
+}
+
+func (_c *messageTranslateMessageCall) OnDeleteMessagesRaw(channelType interface{}, channelURL interface{}, deleteMessagesRequest interface{}) *messageDeleteMessagesCall {
+	return _c.Parent.OnDeleteMessagesRaw(channelType, channelURL, deleteMessagesRequest)
+}
+
+func (_c *messageTranslateMessageCall) OnListMessagesRaw(channelType interface{}, channelURL interface{}, listMessagesRequest interface{}) *messageListMessagesCall {
+	return _c.Parent.OnListMessagesRaw(channelType, channelURL, listMessagesRequest)
+}
+
+func (_c *messageTranslateMessageCall) OnListMessagesStreamRaw(channelType interface{}, channelURL interface{}, listMessagesRequest interface{}, fn interface{}) *messageListMessagesStreamCall {
+	return _c.Parent.OnListMessagesStreamRaw(channelType, channelURL, listMessagesRequest, fn)
+}
+
+func (_c *messageTranslateMessageCall) OnMigrateMessagesRaw(channelURL interface{}, migrateMessagesRequest interface{}) *messageMigrateMessagesCall {
+	return _c.Parent.OnMigrateMessagesRaw(channelURL, migrateMessagesRequest)
+}
+
+func (_c *messageTranslateMessageCall) OnSearchMessagesRaw(searchMessagesRequest interface{}) *messageSearchMessagesCall {
+	return _c.Parent.OnSearchMessagesRaw(searchMessagesRequest)
+}
+
+func (_c *messageTranslateMessageCall) OnSendMessageRaw(channelType interface{}, channelURL interface{}, sendMessageRequest interface{}) *messageSendMessageCall {
+	return _c.Parent.OnSendMessageRaw(channelType, channelURL, sendMessageRequest)
+}
+
+func (_c *messageTranslateMessageCall) OnTranslateMessageRaw(channelType interface{}, channelURL interface{}, messageID interface{}, targetLanguages interface{}) *messageTranslateMessageCall {
+	return _c.Parent.OnTranslateMessageRaw(channelType, channelURL, messageID, targetLanguages)
+}
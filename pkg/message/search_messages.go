@@ -0,0 +1,158 @@
+package message
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// SearchMessagesRequest is the request to search messages.
+type SearchMessagesRequest struct {
+	// Query specifies the keywords to search for. The search is performed on
+	// the message text of text messages - required.
+	Query string
+
+	// Token specifies a page token that indicates the starting index of a chunk
+	// of results. Use the Next value of a previous response to retrieve the
+	// next page.
+	// Optional.
+	Token string
+	// Limit specifies the number of results to return per page. Acceptable
+	// values are 1 to 100, inclusive.
+	// Optional. (Default: 20)
+	Limit *int
+	// ChannelURL restricts the search scope to the messages of the channel with
+	// the specified URL.
+	// Optional.
+	ChannelURL string
+	// CustomType restricts the search scope to the messages of the channels
+	// with the specified custom type.
+	// Optional.
+	CustomType string
+	// MessageTSFrom restricts the search scope to the messages sent after or at
+	// the specified time, in Unix milliseconds.
+	// Optional.
+	MessageTSFrom *int64
+	// MessageTSTo restricts the search scope to the messages sent before or at
+	// the specified time, in Unix milliseconds.
+	// Optional.
+	MessageTSTo *int64
+	// ExactMatch determines whether to search for messages that exactly match
+	// the keywords of the query.
+	// Optional. (Default: false)
+	ExactMatch *bool
+	// AdvancedQuery determines whether to search using the Lucene query syntax,
+	// which supports operators such as AND, OR and wildcards in the query.
+	// Optional. (Default: false)
+	AdvancedQuery *bool
+	// SortField specifies the field to sort the results by. Acceptable values
+	// are SortFieldScore, which sorts by relevance to the query, and
+	// SortFieldTimestamp, which sorts by the time of the message.
+	// Optional. (Default: SortFieldScore)
+	SortField SortField
+}
+
+func (smr *SearchMessagesRequest) Validate() error {
+	if smr.Query == "" {
+		return errors.New("query is required")
+	}
+
+	if smr.MessageTSFrom != nil && smr.MessageTSTo != nil && *smr.MessageTSFrom > *smr.MessageTSTo {
+		return errors.New("message_ts_from must be before message_ts_to")
+	}
+
+	return nil
+}
+
+// SearchMessagesResponse is the response to search messages.
+type SearchMessagesResponse struct {
+	// Results is the list of messages that match the query.
+	Results []MessageResource `json:"results"`
+	// TotalCount is the total number of messages that match the query.
+	TotalCount int `json:"total_count"`
+	// HasNext indicates whether there are more results to retrieve.
+	HasNext bool `json:"has_next"`
+	// Next is the value for the token parameter to retrieve the next page in the
+	// result.
+	Next string `json:"end_cursor"`
+}
+
+func searchMessagesRequestToMap(smr SearchMessagesRequest) map[string]string {
+	m := make(map[string]string)
+
+	m["query"] = smr.Query
+
+	// Optional fields
+	if smr.Token != "" {
+		m["token"] = smr.Token
+	}
+
+	if smr.Limit != nil {
+		m["limit"] = strconv.Itoa(*smr.Limit)
+	}
+
+	if smr.ChannelURL != "" {
+		m["channel_url"] = smr.ChannelURL
+	}
+
+	if smr.CustomType != "" {
+		m["custom_type"] = smr.CustomType
+	}
+
+	if smr.MessageTSFrom != nil {
+		m["message_ts_from"] = strconv.FormatInt(*smr.MessageTSFrom, 10)
+	}
+
+	if smr.MessageTSTo != nil {
+		m["message_ts_to"] = strconv.FormatInt(*smr.MessageTSTo, 10)
+	}
+
+	if smr.ExactMatch != nil {
+		m["exact_match"] = strconv.FormatBool(*smr.ExactMatch)
+	}
+
+	if smr.AdvancedQuery != nil {
+		m["advanced_query"] = strconv.FormatBool(*smr.AdvancedQuery)
+	}
+
+	if smr.SortField != "" {
+		m["sort_field"] = string(smr.SortField)
+	}
+
+	return m
+}
+
+// SearchMessages searches messages across the channels of the application.
+// Use the Next field of the response as the Token of the following request to
+// retrieve the next page.
+// See https://sendbird.com/docs/chat/platform-api/v3/message/search/search-messages
+func (m *message) SearchMessages(ctx context.Context, searchMessagesRequest SearchMessagesRequest) (*SearchMessagesResponse, error) {
+	if err := searchMessagesRequest.Validate(); err != nil {
+		return nil, fmt.Errorf("failed to validate search messages request: %w", err)
+	}
+
+	u := &url.URL{
+		Path: "/search/messages",
+	}
+
+	query := u.Query()
+	for k, v := range searchMessagesRequestToMap(searchMessagesRequest) {
+		query.Set(k, v)
+	}
+
+	u.RawQuery = query.Encode()
+
+	smr, err := m.client.Get(ctx, u.String(), nil, &SearchMessagesResponse{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to search messages: %w", err)
+	}
+
+	searchMessagesResponse, ok := smr.(*SearchMessagesResponse)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast body to SearchMessagesResponse: %+v", smr)
+	}
+
+	return searchMessagesResponse, nil
+}
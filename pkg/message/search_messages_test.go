@@ -0,0 +1,99 @@
+package message
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yumi-ia/sendbird-go/pkg/client"
+)
+
+func TestValidateSearchMR(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		request   SearchMessagesRequest
+		assertErr assert.ErrorAssertionFunc
+	}{
+		{
+			name:      "invalid request - empty query",
+			request:   SearchMessagesRequest{},
+			assertErr: assert.Error,
+		},
+		{
+			name: "invalid request - inverted time range",
+			request: SearchMessagesRequest{
+				Query:         "hello",
+				MessageTSFrom: ptr(int64(43)),
+				MessageTSTo:   ptr(int64(42)),
+			},
+			assertErr: assert.Error,
+		},
+		{
+			name: "valid request",
+			request: SearchMessagesRequest{
+				Query:         "hello",
+				MessageTSFrom: ptr(int64(42)),
+				MessageTSTo:   ptr(int64(43)),
+			},
+			assertErr: assert.NoError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			test.assertErr(t, test.request.Validate())
+		})
+	}
+}
+
+func TestSearchMessages(t *testing.T) {
+	t.Parallel()
+
+	url := "/search/messages"
+	url += "?advanced_query=true"
+	url += "&channel_url=channel-url"
+	url += "&custom_type=custom-type"
+	url += "&exact_match=true"
+	url += "&limit=42"
+	url += "&message_ts_from=43"
+	url += "&message_ts_to=44"
+	url += "&query=hello+world"
+	url += "&sort_field=ts"
+	url += "&token=token"
+
+	searchMessagesRequest := SearchMessagesRequest{
+		Query:         "hello world",
+		Token:         "token",
+		Limit:         ptr(42),
+		ChannelURL:    "channel-url",
+		CustomType:    "custom-type",
+		MessageTSFrom: ptr(int64(43)),
+		MessageTSTo:   ptr(int64(44)),
+		ExactMatch:    ptr(true),
+		AdvancedQuery: ptr(true),
+		SortField:     SortFieldTimestamp,
+	}
+
+	searchMessagesResponse := &SearchMessagesResponse{
+		Results: []MessageResource{{
+			MessageID: 69,
+		}},
+		TotalCount: 1,
+		HasNext:    true,
+		Next:       "next",
+	}
+
+	client := client.NewClientMock(t).
+		OnGet(url, nil, &SearchMessagesResponse{}).TypedReturns(searchMessagesResponse, nil).Once().
+		Parent
+	message := NewMessage(client)
+
+	cur, err := message.SearchMessages(context.Background(), searchMessagesRequest)
+	require.NoError(t, err)
+	assert.Equal(t, searchMessagesResponse, cur)
+}
@@ -115,6 +115,12 @@ type SendMessageRequest struct {
 	// from 0.0 to 1.0, which indicates silent and full volume, respectively.
 	// (Default: 1.0)
 	Volume float32 `json:"volume,omitempty"`
+	// TranslationTargetLanguages specifies an array of one or more language
+	// codes to translate the message into, such as "es" or "zh-CN". The
+	// translations are available in the translations property of the message.
+	// To use this property, the auto-translation feature should be turned on in
+	// Settings > Chat > Features.
+	TranslationTargetLanguages []string `json:"translation_target_languages,omitempty"`
 }
 
 func (smr *SendMessageRequest) Validate() error {
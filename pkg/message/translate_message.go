@@ -0,0 +1,45 @@
+package message
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// translateMessageRequest is the request to translate a message.
+type translateMessageRequest struct {
+	// TargetLanguages specifies an array of one or more language codes to
+	// translate the message into, such as "es" or "zh-CN".
+	TargetLanguages []string `json:"target_langs"`
+}
+
+// TranslateMessageResponse is the response to translate a message.
+type TranslateMessageResponse MessageResource
+
+// TranslateMessage translates an existing message into specific languages.
+// The translations are added to the existing ones in the translations
+// property of the message.
+// See https://sendbird.com/docs/chat/platform-api/v3/message/translation/translate-a-message
+func (m *message) TranslateMessage(ctx context.Context, channelType ChannelType, channelURL string, messageID int, targetLanguages []string) (*TranslateMessageResponse, error) {
+	if len(targetLanguages) == 0 {
+		return nil, errors.New("target languages are required")
+	}
+
+	path := fmt.Sprintf("/%s/%s/messages/%d/translation", channelType, channelURL, messageID)
+
+	req := translateMessageRequest{
+		TargetLanguages: targetLanguages,
+	}
+
+	tmr, err := m.client.Post(ctx, path, req, &TranslateMessageResponse{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to translate message: %w", err)
+	}
+
+	translateMessageResponse, ok := tmr.(*TranslateMessageResponse)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast body to TranslateMessageResponse: %+v", tmr)
+	}
+
+	return translateMessageResponse, nil
+}
@@ -0,0 +1,41 @@
+package message
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yumi-ia/sendbird-go/pkg/client"
+)
+
+func TestTranslateMessage(t *testing.T) {
+	t.Parallel()
+
+	translateMessageResponse := &TranslateMessageResponse{
+		MessageID: 42,
+		Message:   "hello",
+		Translations: map[string]string{
+			"es": "hola",
+			"fr": "bonjour",
+		},
+	}
+
+	client := client.NewClientMock(t).
+		OnPost("/group_channels/channel-url/messages/42/translation", translateMessageRequest{TargetLanguages: []string{"es", "fr"}}, &TranslateMessageResponse{}).TypedReturns(translateMessageResponse, nil).Once().
+		Parent
+	message := NewMessage(client)
+
+	cur, err := message.TranslateMessage(context.Background(), ChannelTypeGroup, "channel-url", 42, []string{"es", "fr"})
+	require.NoError(t, err)
+	assert.Equal(t, translateMessageResponse, cur)
+}
+
+func TestTranslateMessage_noTargetLanguages(t *testing.T) {
+	t.Parallel()
+
+	message := NewMessage(client.NewClientMock(t))
+
+	_, err := message.TranslateMessage(context.Background(), ChannelTypeGroup, "channel-url", 42, nil)
+	require.Error(t, err)
+}
@@ -79,24 +79,32 @@ type MessageEvents struct {
 
 // MessageResource is the resource of a message.
 type MessageResource struct {
-	MessageID            int           `json:"message_id"`
-	Type                 string        `json:"type"`
-	CustomType           string        `json:"custom_type"`
-	ChannelURL           string        `json:"channel_url"`
-	User                 User          `json:"user"`
-	MentionType          string        `json:"mention_type"`
-	MentionedUsers       []User        `json:"mentioned_users"`
-	IsRemoved            bool          `json:"is_removed"`
-	Message              string        `json:"message"`
-	Data                 string        `json:"data"`
-	Poll                 Poll          `json:"poll"`
-	MessageEvents        MessageEvents `json:"message_events"`
-	CreatedAt            int64         `json:"created_at"`
-	UpdatedAt            int           `json:"updated_at"`
-	IsAppleCriticalAlert bool          `json:"is_apple_critical_alert"`
+	MessageID            int               `json:"message_id"`
+	Type                 string            `json:"type"`
+	CustomType           string            `json:"custom_type"`
+	ChannelURL           string            `json:"channel_url"`
+	User                 User              `json:"user"`
+	MentionType          string            `json:"mention_type"`
+	MentionedUsers       []User            `json:"mentioned_users"`
+	IsRemoved            bool              `json:"is_removed"`
+	Message              string            `json:"message"`
+	Data                 string            `json:"data"`
+	Poll                 Poll              `json:"poll"`
+	MessageEvents        MessageEvents     `json:"message_events"`
+	CreatedAt            int64             `json:"created_at"`
+	UpdatedAt            int               `json:"updated_at"`
+	IsAppleCriticalAlert bool              `json:"is_apple_critical_alert"`
+	Translations         map[string]string `json:"translations"`
 }
 
 type MetaArray struct {
 	Key   string   `json:"key"`
 	Value []string `json:"value"`
 }
+
+type SortField string
+
+const (
+	SortFieldScore     SortField = "score"
+	SortFieldTimestamp SortField = "ts"
+)
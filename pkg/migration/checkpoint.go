@@ -0,0 +1,157 @@
+package migration
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// Checkpoint is the progress of the migration of a channel.
+type Checkpoint struct {
+	// Migrated is the number of messages of the channel processed so far, in
+	// timestamp order, including the ones of the failed batches.
+	Migrated int `json:"migrated"`
+	// LastTimestamp is the timestamp of the last processed message, used to
+	// check that a resumed migration is given the same messages.
+	LastTimestamp int64 `json:"last_timestamp"`
+	// Failed are the batches that failed to be migrated with
+	// WithContinueOnError, migrated again by the next run.
+	Failed []FailedBatch `json:"failed,omitempty"`
+}
+
+// FailedBatch is a batch of messages of a checkpoint that failed to be
+// migrated.
+type FailedBatch struct {
+	// Offset is the offset of the first message of the batch in the messages
+	// of the channel, in timestamp order.
+	Offset int `json:"offset"`
+	// Messages is the number of messages of the batch.
+	Messages int `json:"messages"`
+	// FromTimestamp is the timestamp of the first message of the batch.
+	FromTimestamp int64 `json:"from_timestamp"`
+	// ToTimestamp is the timestamp of the last message of the batch.
+	ToTimestamp int64 `json:"to_timestamp"`
+	// Error is the error message of the migration of the batch.
+	Error string `json:"error"`
+}
+
+// CheckpointStore stores the checkpoints of the migrations, so that they can
+// be resumed after a crash.
+type CheckpointStore interface {
+	// Load returns the checkpoint of a channel, or a zero checkpoint if the
+	// migration of the channel has not started.
+	Load(ctx context.Context, channelURL string) (Checkpoint, error)
+	// Save saves the checkpoint of a channel.
+	Save(ctx context.Context, channelURL string, checkpoint Checkpoint) error
+}
+
+// MemoryStore is a CheckpointStore keeping the checkpoints in memory.
+type MemoryStore struct {
+	mu          sync.Mutex
+	checkpoints map[string]Checkpoint
+}
+
+var _ CheckpointStore = (*MemoryStore)(nil)
+
+// NewMemoryStore creates a new in-memory checkpoint store.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{checkpoints: map[string]Checkpoint{}}
+}
+
+// Load returns the checkpoint of a channel.
+func (ms *MemoryStore) Load(_ context.Context, channelURL string) (Checkpoint, error) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	return ms.checkpoints[channelURL], nil
+}
+
+// Save saves the checkpoint of a channel.
+func (ms *MemoryStore) Save(_ context.Context, channelURL string, checkpoint Checkpoint) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	ms.checkpoints[channelURL] = checkpoint
+
+	return nil
+}
+
+// FileStore is a CheckpointStore keeping the checkpoint of each channel in a
+// JSON file of a directory.
+type FileStore struct {
+	dir string
+}
+
+var _ CheckpointStore = (*FileStore)(nil)
+
+// NewFileStore creates a new checkpoint store writing to dir, which is
+// created if needed.
+func NewFileStore(dir string) (*FileStore, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create checkpoint directory: %w", err)
+	}
+
+	return &FileStore{dir: dir}, nil
+}
+
+func (s *FileStore) path(channelURL string) string {
+	return filepath.Join(s.dir, url.PathEscape(channelURL)+".json")
+}
+
+// Load returns the checkpoint of a channel.
+func (s *FileStore) Load(_ context.Context, channelURL string) (Checkpoint, error) {
+	b, err := os.ReadFile(s.path(channelURL))
+	if errors.Is(err, fs.ErrNotExist) {
+		return Checkpoint{}, nil
+	}
+
+	if err != nil {
+		return Checkpoint{}, fmt.Errorf("failed to read checkpoint: %w", err)
+	}
+
+	var checkpoint Checkpoint
+	if err := json.Unmarshal(b, &checkpoint); err != nil {
+		return Checkpoint{}, fmt.Errorf("failed to decode checkpoint: %w", err)
+	}
+
+	return checkpoint, nil
+}
+
+// Save saves the checkpoint of a channel. The file is replaced atomically so
+// that a crash while saving doesn't corrupt it.
+func (s *FileStore) Save(_ context.Context, channelURL string, checkpoint Checkpoint) error {
+	b, err := json.Marshal(checkpoint)
+	if err != nil {
+		return fmt.Errorf("failed to encode checkpoint: %w", err)
+	}
+
+	path := s.path(channelURL)
+
+	tmp, err := os.CreateTemp(s.dir, ".checkpoint-*")
+	if err != nil {
+		return fmt.Errorf("failed to create checkpoint: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(b); err != nil {
+		tmp.Close()
+
+		return fmt.Errorf("failed to write checkpoint: %w", err)
+	}
+
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write checkpoint: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to save checkpoint: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package migration
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileStore(t *testing.T) {
+	t.Parallel()
+
+	dir := filepath.Join(t.TempDir(), "checkpoints")
+
+	store, err := NewFileStore(dir)
+	require.NoError(t, err)
+
+	checkpoint, err := store.Load(context.Background(), "channel/url")
+	require.NoError(t, err)
+	assert.Equal(t, Checkpoint{}, checkpoint)
+
+	require.NoError(t, store.Save(context.Background(), "channel/url", Checkpoint{Migrated: 100, LastTimestamp: 42}))
+
+	expected := Checkpoint{
+		Migrated:      200,
+		LastTimestamp: 43,
+		Failed:        []FailedBatch{{Offset: 100, Messages: 100, FromTimestamp: 42, ToTimestamp: 43, Error: "failed"}},
+	}
+	require.NoError(t, store.Save(context.Background(), "channel/url", expected))
+
+	checkpoint, err = store.Load(context.Background(), "channel/url")
+	require.NoError(t, err)
+	assert.Equal(t, expected, checkpoint)
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "channel%2Furl.json", entries[0].Name())
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "broken.json"), []byte("{"), 0o600))
+
+	_, err = store.Load(context.Background(), "broken")
+	require.ErrorContains(t, err, "failed to decode checkpoint")
+}
+
+func TestMemoryStore(t *testing.T) {
+	t.Parallel()
+
+	store := NewMemoryStore()
+
+	checkpoint, err := store.Load(context.Background(), "channel-url")
+	require.NoError(t, err)
+	assert.Equal(t, Checkpoint{}, checkpoint)
+
+	require.NoError(t, store.Save(context.Background(), "channel-url", Checkpoint{Migrated: 1, LastTimestamp: 1}))
+
+	checkpoint, err = store.Load(context.Background(), "channel-url")
+	require.NoError(t, err)
+	assert.Equal(t, Checkpoint{Migrated: 1, LastTimestamp: 1}, checkpoint)
+}
@@ -0,0 +1,455 @@
+// Package migration package provides a pipeline migrating the history of a
+// chat system to sendbird on top of the migrate messages API. It orders and
+// chunks the messages to the limits of the API, ensures the senders and the
+// channels exist, and checkpoints its progress so that it can be resumed.
+// See https://sendbird.com/docs/chat/platform-api/v3/message/migration/migrate-messages
+package migration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sort"
+
+	"github.com/yumi-ia/sendbird-go/pkg/channel"
+	"github.com/yumi-ia/sendbird-go/pkg/client"
+	"github.com/yumi-ia/sendbird-go/pkg/message"
+	"github.com/yumi-ia/sendbird-go/pkg/user"
+)
+
+// MaxBatchSize is the maximum number of messages migrated per request.
+const MaxBatchSize = 100
+
+const (
+	// maxGroupChannelMembers is the member limit of a group channel, above
+	// which a supergroup channel is created instead.
+	maxGroupChannelMembers = 100
+	// maxSuperGroupChannelMembers is the member limit of a supergroup channel.
+	maxSuperGroupChannelMembers = 2000
+)
+
+// ErrCheckpointMismatch is returned when resuming a migration with messages
+// not matching its checkpoint.
+var ErrCheckpointMismatch = errors.New("checkpoint doesn't match the messages")
+
+// Pipeline migrates the messages of channels, one channel at a time.
+type Pipeline interface {
+	// Run migrates the messages of a channel, resuming from its checkpoint.
+	// It stops at the first failed batch and returns its error along with the
+	// report, unless WithContinueOnError is set: the failed batches are then
+	// listed in the report and in the checkpoint, to be migrated again by the
+	// next run, and Report.Err must be checked.
+	Run(ctx context.Context, channelURL string, messages []message.MigrationMessage) (*Report, error)
+}
+
+// pipeline is the implementation of the Pipeline interface.
+type pipeline struct {
+	// logger is the logger of the pipeline.
+	logger *slog.Logger
+	// message is the service migrating the messages.
+	message message.Message
+	// store is the store of the checkpoints.
+	store CheckpointStore
+	// user is the service creating the missing users, if any.
+	user user.User
+	// newUser returns the request creating a missing user.
+	newUser func(userID string) user.CreateUserRequest
+	// channel is the service creating the missing channels, if any.
+	channel channel.Channel
+	// newChannel returns the request creating a missing channel.
+	newChannel func(channelURL string, userIDs []string) channel.CreateGroupChannelRequest
+	// batchSize is the number of messages migrated per request.
+	batchSize int
+	// updateReadTS is passed to every migrate messages request.
+	updateReadTS bool
+	// continueOnError determines whether to migrate the next batches after a
+	// failed one.
+	continueOnError bool
+}
+
+// Option is the interface for the options of the pipeline.
+type Option func(pipeline *pipeline) *pipeline
+
+// WithLogger is the option for the logger of the pipeline.
+func WithLogger(l *slog.Logger) Option {
+	return func(pipeline *pipeline) *pipeline {
+		pipeline.logger = l
+
+		return pipeline
+	}
+}
+
+// WithUsers is the option to create the senders and mentioned users missing
+// before migrating the messages. newUser returns the request creating a user;
+// if nil, the user is created with its ID as nickname.
+func WithUsers(u user.User, newUser func(userID string) user.CreateUserRequest) Option {
+	return func(pipeline *pipeline) *pipeline {
+		pipeline.user = u
+		if newUser != nil {
+			pipeline.newUser = newUser
+		}
+
+		return pipeline
+	}
+}
+
+// WithChannels is the option to create the channel if missing before
+// migrating its messages. newChannel returns the request creating the channel
+// from its URL and the users of its messages left to migrate; if nil, a group
+// channel is created with these users as members, as a supergroup channel
+// above 100 members and with only the first 2000 users above the member limit
+// of a supergroup channel.
+func WithChannels(c channel.Channel, newChannel func(channelURL string, userIDs []string) channel.CreateGroupChannelRequest) Option {
+	return func(pipeline *pipeline) *pipeline {
+		pipeline.channel = c
+		if newChannel != nil {
+			pipeline.newChannel = newChannel
+		}
+
+		return pipeline
+	}
+}
+
+// WithBatchSize is the option for the number of messages migrated per
+// request, up to MaxBatchSize. (Default: MaxBatchSize)
+func WithBatchSize(size int) Option {
+	return func(pipeline *pipeline) *pipeline {
+		pipeline.batchSize = max(1, min(size, MaxBatchSize))
+
+		return pipeline
+	}
+}
+
+// WithUpdateReadTS is the option for the UpdateReadTS of the migrate messages
+// requests. (Default: false)
+func WithUpdateReadTS(updateReadTS bool) Option {
+	return func(pipeline *pipeline) *pipeline {
+		pipeline.updateReadTS = updateReadTS
+
+		return pipeline
+	}
+}
+
+// WithContinueOnError is the option to migrate the next batches after a failed
+// one. The checkpoint then moves past the failed batches, which are reported
+// and recorded in the checkpoint to be migrated again by the next run.
+// (Default: false, the migration stops at the first failed batch)
+func WithContinueOnError(continueOnError bool) Option {
+	return func(pipeline *pipeline) *pipeline {
+		pipeline.continueOnError = continueOnError
+
+		return pipeline
+	}
+}
+
+// NewPipeline creates a new migration pipeline migrating the messages with m
+// and checkpointing its progress to store.
+func NewPipeline(m message.Message, store CheckpointStore, opts ...Option) Pipeline {
+	p := &pipeline{
+		logger:     slog.Default(),
+		message:    m,
+		store:      store,
+		newUser:    defaultNewUser,
+		newChannel: defaultNewChannel,
+		batchSize:  MaxBatchSize,
+	}
+
+	for _, opt := range opts {
+		p = opt(p)
+	}
+
+	return p
+}
+
+func defaultNewUser(userID string) user.CreateUserRequest {
+	return user.CreateUserRequest{UserID: userID, Nickname: userID}
+}
+
+func defaultNewChannel(channelURL string, userIDs []string) channel.CreateGroupChannelRequest {
+	return channel.CreateGroupChannelRequest{
+		ChannelURL: channelURL,
+		UserIDs:    userIDs[:min(len(userIDs), maxSuperGroupChannelMembers)],
+		IsSuper:    len(userIDs) > maxGroupChannelMembers,
+	}
+}
+
+// BatchFailure is a batch of messages that failed to be migrated.
+type BatchFailure struct {
+	// Offset is the offset of the first message of the batch in the messages
+	// of the channel, in timestamp order.
+	Offset int
+	// FromTimestamp is the timestamp of the first message of the batch.
+	FromTimestamp int64
+	// ToTimestamp is the timestamp of the last message of the batch.
+	ToTimestamp int64
+	// Messages is the number of messages of the batch.
+	Messages int
+	// Err is the error of the migration of the batch.
+	Err error
+}
+
+// Report is the report of the migration of a channel.
+type Report struct {
+	// ChannelURL is the URL of the migrated channel.
+	ChannelURL string
+	// Total is the number of messages of the channel.
+	Total int
+	// Resumed is the number of messages skipped as already migrated by a
+	// previous run.
+	Resumed int
+	// Migrated is the number of messages migrated by this run.
+	Migrated int
+	// Batches is the number of batches sent by this run.
+	Batches int
+	// Failures are the batches that failed to be migrated.
+	Failures []BatchFailure
+}
+
+// Err returns the errors of the failed batches joined, or nil if every batch
+// succeeded.
+func (r *Report) Err() error {
+	errs := make([]error, 0, len(r.Failures))
+
+	for _, failure := range r.Failures {
+		errs = append(errs, fmt.Errorf("batch at offset %d (timestamps %d to %d): %w",
+			failure.Offset, failure.FromTimestamp, failure.ToTimestamp, failure.Err))
+	}
+
+	return errors.Join(errs...)
+}
+
+// Run migrates the messages of a channel. The messages are ordered by
+// timestamp and migrated in batches, the checkpoint of the channel being
+// saved after each batch. When a checkpoint exists, the messages it covers are
+// skipped; the same messages must then be given again. The failed batches
+// recorded in the checkpoint are migrated again first.
+//
+// An error is returned when the migration can't start or can't be
+// checkpointed, and, unless WithContinueOnError is set, when a batch fails.
+// The failed batches are reported in the returned report.
+//
+// The checkpoint is saved once a batch is migrated: if the process stops, or
+// the checkpoint fails to be saved, in between, the batch is migrated again,
+// and duplicated, by the next run.
+func (p *pipeline) Run(ctx context.Context, channelURL string, messages []message.MigrationMessage) (*Report, error) {
+	if channelURL == "" {
+		return nil, errors.New("channel URL is required")
+	}
+
+	report := &Report{ChannelURL: channelURL, Total: len(messages)}
+	if len(messages) == 0 {
+		return report, nil
+	}
+
+	mmr := message.MigrateMessagesRequest{Messages: messages}
+	if err := mmr.Validate(); err != nil {
+		return nil, fmt.Errorf("failed to validate messages: %w", err)
+	}
+
+	messages = slices.Clone(messages)
+	sort.SliceStable(messages, func(i, j int) bool {
+		return messages[i].Timestamp < messages[j].Timestamp
+	})
+
+	checkpoint, err := p.store.Load(ctx, channelURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load checkpoint: %w", err)
+	}
+
+	if err := checkCheckpoint(checkpoint, messages); err != nil {
+		return nil, err
+	}
+
+	failed := checkpoint.Failed
+	remaining := messages[checkpoint.Migrated:]
+
+	var pending []message.MigrationMessage
+	for _, batch := range failed {
+		pending = append(pending, messages[batch.Offset:batch.Offset+batch.Messages]...)
+	}
+
+	report.Resumed = checkpoint.Migrated - len(pending)
+	pending = append(pending, remaining...)
+
+	logger := p.logger.With("channel_url", channelURL)
+
+	if len(pending) == 0 {
+		logger.Debug("migration already done")
+
+		return report, nil
+	}
+
+	userIDs := messageUserIDs(pending)
+
+	if err := p.ensureUsers(ctx, userIDs); err != nil {
+		return nil, err
+	}
+
+	if err := p.ensureChannel(ctx, channelURL, userIDs); err != nil {
+		return nil, err
+	}
+
+	// The batches failed by the previous runs are kept in the checkpoint until
+	// they are migrated.
+	checkpoint.Failed = nil
+
+	for i, batch := range failed {
+		err := p.migrateBatch(ctx, channelURL, batch.Offset, messages[batch.Offset:batch.Offset+batch.Messages], report)
+		if err != nil {
+			logger.Warn("failed to migrate failed batch again", "offset", batch.Offset, "error", err)
+
+			checkpoint.Failed = append(checkpoint.Failed, batch)
+
+			if !p.continueOnError || ctx.Err() != nil {
+				return report, report.Err()
+			}
+
+			continue
+		}
+
+		saved := checkpoint
+		saved.Failed = append(slices.Clip(checkpoint.Failed), failed[i+1:]...)
+
+		if err := p.store.Save(ctx, channelURL, saved); err != nil {
+			return report, fmt.Errorf("failed to save checkpoint: %w", err)
+		}
+	}
+
+	for start := 0; start < len(remaining); start += p.batchSize {
+		batch := remaining[start:min(start+p.batchSize, len(remaining))]
+		offset := checkpoint.Migrated
+		last := batch[len(batch)-1]
+
+		if err := p.migrateBatch(ctx, channelURL, offset, batch, report); err != nil {
+			logger.Warn("failed to migrate batch", "offset", offset, "error", err)
+
+			if !p.continueOnError || ctx.Err() != nil {
+				return report, report.Err()
+			}
+
+			checkpoint.Failed = append(slices.Clip(checkpoint.Failed), FailedBatch{
+				Offset:        offset,
+				Messages:      len(batch),
+				FromTimestamp: batch[0].Timestamp,
+				ToTimestamp:   last.Timestamp,
+				Error:         err.Error(),
+			})
+		}
+
+		checkpoint.Migrated += len(batch)
+		checkpoint.LastTimestamp = last.Timestamp
+
+		if err := p.store.Save(ctx, channelURL, checkpoint); err != nil {
+			return report, fmt.Errorf("failed to save checkpoint: %w", err)
+		}
+	}
+
+	logger.Debug("migration done", "migrated", report.Migrated, "failures", len(report.Failures))
+
+	return report, nil
+}
+
+// migrateBatch migrates a batch of messages starting at offset and records it
+// in the report.
+func (p *pipeline) migrateBatch(ctx context.Context, channelURL string, offset int, batch []message.MigrationMessage, report *Report) error {
+	err := p.message.MigrateMessages(ctx, channelURL, message.MigrateMessagesRequest{
+		Messages:     batch,
+		UpdateReadTS: p.updateReadTS,
+	})
+
+	report.Batches++
+
+	if err != nil {
+		report.Failures = append(report.Failures, BatchFailure{
+			Offset:        offset,
+			FromTimestamp: batch[0].Timestamp,
+			ToTimestamp:   batch[len(batch)-1].Timestamp,
+			Messages:      len(batch),
+			Err:           err,
+		})
+
+		return err
+	}
+
+	report.Migrated += len(batch)
+
+	return nil
+}
+
+// checkCheckpoint checks that the checkpoint, and its failed batches, cover
+// the first messages.
+func checkCheckpoint(checkpoint Checkpoint, messages []message.MigrationMessage) error {
+	switch {
+	case checkpoint.Migrated < 0 || checkpoint.Migrated > len(messages):
+		return fmt.Errorf("%w: %d messages migrated out of %d", ErrCheckpointMismatch, checkpoint.Migrated, len(messages))
+	case checkpoint.Migrated > 0 && messages[checkpoint.Migrated-1].Timestamp != checkpoint.LastTimestamp:
+		return fmt.Errorf("%w: last migrated message at %d, got %d", ErrCheckpointMismatch,
+			checkpoint.LastTimestamp, messages[checkpoint.Migrated-1].Timestamp)
+	}
+
+	for _, batch := range checkpoint.Failed {
+		end := batch.Offset + batch.Messages
+		if batch.Offset < 0 || batch.Messages <= 0 || end > checkpoint.Migrated ||
+			messages[batch.Offset].Timestamp != batch.FromTimestamp || messages[end-1].Timestamp != batch.ToTimestamp {
+			return fmt.Errorf("%w: failed batch at offset %d", ErrCheckpointMismatch, batch.Offset)
+		}
+	}
+
+	return nil
+}
+
+// messageUserIDs returns the sorted IDs of the senders and mentioned users of
+// the messages.
+func messageUserIDs(messages []message.MigrationMessage) []string {
+	var userIDs []string
+
+	for _, m := range messages {
+		// Admin messages have no sender.
+		if m.UserID != "" {
+			userIDs = append(userIDs, m.UserID)
+		}
+
+		userIDs = append(userIDs, m.MentionUserIDs...)
+	}
+
+	slices.Sort(userIDs)
+
+	return slices.Compact(userIDs)
+}
+
+func (p *pipeline) ensureUsers(ctx context.Context, userIDs []string) error {
+	if p.user == nil {
+		return nil
+	}
+
+	createUserRequests := make([]user.CreateUserRequest, len(userIDs))
+	for i, userID := range userIDs {
+		createUserRequests[i] = p.newUser(userID)
+	}
+
+	report, err := user.CreateUsers(ctx, p.user, createUserRequests, user.BatchOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to create users: %w", err)
+	}
+
+	if err := report.Err(); err != nil {
+		return fmt.Errorf("failed to create users: %w", err)
+	}
+
+	return nil
+}
+
+func (p *pipeline) ensureChannel(ctx context.Context, channelURL string, userIDs []string) error {
+	if p.channel == nil {
+		return nil
+	}
+
+	_, err := p.channel.CreateGroupChannel(ctx, p.newChannel(channelURL, userIDs))
+	if err != nil && !errors.Is(err, client.ErrResourceAlreadyExists) {
+		return fmt.Errorf("failed to create channel: %w", err)
+	}
+
+	return nil
+}
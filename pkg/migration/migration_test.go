@@ -0,0 +1,356 @@
+package migration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/yumi-ia/sendbird-go/pkg/channel"
+	"github.com/yumi-ia/sendbird-go/pkg/client"
+	"github.com/yumi-ia/sendbird-go/pkg/message"
+	"github.com/yumi-ia/sendbird-go/pkg/user"
+)
+
+func textMessages(n int) []message.MigrationMessage {
+	messages := make([]message.MigrationMessage, n)
+	for i := range messages {
+		// In reverse order, the pipeline sorts them.
+		messages[i] = message.MigrationMessage{
+			UserID:      fmt.Sprintf("user-%d", i%2),
+			MessageType: message.MessageTypeText,
+			Message:     fmt.Sprintf("message %d", n-i),
+			Timestamp:   int64(n - i),
+		}
+	}
+
+	return messages
+}
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	messages := textMessages(5)
+
+	var migrated [][]int64
+
+	m := message.NewMessageMock(t).
+		OnMigrateMessagesRaw("channel-url", mock.Anything).
+		TypedRun(func(_ string, mmr message.MigrateMessagesRequest) {
+			var timestamps []int64
+			for _, m := range mmr.Messages {
+				timestamps = append(timestamps, m.Timestamp)
+			}
+
+			migrated = append(migrated, timestamps)
+			assert.True(t, mmr.UpdateReadTS)
+		}).
+		TypedReturns(nil).Times(3).
+		Parent
+
+	u := user.NewUserMock(t).
+		OnCreateUser(user.CreateUserRequest{UserID: "user-0", Nickname: "user-0"}).TypedReturns(&user.CreateUserResponse{}, nil).Once().
+		OnCreateUser(user.CreateUserRequest{UserID: "user-1", Nickname: "user-1"}).
+		TypedReturns(nil, fmt.Errorf("failed to create user: %w", client.ErrResourceAlreadyExists)).Once().
+		Parent
+
+	c := channel.NewChannelMock(t).
+		OnCreateGroupChannel(channel.CreateGroupChannelRequest{ChannelURL: "channel-url", UserIDs: []string{"user-0", "user-1"}}).
+		TypedReturns(nil, fmt.Errorf("failed to create channel: %w", client.ErrResourceAlreadyExists)).Once().
+		Parent
+
+	store := NewMemoryStore()
+
+	p := NewPipeline(m, store,
+		WithUsers(u, nil),
+		WithChannels(c, nil),
+		WithBatchSize(2),
+		WithUpdateReadTS(true),
+	)
+
+	report, err := p.Run(context.Background(), "channel-url", messages)
+	require.NoError(t, err)
+	require.NoError(t, report.Err())
+
+	assert.Equal(t, &Report{ChannelURL: "channel-url", Total: 5, Migrated: 5, Batches: 3}, report)
+	assert.Equal(t, [][]int64{{1, 2}, {3, 4}, {5}}, migrated)
+
+	checkpoint, err := store.Load(context.Background(), "channel-url")
+	require.NoError(t, err)
+	assert.Equal(t, Checkpoint{Migrated: 5, LastTimestamp: 5}, checkpoint)
+
+	// Running again migrates nothing.
+	report, err = p.Run(context.Background(), "channel-url", messages)
+	require.NoError(t, err)
+	assert.Equal(t, &Report{ChannelURL: "channel-url", Total: 5, Resumed: 5}, report)
+}
+
+func TestRun_resume(t *testing.T) {
+	t.Parallel()
+
+	messages := textMessages(250)
+	errMigrate := errors.New("failed to migrate messages")
+
+	store := NewMemoryStore()
+
+	m := message.NewMessageMock(t).
+		OnMigrateMessagesRaw("channel-url", mock.Anything).TypedReturns(nil).Once().
+		OnMigrateMessagesRaw("channel-url", mock.Anything).TypedReturns(errMigrate).Once().
+		Parent
+
+	report, err := NewPipeline(m, store).Run(context.Background(), "channel-url", messages)
+	require.ErrorIs(t, err, errMigrate)
+	assert.Equal(t, 100, report.Migrated)
+	assert.Equal(t, 2, report.Batches)
+	require.Len(t, report.Failures, 1)
+	assert.Equal(t, BatchFailure{Offset: 100, FromTimestamp: 101, ToTimestamp: 200, Messages: 100, Err: errMigrate}, report.Failures[0])
+	require.ErrorIs(t, report.Err(), errMigrate)
+
+	m = message.NewMessageMock(t).
+		OnMigrateMessagesRaw("channel-url", mock.Anything).
+		TypedRun(func(_ string, mmr message.MigrateMessagesRequest) {
+			assert.Equal(t, int64(101), mmr.Messages[0].Timestamp)
+		}).
+		TypedReturns(nil).Once().
+		OnMigrateMessagesRaw("channel-url", mock.Anything).
+		TypedRun(func(_ string, mmr message.MigrateMessagesRequest) {
+			assert.Len(t, mmr.Messages, 50)
+		}).
+		TypedReturns(nil).Once().
+		Parent
+
+	report, err = NewPipeline(m, store).Run(context.Background(), "channel-url", messages)
+	require.NoError(t, err)
+	assert.Equal(t, &Report{ChannelURL: "channel-url", Total: 250, Resumed: 100, Migrated: 150, Batches: 2}, report)
+}
+
+func TestRun_continueOnError(t *testing.T) {
+	t.Parallel()
+
+	errMigrate := errors.New("failed to migrate messages")
+
+	m := message.NewMessageMock(t).
+		OnMigrateMessagesRaw("channel-url", mock.Anything).TypedReturns(errMigrate).Once().
+		OnMigrateMessagesRaw("channel-url", mock.Anything).TypedReturns(nil).Once().
+		Parent
+
+	store := NewMemoryStore()
+
+	report, err := NewPipeline(m, store, WithBatchSize(1), WithContinueOnError(true)).
+		Run(context.Background(), "channel-url", textMessages(2))
+	require.NoError(t, err)
+	assert.Equal(t, 1, report.Migrated)
+	assert.Equal(t, 2, report.Batches)
+	require.Len(t, report.Failures, 1)
+	assert.Equal(t, int64(1), report.Failures[0].FromTimestamp)
+
+	checkpoint, err := store.Load(context.Background(), "channel-url")
+	require.NoError(t, err)
+	assert.Equal(t, Checkpoint{
+		Migrated:      2,
+		LastTimestamp: 2,
+		Failed: []FailedBatch{
+			{Offset: 0, Messages: 1, FromTimestamp: 1, ToTimestamp: 1, Error: "failed to migrate messages"},
+		},
+	}, checkpoint)
+
+	// The next run migrates the failed batch again.
+	m = message.NewMessageMock(t).
+		OnMigrateMessagesRaw("channel-url", mock.Anything).
+		TypedRun(func(_ string, mmr message.MigrateMessagesRequest) {
+			require.Len(t, mmr.Messages, 1)
+			assert.Equal(t, int64(1), mmr.Messages[0].Timestamp)
+		}).
+		TypedReturns(nil).Once().
+		Parent
+
+	report, err = NewPipeline(m, store, WithBatchSize(1), WithContinueOnError(true)).
+		Run(context.Background(), "channel-url", textMessages(2))
+	require.NoError(t, err)
+	assert.Equal(t, &Report{ChannelURL: "channel-url", Total: 2, Resumed: 1, Migrated: 1, Batches: 1}, report)
+
+	checkpoint, err = store.Load(context.Background(), "channel-url")
+	require.NoError(t, err)
+	assert.Equal(t, Checkpoint{Migrated: 2, LastTimestamp: 2}, checkpoint)
+}
+
+func TestRun_failedBatchesAgain(t *testing.T) {
+	t.Parallel()
+
+	errMigrate := errors.New("failed to migrate messages")
+
+	store := NewMemoryStore()
+	require.NoError(t, store.Save(context.Background(), "channel-url", Checkpoint{
+		Migrated:      3,
+		LastTimestamp: 3,
+		Failed: []FailedBatch{
+			{Offset: 0, Messages: 1, FromTimestamp: 1, ToTimestamp: 1, Error: "failed"},
+			{Offset: 2, Messages: 1, FromTimestamp: 3, ToTimestamp: 3, Error: "failed"},
+		},
+	}))
+
+	// The first failed batch fails again and stops the migration.
+	m := message.NewMessageMock(t).
+		OnMigrateMessagesRaw("channel-url", mock.Anything).TypedReturns(errMigrate).Once().
+		Parent
+
+	report, err := NewPipeline(m, store).Run(context.Background(), "channel-url", textMessages(4))
+	require.ErrorIs(t, err, errMigrate)
+	assert.Equal(t, 1, report.Resumed)
+	assert.Equal(t, []BatchFailure{
+		{Offset: 0, FromTimestamp: 1, ToTimestamp: 1, Messages: 1, Err: errMigrate},
+	}, report.Failures)
+
+	checkpoint, err := store.Load(context.Background(), "channel-url")
+	require.NoError(t, err)
+	assert.Len(t, checkpoint.Failed, 2)
+
+	// The first failed batch fails again, the others are migrated.
+	m = message.NewMessageMock(t).
+		OnMigrateMessagesRaw("channel-url", mock.Anything).TypedReturns(errMigrate).Once().
+		OnMigrateMessagesRaw("channel-url", mock.Anything).TypedReturns(nil).Twice().
+		Parent
+
+	report, err = NewPipeline(m, store, WithContinueOnError(true)).Run(context.Background(), "channel-url", textMessages(4))
+	require.NoError(t, err)
+	assert.Equal(t, 2, report.Migrated)
+	assert.Equal(t, 3, report.Batches)
+	require.ErrorIs(t, report.Err(), errMigrate)
+
+	checkpoint, err = store.Load(context.Background(), "channel-url")
+	require.NoError(t, err)
+	assert.Equal(t, Checkpoint{
+		Migrated:      4,
+		LastTimestamp: 4,
+		Failed: []FailedBatch{
+			{Offset: 0, Messages: 1, FromTimestamp: 1, ToTimestamp: 1, Error: "failed"},
+		},
+	}, checkpoint)
+}
+
+func TestRun_remainingUsers(t *testing.T) {
+	t.Parallel()
+
+	store := NewMemoryStore()
+	require.NoError(t, store.Save(context.Background(), "channel-url", Checkpoint{Migrated: 1, LastTimestamp: 1}))
+
+	m := message.NewMessageMock(t).
+		OnMigrateMessagesRaw("channel-url", mock.Anything).TypedReturns(nil).Once().
+		Parent
+
+	// Only user-0 sent a message left to migrate.
+	u := user.NewUserMock(t).
+		OnCreateUser(user.CreateUserRequest{UserID: "user-0", Nickname: "user-0"}).TypedReturns(&user.CreateUserResponse{}, nil).Once().
+		Parent
+
+	c := channel.NewChannelMock(t).
+		OnCreateGroupChannel(channel.CreateGroupChannelRequest{ChannelURL: "channel-url", UserIDs: []string{"user-0"}}).
+		TypedReturns(&channel.CreateGroupChannelResponse{}, nil).Once().
+		Parent
+
+	report, err := NewPipeline(m, store, WithUsers(u, nil), WithChannels(c, nil)).
+		Run(context.Background(), "channel-url", textMessages(2))
+	require.NoError(t, err)
+	assert.Equal(t, &Report{ChannelURL: "channel-url", Total: 2, Resumed: 1, Migrated: 1, Batches: 1}, report)
+}
+
+func TestRun_errors(t *testing.T) {
+	t.Parallel()
+
+	store := NewMemoryStore()
+	require.NoError(t, store.Save(context.Background(), "mismatch", Checkpoint{Migrated: 1, LastTimestamp: 42}))
+	require.NoError(t, store.Save(context.Background(), "too-far", Checkpoint{Migrated: 3, LastTimestamp: 3}))
+	require.NoError(t, store.Save(context.Background(), "failed-mismatch", Checkpoint{
+		Migrated:      2,
+		LastTimestamp: 2,
+		Failed:        []FailedBatch{{Offset: 1, Messages: 1, FromTimestamp: 42, ToTimestamp: 42}},
+	}))
+
+	p := NewPipeline(message.NewMessageMock(t), store)
+
+	_, err := p.Run(context.Background(), "", textMessages(1))
+	require.ErrorContains(t, err, "channel URL is required")
+
+	_, err = p.Run(context.Background(), "channel-url", []message.MigrationMessage{{UserID: "user"}})
+	require.ErrorContains(t, err, "failed to validate messages")
+
+	_, err = p.Run(context.Background(), "mismatch", textMessages(2))
+	require.ErrorIs(t, err, ErrCheckpointMismatch)
+
+	_, err = p.Run(context.Background(), "too-far", textMessages(2))
+	require.ErrorIs(t, err, ErrCheckpointMismatch)
+
+	_, err = p.Run(context.Background(), "failed-mismatch", textMessages(2))
+	require.ErrorIs(t, err, ErrCheckpointMismatch)
+
+	report, err := p.Run(context.Background(), "empty", nil)
+	require.NoError(t, err)
+	assert.Equal(t, &Report{ChannelURL: "empty"}, report)
+}
+
+func TestRun_ensureErrors(t *testing.T) {
+	t.Parallel()
+
+	errAPI := fmt.Errorf("failed: %w", client.ErrInvalidValue)
+
+	u := user.NewUserMock(t).
+		OnCreateUserRaw(mock.Anything).TypedReturns(nil, errAPI).Once().
+		Parent
+
+	_, err := NewPipeline(message.NewMessageMock(t), NewMemoryStore(), WithUsers(u, nil)).
+		Run(context.Background(), "channel-url", textMessages(1))
+	require.ErrorContains(t, err, "failed to create users")
+	require.ErrorIs(t, err, client.ErrInvalidValue)
+
+	c := channel.NewChannelMock(t).
+		OnCreateGroupChannelRaw(mock.Anything).TypedReturns(nil, errAPI).Once().
+		Parent
+
+	_, err = NewPipeline(message.NewMessageMock(t), NewMemoryStore(), WithChannels(c, nil)).
+		Run(context.Background(), "channel-url", textMessages(1))
+	require.ErrorContains(t, err, "failed to create channel")
+}
+
+func TestMessageUserIDs(t *testing.T) {
+	t.Parallel()
+
+	userIDs := messageUserIDs([]message.MigrationMessage{
+		{UserID: "user-1", MessageType: message.MessageTypeText, MentionUserIDs: []string{"user-2"}},
+		{MessageType: message.MessageTypeAdminMessage},
+		{UserID: "user-0", MessageType: message.MessageTypeFile, MentionUserIDs: []string{"user-1"}},
+	})
+	assert.Equal(t, []string{"user-0", "user-1", "user-2"}, userIDs)
+}
+
+func TestDefaultNewChannel(t *testing.T) {
+	t.Parallel()
+
+	userIDs := make([]string, 3000)
+	for i := range userIDs {
+		userIDs[i] = fmt.Sprintf("user-%d", i)
+	}
+
+	cgcr := defaultNewChannel("channel-url", userIDs[:100])
+	assert.Len(t, cgcr.UserIDs, 100)
+	assert.False(t, cgcr.IsSuper)
+
+	cgcr = defaultNewChannel("channel-url", userIDs[:101])
+	assert.Len(t, cgcr.UserIDs, 101)
+	assert.True(t, cgcr.IsSuper)
+
+	cgcr = defaultNewChannel("channel-url", userIDs)
+	assert.Equal(t, userIDs[:2000], cgcr.UserIDs)
+	assert.True(t, cgcr.IsSuper)
+}
+
+func TestWithBatchSize(t *testing.T) {
+	t.Parallel()
+
+	p := &pipeline{}
+	assert.Equal(t, MaxBatchSize, WithBatchSize(1000)(p).batchSize)
+	assert.Equal(t, 1, WithBatchSize(0)(p).batchSize)
+	assert.Equal(t, 42, WithBatchSize(42)(p).batchSize)
+}
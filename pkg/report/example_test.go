@@ -0,0 +1,19 @@
+package report_test
+
+import (
+	"github.com/yumi-ia/sendbird-go/pkg/client"
+	"github.com/yumi-ia/sendbird-go/pkg/report"
+)
+
+func ExampleNewReport() {
+	// Initialize a client.
+	opts := []client.Option{}
+	c := client.NewClient(opts...)
+
+	// Initialize a report service.
+	r := report.NewReport(c)
+
+	// the report client is ready to be used.
+	_ = r
+	// r.DoWork()
+}
@@ -0,0 +1,89 @@
+package report
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/yumi-ia/sendbird-go/pkg/message"
+)
+
+// ListReportsRequest is the request to list the reports on a resource.
+type ListReportsRequest struct {
+	// Token specifies a page token that indicates the starting index of a chunk
+	// of results. If not specified, the index is set as 0.
+	// Optional.
+	Token string
+	// Limit specifies the number of results to return per page. Acceptable
+	// values are 1 to 100, inclusive. (Default: 10)
+	// Optional.
+	Limit *int
+}
+
+// ListReportsResponse is the response to list the reports on a resource.
+type ListReportsResponse struct {
+	// ReportLogs is the list of reports on the resource.
+	ReportLogs []ReportResource `json:"report_logs"`
+	// Next is the value for the token parameter to retrieve the next page in the
+	// result.
+	Next string `json:"next"`
+}
+
+func listReportsRequestToMap(lrr ListReportsRequest) map[string]string {
+	m := make(map[string]string)
+
+	if lrr.Token != "" {
+		m["token"] = lrr.Token
+	}
+
+	if lrr.Limit != nil {
+		m["limit"] = strconv.Itoa(*lrr.Limit)
+	}
+
+	return m
+}
+
+func (r *report) listReports(ctx context.Context, path string, listReportsRequest ListReportsRequest) (*ListReportsResponse, error) {
+	u, err := url.Parse(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse URL: %w", err)
+	}
+
+	query := u.Query()
+	for k, v := range listReportsRequestToMap(listReportsRequest) {
+		query.Set(k, v)
+	}
+
+	u.RawQuery = query.Encode()
+
+	lrr, err := r.client.Get(ctx, u.String(), nil, &ListReportsResponse{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list reports: %w", err)
+	}
+
+	listReportsResponse, ok := lrr.(*ListReportsResponse)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast body to ListReportsResponse: %+v", lrr)
+	}
+
+	return listReportsResponse, nil
+}
+
+// ListMessageReports lists the reports on a message.
+// See https://sendbird.com/docs/chat/platform-api/v3/report/reporting-a-message/list-reports-on-a-message
+func (r *report) ListMessageReports(ctx context.Context, channelType message.ChannelType, channelURL string, messageID int, listReportsRequest ListReportsRequest) (*ListReportsResponse, error) {
+	return r.listReports(ctx, fmt.Sprintf("/report/%s/%s/messages/%d", channelType, channelURL, messageID), listReportsRequest)
+}
+
+// ListUserReports lists the reports on a user.
+// See https://sendbird.com/docs/chat/platform-api/v3/report/reporting-a-user/list-reports-on-a-user
+func (r *report) ListUserReports(ctx context.Context, offendingUserID string, listReportsRequest ListReportsRequest) (*ListReportsResponse, error) {
+	return r.listReports(ctx, "/report/users/"+offendingUserID, listReportsRequest)
+}
+
+// ListChannelReports lists the reports on a channel.
+// See https://sendbird.com/docs/chat/platform-api/v3/report/reporting-a-channel/list-reports-on-a-channel
+func (r *report) ListChannelReports(ctx context.Context, channelType message.ChannelType, channelURL string, listReportsRequest ListReportsRequest) (*ListReportsResponse, error) {
+	return r.listReports(ctx, fmt.Sprintf("/report/%s/%s", channelType, channelURL), listReportsRequest)
+}
@@ -0,0 +1,50 @@
+package report
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yumi-ia/sendbird-go/pkg/client"
+	"github.com/yumi-ia/sendbird-go/pkg/message"
+)
+
+func ptr[T any](t T) *T {
+	return &t
+}
+
+func TestListReports(t *testing.T) {
+	t.Parallel()
+
+	listReportsRequest := ListReportsRequest{
+		Token: "token",
+		Limit: ptr(42),
+	}
+
+	listReportsResponse := &ListReportsResponse{
+		ReportLogs: []ReportResource{{
+			ReportCategory: ReportCategorySpam,
+		}},
+		Next: "next",
+	}
+
+	client := client.NewClientMock(t).
+		OnGet("/report/group_channels/channel-url/messages/42?limit=42&token=token", nil, &ListReportsResponse{}).TypedReturns(listReportsResponse, nil).Once().
+		OnGet("/report/users/offending-user-id?limit=42&token=token", nil, &ListReportsResponse{}).TypedReturns(listReportsResponse, nil).Once().
+		OnGet("/report/group_channels/channel-url?limit=42&token=token", nil, &ListReportsResponse{}).TypedReturns(listReportsResponse, nil).Once().
+		Parent
+	report := NewReport(client)
+
+	cur, err := report.ListMessageReports(context.Background(), message.ChannelTypeGroup, "channel-url", 42, listReportsRequest)
+	require.NoError(t, err)
+	assert.Equal(t, listReportsResponse, cur)
+
+	cur, err = report.ListUserReports(context.Background(), "offending-user-id", listReportsRequest)
+	require.NoError(t, err)
+	assert.Equal(t, listReportsResponse, cur)
+
+	cur, err = report.ListChannelReports(context.Background(), message.ChannelTypeGroup, "channel-url", listReportsRequest)
+	require.NoError(t, err)
+	assert.Equal(t, listReportsResponse, cur)
+}
@@ -0,0 +1,4 @@
+package report
+
+// https://github.com/traefik/mocktail
+// mocktail:Report
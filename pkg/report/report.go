@@ -0,0 +1,44 @@
+// Package report package provides the interface for the report service.
+// It provides the methods to interact with the sendbird API.
+// See https://sendbird.com/docs/chat/platform-api/v3/report/report-overview.
+package report
+
+import (
+	"context"
+
+	"github.com/yumi-ia/sendbird-go/pkg/client"
+	"github.com/yumi-ia/sendbird-go/pkg/message"
+)
+
+type Report interface {
+	// ReportMessage reports a message which contains suspicious, harassing,
+	// inappropriate or spam content.
+	// See https://sendbird.com/docs/chat/platform-api/v3/report/reporting-a-message/report-a-message
+	ReportMessage(ctx context.Context, channelType message.ChannelType, channelURL string, messageID int, reportMessageRequest ReportMessageRequest) (*ReportMessageResponse, error)
+	// ReportUser reports a user who sends suspicious, harassing, inappropriate
+	// or spam content.
+	// See https://sendbird.com/docs/chat/platform-api/v3/report/reporting-a-user/report-a-user
+	ReportUser(ctx context.Context, offendingUserID string, reportUserRequest ReportUserRequest) (*ReportUserResponse, error)
+	// ReportChannel reports a channel which contains suspicious, harassing,
+	// inappropriate or spam content.
+	// See https://sendbird.com/docs/chat/platform-api/v3/report/reporting-a-channel/report-a-channel
+	ReportChannel(ctx context.Context, channelType message.ChannelType, channelURL string, reportChannelRequest ReportChannelRequest) (*ReportChannelResponse, error)
+
+	// ListMessageReports lists the reports on a message.
+	// See https://sendbird.com/docs/chat/platform-api/v3/report/reporting-a-message/list-reports-on-a-message
+	ListMessageReports(ctx context.Context, channelType message.ChannelType, channelURL string, messageID int, listReportsRequest ListReportsRequest) (*ListReportsResponse, error)
+	// ListUserReports lists the reports on a user.
+	// See https://sendbird.com/docs/chat/platform-api/v3/report/reporting-a-user/list-reports-on-a-user
+	ListUserReports(ctx context.Context, offendingUserID string, listReportsRequest ListReportsRequest) (*ListReportsResponse, error)
+	// ListChannelReports lists the reports on a channel.
+	// See https://sendbird.com/docs/chat/platform-api/v3/report/reporting-a-channel/list-reports-on-a-channel
+	ListChannelReports(ctx context.Context, channelType message.ChannelType, channelURL string, listReportsRequest ListReportsRequest) (*ListReportsResponse, error)
+}
+
+type report struct {
+	client client.Client
+}
+
+func NewReport(c client.Client) Report {
+	return &report{client: c}
+}
@@ -0,0 +1,55 @@
+package report
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/yumi-ia/sendbird-go/pkg/message"
+)
+
+// ReportChannelRequest is the request to report a channel.
+type ReportChannelRequest struct {
+	// ReportCategory specifies the category of the report - required.
+	ReportCategory ReportCategory `json:"report_category"`
+
+	// ReportingUserID specifies the ID of the user who reports the channel. If
+	// not specified, the channel is reported by the application - optional.
+	ReportingUserID string `json:"reporting_user_id,omitempty"`
+	// ReportDescription specifies additional information about the report -
+	// optional.
+	ReportDescription string `json:"report_description,omitempty"`
+}
+
+func (rcr *ReportChannelRequest) Validate() error {
+	if !rcr.ReportCategory.IsValid() {
+		return fmt.Errorf("invalid report category %q", rcr.ReportCategory)
+	}
+
+	return nil
+}
+
+// ReportChannelResponse is the response to report a channel.
+type ReportChannelResponse ReportResource
+
+// ReportChannel reports a channel which contains suspicious, harassing,
+// inappropriate or spam content.
+// See https://sendbird.com/docs/chat/platform-api/v3/report/reporting-a-channel/report-a-channel
+func (r *report) ReportChannel(ctx context.Context, channelType message.ChannelType, channelURL string, reportChannelRequest ReportChannelRequest) (*ReportChannelResponse, error) {
+	if err := reportChannelRequest.Validate(); err != nil {
+		return nil, fmt.Errorf("failed to validate report channel request: %w", err)
+	}
+
+	path := fmt.Sprintf("/report/%s/%s", channelType, channelURL)
+
+	rcr, err := r.client.Post(ctx, path, reportChannelRequest, &ReportChannelResponse{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to report channel: %w", err)
+	}
+
+	reportChannelResponse, ok := rcr.(*ReportChannelResponse)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast body to ReportChannelResponse: %+v", rcr)
+	}
+
+	return reportChannelResponse, nil
+}
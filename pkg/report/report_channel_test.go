@@ -0,0 +1,36 @@
+package report
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yumi-ia/sendbird-go/pkg/channel"
+	"github.com/yumi-ia/sendbird-go/pkg/client"
+	"github.com/yumi-ia/sendbird-go/pkg/message"
+)
+
+func TestReportChannel(t *testing.T) {
+	t.Parallel()
+
+	reportChannelRequest := ReportChannelRequest{
+		ReportCategory:  ReportCategorySuspicious,
+		ReportingUserID: "reporting-user-id",
+	}
+
+	reportChannelResponse := &ReportChannelResponse{
+		ReportType:     ReportTypeChannel,
+		ReportCategory: ReportCategorySuspicious,
+		Channel:        &channel.ChannelResource{ChannelURL: "channel-url"},
+	}
+
+	client := client.NewClientMock(t).
+		OnPost("/report/open_channels/channel-url", reportChannelRequest, &ReportChannelResponse{}).TypedReturns(reportChannelResponse, nil).Once().
+		Parent
+	report := NewReport(client)
+
+	cur, err := report.ReportChannel(context.Background(), message.ChannelTypeOpen, "channel-url", reportChannelRequest)
+	require.NoError(t, err)
+	assert.Equal(t, reportChannelResponse, cur)
+}
@@ -0,0 +1,62 @@
+package report
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/yumi-ia/sendbird-go/pkg/message"
+)
+
+// ReportMessageRequest is the request to report a message.
+type ReportMessageRequest struct {
+	// ReportCategory specifies the category of the report - required.
+	ReportCategory ReportCategory `json:"report_category"`
+	// OffendingUserID specifies the ID of the user who sent the message -
+	// required.
+	OffendingUserID string `json:"offending_user_id"`
+
+	// ReportingUserID specifies the ID of the user who reports the message. If
+	// not specified, the message is reported by the application - optional.
+	ReportingUserID string `json:"reporting_user_id,omitempty"`
+	// ReportDescription specifies additional information about the report -
+	// optional.
+	ReportDescription string `json:"report_description,omitempty"`
+}
+
+func (rmr *ReportMessageRequest) Validate() error {
+	switch {
+	case !rmr.ReportCategory.IsValid():
+		return fmt.Errorf("invalid report category %q", rmr.ReportCategory)
+	case rmr.OffendingUserID == "":
+		return errors.New("offending user ID is required")
+	}
+
+	return nil
+}
+
+// ReportMessageResponse is the response to report a message.
+type ReportMessageResponse ReportResource
+
+// ReportMessage reports a message which contains suspicious, harassing,
+// inappropriate or spam content.
+// See https://sendbird.com/docs/chat/platform-api/v3/report/reporting-a-message/report-a-message
+func (r *report) ReportMessage(ctx context.Context, channelType message.ChannelType, channelURL string, messageID int, reportMessageRequest ReportMessageRequest) (*ReportMessageResponse, error) {
+	if err := reportMessageRequest.Validate(); err != nil {
+		return nil, fmt.Errorf("failed to validate report message request: %w", err)
+	}
+
+	path := fmt.Sprintf("/report/%s/%s/messages/%d", channelType, channelURL, messageID)
+
+	rmr, err := r.client.Post(ctx, path, reportMessageRequest, &ReportMessageResponse{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to report message: %w", err)
+	}
+
+	reportMessageResponse, ok := rmr.(*ReportMessageResponse)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast body to ReportMessageResponse: %+v", rmr)
+	}
+
+	return reportMessageResponse, nil
+}
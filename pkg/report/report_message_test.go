@@ -0,0 +1,88 @@
+package report
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yumi-ia/sendbird-go/pkg/client"
+	"github.com/yumi-ia/sendbird-go/pkg/message"
+)
+
+func TestValidateRMR(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		request   ReportMessageRequest
+		assertErr assert.ErrorAssertionFunc
+	}{
+		{
+			name:      "invalid request - empty",
+			request:   ReportMessageRequest{},
+			assertErr: assert.Error,
+		},
+		{
+			name: "invalid request - unknown category",
+			request: ReportMessageRequest{
+				ReportCategory:  "rude",
+				OffendingUserID: "offending-user-id",
+			},
+			assertErr: assert.Error,
+		},
+		{
+			name: "invalid request - missing offending user",
+			request: ReportMessageRequest{
+				ReportCategory: ReportCategorySpam,
+			},
+			assertErr: assert.Error,
+		},
+		{
+			name: "valid request",
+			request: ReportMessageRequest{
+				ReportCategory:  ReportCategorySpam,
+				OffendingUserID: "offending-user-id",
+			},
+			assertErr: assert.NoError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			test.assertErr(t, test.request.Validate())
+		})
+	}
+}
+
+func TestReportMessage(t *testing.T) {
+	t.Parallel()
+
+	reportMessageRequest := ReportMessageRequest{
+		ReportCategory:    ReportCategoryHarassing,
+		OffendingUserID:   "offending-user-id",
+		ReportingUserID:   "reporting-user-id",
+		ReportDescription: "report-description",
+	}
+
+	reportMessageResponse := &ReportMessageResponse{
+		ReportType:        ReportTypeMessage,
+		ReportCategory:    ReportCategoryHarassing,
+		ReportingUser:     &message.User{UserID: "reporting-user-id"},
+		OffendingUser:     &message.User{UserID: "offending-user-id"},
+		ReportedMessage:   &message.MessageResource{MessageID: 42},
+		ReportDescription: "report-description",
+		CreatedAt:         42,
+	}
+
+	client := client.NewClientMock(t).
+		OnPost("/report/group_channels/channel-url/messages/42", reportMessageRequest, &ReportMessageResponse{}).TypedReturns(reportMessageResponse, nil).Once().
+		Parent
+	report := NewReport(client)
+
+	cur, err := report.ReportMessage(context.Background(), message.ChannelTypeGroup, "channel-url", 42, reportMessageRequest)
+	require.NoError(t, err)
+	assert.Equal(t, reportMessageResponse, cur)
+}
@@ -0,0 +1,65 @@
+package report
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/yumi-ia/sendbird-go/pkg/message"
+)
+
+// ReportUserRequest is the request to report a user.
+type ReportUserRequest struct {
+	// ChannelType specifies the type of the channel where the user is reported
+	// - required.
+	ChannelType message.ChannelType `json:"channel_type"`
+	// ChannelURL specifies the URL of the channel where the user is reported -
+	// required.
+	ChannelURL string `json:"channel_url"`
+	// ReportCategory specifies the category of the report - required.
+	ReportCategory ReportCategory `json:"report_category"`
+
+	// ReportingUserID specifies the ID of the user who reports the user. If not
+	// specified, the user is reported by the application - optional.
+	ReportingUserID string `json:"reporting_user_id,omitempty"`
+	// ReportDescription specifies additional information about the report -
+	// optional.
+	ReportDescription string `json:"report_description,omitempty"`
+}
+
+func (rur *ReportUserRequest) Validate() error {
+	switch {
+	case rur.ChannelType == "":
+		return errors.New("channel type is required")
+	case rur.ChannelURL == "":
+		return errors.New("channel URL is required")
+	case !rur.ReportCategory.IsValid():
+		return fmt.Errorf("invalid report category %q", rur.ReportCategory)
+	}
+
+	return nil
+}
+
+// ReportUserResponse is the response to report a user.
+type ReportUserResponse ReportResource
+
+// ReportUser reports a user who sends suspicious, harassing, inappropriate or
+// spam content.
+// See https://sendbird.com/docs/chat/platform-api/v3/report/reporting-a-user/report-a-user
+func (r *report) ReportUser(ctx context.Context, offendingUserID string, reportUserRequest ReportUserRequest) (*ReportUserResponse, error) {
+	if err := reportUserRequest.Validate(); err != nil {
+		return nil, fmt.Errorf("failed to validate report user request: %w", err)
+	}
+
+	rur, err := r.client.Post(ctx, "/report/users/"+offendingUserID, reportUserRequest, &ReportUserResponse{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to report user: %w", err)
+	}
+
+	reportUserResponse, ok := rur.(*ReportUserResponse)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast body to ReportUserResponse: %+v", rur)
+	}
+
+	return reportUserResponse, nil
+}
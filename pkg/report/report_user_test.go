@@ -0,0 +1,47 @@
+package report
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yumi-ia/sendbird-go/pkg/client"
+	"github.com/yumi-ia/sendbird-go/pkg/message"
+)
+
+func TestReportUser(t *testing.T) {
+	t.Parallel()
+
+	reportUserRequest := ReportUserRequest{
+		ChannelType:       message.ChannelTypeGroup,
+		ChannelURL:        "channel-url",
+		ReportCategory:    ReportCategoryInappropriate,
+		ReportingUserID:   "reporting-user-id",
+		ReportDescription: "report-description",
+	}
+
+	reportUserResponse := &ReportUserResponse{
+		ReportType:     ReportTypeUser,
+		ReportCategory: ReportCategoryInappropriate,
+		OffendingUser:  &message.User{UserID: "offending-user-id"},
+	}
+
+	client := client.NewClientMock(t).
+		OnPost("/report/users/offending-user-id", reportUserRequest, &ReportUserResponse{}).TypedReturns(reportUserResponse, nil).Once().
+		Parent
+	report := NewReport(client)
+
+	cur, err := report.ReportUser(context.Background(), "offending-user-id", reportUserRequest)
+	require.NoError(t, err)
+	assert.Equal(t, reportUserResponse, cur)
+}
+
+func TestReportUser_invalid(t *testing.T) {
+	t.Parallel()
+
+	report := NewReport(client.NewClientMock(t))
+
+	_, err := report.ReportUser(context.Background(), "offending-user-id", ReportUserRequest{ReportCategory: ReportCategorySpam})
+	require.Error(t, err)
+}
@@ -0,0 +1,46 @@
+package report
+
+import (
+	"github.com/yumi-ia/sendbird-go/pkg/channel"
+	"github.com/yumi-ia/sendbird-go/pkg/message"
+)
+
+type ReportCategory string
+
+const (
+	ReportCategorySuspicious    ReportCategory = "suspicious"
+	ReportCategoryHarassing     ReportCategory = "harassing"
+	ReportCategoryInappropriate ReportCategory = "inappropriate"
+	ReportCategorySpam          ReportCategory = "spam"
+)
+
+// IsValid reports whether the category is one of the categories supported by
+// Sendbird.
+func (rc ReportCategory) IsValid() bool {
+	switch rc {
+	case ReportCategorySuspicious, ReportCategoryHarassing, ReportCategoryInappropriate, ReportCategorySpam:
+		return true
+	}
+
+	return false
+}
+
+type ReportType string
+
+const (
+	ReportTypeMessage ReportType = "message"
+	ReportTypeUser    ReportType = "user"
+	ReportTypeChannel ReportType = "channel"
+)
+
+// ReportResource is the resource of a report.
+type ReportResource struct {
+	ReportType        ReportType               `json:"report_type"`
+	ReportCategory    ReportCategory           `json:"report_category"`
+	ReportingUser     *message.User            `json:"reporting_user"`
+	OffendingUser     *message.User            `json:"offending_user"`
+	ReportedMessage   *message.MessageResource `json:"reported_message"`
+	Channel           *channel.ChannelResource `json:"channel"`
+	ReportDescription string                   `json:"report_description"`
+	CreatedAt         int64                    `json:"created_at"`
+}
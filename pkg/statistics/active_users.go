@@ -0,0 +1,117 @@
+package statistics
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+const (
+	// maxDAURangeDays is the maximum number of days of the range given to
+	// GetDailyActiveUsers, as one call is made per day.
+	maxDAURangeDays = 366
+	// maxMAURangeMonths is the maximum number of months of the range given to
+	// GetMonthlyActiveUsers, as one call is made per month.
+	maxMAURangeMonths = 24
+)
+
+// getDAUResponse is the response to get the number of daily active users.
+type getDAUResponse struct {
+	DAU int `json:"dau"`
+}
+
+// getMAUResponse is the response to get the number of monthly active users.
+type getMAUResponse struct {
+	MAU int `json:"mau"`
+}
+
+// GetDailyActiveUsers retrieves the number of daily active users for each day
+// of the range. The API returns a single day per call, so one sequential call
+// is made per day of the range, which is limited to 366 days.
+// See https://sendbird.com/docs/chat/platform-api/v3/statistics/daus-and-maus/get-number-of-daily-active-users
+func (s *statistics) GetDailyActiveUsers(ctx context.Context, dateRange DateRange) (TimeSeries, error) {
+	if err := dateRange.Validate(); err != nil {
+		return nil, fmt.Errorf("failed to validate date range: %w", err)
+	}
+
+	if n := dateRange.dayCount(); n > maxDAURangeDays {
+		return nil, fmt.Errorf("range of %d days exceeds the maximum of %d days", n, maxDAURangeDays)
+	}
+
+	days := dateRange.days()
+
+	ts := make(TimeSeries, 0, len(days))
+
+	for _, day := range days {
+		if err := ctx.Err(); err != nil {
+			return nil, fmt.Errorf("failed to get daily active users: %w", err)
+		}
+
+		gdr, err := s.client.Get(ctx, dateURL("/applications/dau", day), nil, &getDAUResponse{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get daily active users of %s: %w", day.Format(dateLayout), err)
+		}
+
+		dauResponse, ok := gdr.(*getDAUResponse)
+		if !ok {
+			return nil, fmt.Errorf("failed to cast body to getDAUResponse: %+v", gdr)
+		}
+
+		ts = append(ts, DataPoint{Date: day, Value: dauResponse.DAU})
+	}
+
+	return ts, nil
+}
+
+// GetMonthlyActiveUsers retrieves the number of monthly active users for each
+// month of the range. The API returns a single month per call, so one
+// sequential call is made per month of the range, which is limited to 24
+// months.
+// See https://sendbird.com/docs/chat/platform-api/v3/statistics/daus-and-maus/get-number-of-monthly-active-users
+func (s *statistics) GetMonthlyActiveUsers(ctx context.Context, dateRange DateRange) (TimeSeries, error) {
+	if err := dateRange.Validate(); err != nil {
+		return nil, fmt.Errorf("failed to validate date range: %w", err)
+	}
+
+	if n := dateRange.monthCount(); n > maxMAURangeMonths {
+		return nil, fmt.Errorf("range of %d months exceeds the maximum of %d months", n, maxMAURangeMonths)
+	}
+
+	months := dateRange.months()
+
+	ts := make(TimeSeries, 0, len(months))
+
+	for _, month := range months {
+		if err := ctx.Err(); err != nil {
+			return nil, fmt.Errorf("failed to get monthly active users: %w", err)
+		}
+
+		gmr, err := s.client.Get(ctx, dateURL("/applications/mau", month), nil, &getMAUResponse{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get monthly active users of %s: %w", month.Format(monthLayout), err)
+		}
+
+		mauResponse, ok := gmr.(*getMAUResponse)
+		if !ok {
+			return nil, fmt.Errorf("failed to cast body to getMAUResponse: %+v", gmr)
+		}
+
+		ts = append(ts, DataPoint{Date: month, Value: mauResponse.MAU})
+	}
+
+	return ts, nil
+}
+
+func dateURL(path string, date time.Time) string {
+	u := &url.URL{
+		Path: path,
+	}
+
+	query := u.Query()
+	query.Set("date", date.Format(dateLayout))
+
+	u.RawQuery = query.Encode()
+
+	return u.String()
+}
@@ -0,0 +1,84 @@
+package statistics
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yumi-ia/sendbird-go/pkg/client"
+)
+
+func TestGetDailyActiveUsers(t *testing.T) {
+	t.Parallel()
+
+	client := client.NewClientMock(t).
+		OnGet("/applications/dau?date=2024-01-31", nil, &getDAUResponse{}).TypedReturns(&getDAUResponse{DAU: 10}, nil).Once().
+		OnGet("/applications/dau?date=2024-02-01", nil, &getDAUResponse{}).TypedReturns(&getDAUResponse{DAU: 20}, nil).Once().
+		Parent
+	statistics := NewStatistics(client)
+
+	ts, err := statistics.GetDailyActiveUsers(context.Background(), DateRange{Start: date(2024, 1, 31), End: date(2024, 2, 1)})
+	require.NoError(t, err)
+	assert.Equal(t, TimeSeries{
+		{Date: date(2024, 1, 31), Value: 10},
+		{Date: date(2024, 2, 1), Value: 20},
+	}, ts)
+}
+
+func TestGetMonthlyActiveUsers(t *testing.T) {
+	t.Parallel()
+
+	client := client.NewClientMock(t).
+		OnGet("/applications/mau?date=2023-12-01", nil, &getMAUResponse{}).TypedReturns(&getMAUResponse{MAU: 100}, nil).Once().
+		OnGet("/applications/mau?date=2024-01-01", nil, &getMAUResponse{}).TypedReturns(&getMAUResponse{MAU: 200}, nil).Once().
+		Parent
+	statistics := NewStatistics(client)
+
+	ts, err := statistics.GetMonthlyActiveUsers(context.Background(), DateRange{Start: date(2023, 12, 15), End: date(2024, 1, 15)})
+	require.NoError(t, err)
+	assert.Equal(t, TimeSeries{
+		{Date: date(2023, 12, 1), Value: 100},
+		{Date: date(2024, 1, 1), Value: 200},
+	}, ts)
+}
+
+func TestGetDailyActiveUsers_invalid(t *testing.T) {
+	t.Parallel()
+
+	statistics := NewStatistics(client.NewClientMock(t))
+
+	_, err := statistics.GetDailyActiveUsers(context.Background(), DateRange{Start: date(2024, 1, 2), End: date(2024, 1, 1)})
+	require.Error(t, err)
+}
+
+func TestGetActiveUsers_rangeTooLong(t *testing.T) {
+	t.Parallel()
+
+	statistics := NewStatistics(client.NewClientMock(t))
+
+	_, err := statistics.GetDailyActiveUsers(context.Background(), DateRange{Start: date(2024, 1, 1), End: date(2025, 1, 1)})
+	require.ErrorContains(t, err, "range of 367 days exceeds the maximum of 366 days")
+
+	// The range is checked before its days are listed.
+	_, err = statistics.GetDailyActiveUsers(context.Background(), DateRange{Start: date(1900, 1, 1), End: date(9999, 12, 31)})
+	require.ErrorContains(t, err, "range of 2958464 days exceeds the maximum of 366 days")
+
+	_, err = statistics.GetMonthlyActiveUsers(context.Background(), DateRange{Start: date(2024, 1, 1), End: date(2026, 1, 1)})
+	require.ErrorContains(t, err, "range of 25 months exceeds the maximum of 24 months")
+}
+
+func TestGetDailyActiveUsers_canceled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	statistics := NewStatistics(client.NewClientMock(t).
+		OnGet("/applications/dau?date=2024-01-01", nil, &getDAUResponse{}).
+		TypedReturns(&getDAUResponse{DAU: 10}, nil).
+		TypedRun(func(string, any, any) { cancel() }).Once().
+		Parent)
+
+	_, err := statistics.GetDailyActiveUsers(ctx, DateRange{Start: date(2024, 1, 1), End: date(2024, 1, 3)})
+	require.ErrorIs(t, err, context.Canceled)
+}
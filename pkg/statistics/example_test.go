@@ -0,0 +1,19 @@
+package statistics_test
+
+import (
+	"github.com/yumi-ia/sendbird-go/pkg/client"
+	"github.com/yumi-ia/sendbird-go/pkg/statistics"
+)
+
+func ExampleNewStatistics() {
+	// Initialize a client.
+	opts := []client.Option{}
+	c := client.NewClient(opts...)
+
+	// Initialize a statistics service.
+	s := statistics.NewStatistics(c)
+
+	// the statistics client is ready to be used.
+	_ = s
+	// s.DoWork()
+}
@@ -0,0 +1,60 @@
+package statistics
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// dailyMessageCount is the number of messages sent at a date.
+type dailyMessageCount struct {
+	Date         string `json:"date"`
+	MessageCount int    `json:"message_count"`
+}
+
+// getDailyMessageCountsResponse is the response to get the number of daily
+// messages.
+type getDailyMessageCountsResponse struct {
+	DailyMessageCount []dailyMessageCount `json:"daily_message_count"`
+}
+
+// GetDailyMessageCounts retrieves the number of messages sent for each day of
+// the range.
+// See https://sendbird.com/docs/chat/platform-api/v3/statistics/view-number-of-daily-messages
+func (s *statistics) GetDailyMessageCounts(ctx context.Context, dateRange DateRange) (TimeSeries, error) {
+	if err := dateRange.Validate(); err != nil {
+		return nil, fmt.Errorf("failed to validate date range: %w", err)
+	}
+
+	u := &url.URL{
+		Path: "/statistics/daily_messages",
+	}
+
+	query := u.Query()
+	query.Set("start_date", dateRange.Start.UTC().Format(dateLayout))
+	query.Set("end_date", dateRange.End.UTC().Format(dateLayout))
+
+	u.RawQuery = query.Encode()
+
+	gdmcr, err := s.client.Get(ctx, u.String(), nil, &getDailyMessageCountsResponse{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get daily message counts: %w", err)
+	}
+
+	dailyMessageCountsResponse, ok := gdmcr.(*getDailyMessageCountsResponse)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast body to getDailyMessageCountsResponse: %+v", gdmcr)
+	}
+
+	ts := make(TimeSeries, 0, len(dailyMessageCountsResponse.DailyMessageCount))
+	for _, dmc := range dailyMessageCountsResponse.DailyMessageCount {
+		date, err := parseDate(dmc.Date)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse date %q: %w", dmc.Date, err)
+		}
+
+		ts = append(ts, DataPoint{Date: date, Value: dmc.MessageCount})
+	}
+
+	return ts, nil
+}
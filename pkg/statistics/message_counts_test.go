@@ -0,0 +1,49 @@
+package statistics
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yumi-ia/sendbird-go/pkg/client"
+)
+
+func TestGetDailyMessageCounts(t *testing.T) {
+	t.Parallel()
+
+	response := &getDailyMessageCountsResponse{
+		DailyMessageCount: []dailyMessageCount{
+			{Date: "2024-01-01", MessageCount: 1000},
+			{Date: "2024-01-02", MessageCount: 1200},
+		},
+	}
+
+	client := client.NewClientMock(t).
+		OnGet("/statistics/daily_messages?end_date=2024-01-02&start_date=2024-01-01", nil, &getDailyMessageCountsResponse{}).TypedReturns(response, nil).Once().
+		Parent
+	statistics := NewStatistics(client)
+
+	ts, err := statistics.GetDailyMessageCounts(context.Background(), DateRange{Start: date(2024, 1, 1), End: date(2024, 1, 2)})
+	require.NoError(t, err)
+	assert.Equal(t, TimeSeries{
+		{Date: date(2024, 1, 1), Value: 1000},
+		{Date: date(2024, 1, 2), Value: 1200},
+	}, ts)
+}
+
+func TestGetDailyMessageCounts_invalidDate(t *testing.T) {
+	t.Parallel()
+
+	response := &getDailyMessageCountsResponse{
+		DailyMessageCount: []dailyMessageCount{{Date: "01/01/2024", MessageCount: 1000}},
+	}
+
+	client := client.NewClientMock(t).
+		OnGet("/statistics/daily_messages?end_date=2024-01-01&start_date=2024-01-01", nil, &getDailyMessageCountsResponse{}).TypedReturns(response, nil).Once().
+		Parent
+	statistics := NewStatistics(client)
+
+	_, err := statistics.GetDailyMessageCounts(context.Background(), DateRange{Start: date(2024, 1, 1), End: date(2024, 1, 1)})
+	require.Error(t, err)
+}
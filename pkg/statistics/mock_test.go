@@ -0,0 +1,4 @@
+package statistics
+
+// https://github.com/traefik/mocktail
+// mocktail:Statistics
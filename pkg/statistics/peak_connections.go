@@ -0,0 +1,80 @@
+package statistics
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// peakConnection is the peak number of concurrent connections at a date.
+type peakConnection struct {
+	Date            string `json:"date"`
+	PeakConnections int    `json:"peak_connections"`
+}
+
+// getPeakConnectionsResponse is the response to get the peak number of
+// concurrent connections.
+type getPeakConnectionsResponse struct {
+	PeakConnections []peakConnection `json:"peak_connections"`
+}
+
+func peakConnectionsRequestToMap(timeDimension TimeDimension, dateRange DateRange) map[string]string {
+	start, end := dateRange.Start.UTC(), dateRange.End.UTC()
+
+	return map[string]string{
+		"time_dimension": string(timeDimension),
+		"start_year":     strconv.Itoa(start.Year()),
+		"start_month":    strconv.Itoa(int(start.Month())),
+		"start_day":      strconv.Itoa(start.Day()),
+		"end_year":       strconv.Itoa(end.Year()),
+		"end_month":      strconv.Itoa(int(end.Month())),
+		"end_day":        strconv.Itoa(end.Day()),
+	}
+}
+
+// GetPeakConnections retrieves the peak number of concurrent connections for
+// each day or month of the range.
+// See https://sendbird.com/docs/chat/platform-api/v3/statistics/get-number-of-peak-connections
+func (s *statistics) GetPeakConnections(ctx context.Context, timeDimension TimeDimension, dateRange DateRange) (TimeSeries, error) {
+	if timeDimension != TimeDimensionDaily && timeDimension != TimeDimensionMonthly {
+		return nil, fmt.Errorf("invalid time dimension %q", timeDimension)
+	}
+
+	if err := dateRange.Validate(); err != nil {
+		return nil, fmt.Errorf("failed to validate date range: %w", err)
+	}
+
+	u := &url.URL{
+		Path: "/applications/peak_connections",
+	}
+
+	query := u.Query()
+	for k, v := range peakConnectionsRequestToMap(timeDimension, dateRange) {
+		query.Set(k, v)
+	}
+
+	u.RawQuery = query.Encode()
+
+	gpcr, err := s.client.Get(ctx, u.String(), nil, &getPeakConnectionsResponse{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get peak connections: %w", err)
+	}
+
+	peakConnectionsResponse, ok := gpcr.(*getPeakConnectionsResponse)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast body to getPeakConnectionsResponse: %+v", gpcr)
+	}
+
+	ts := make(TimeSeries, 0, len(peakConnectionsResponse.PeakConnections))
+	for _, pc := range peakConnectionsResponse.PeakConnections {
+		date, err := parseDate(pc.Date)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse date %q: %w", pc.Date, err)
+		}
+
+		ts = append(ts, DataPoint{Date: date, Value: pc.PeakConnections})
+	}
+
+	return ts, nil
+}
@@ -0,0 +1,42 @@
+package statistics
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yumi-ia/sendbird-go/pkg/client"
+)
+
+func TestGetPeakConnections(t *testing.T) {
+	t.Parallel()
+
+	response := &getPeakConnectionsResponse{
+		PeakConnections: []peakConnection{
+			{Date: "2024-01", PeakConnections: 50},
+			{Date: "2024-02", PeakConnections: 70},
+		},
+	}
+
+	client := client.NewClientMock(t).
+		OnGet("/applications/peak_connections?end_day=29&end_month=2&end_year=2024&start_day=1&start_month=1&start_year=2024&time_dimension=monthly", nil, &getPeakConnectionsResponse{}).TypedReturns(response, nil).Once().
+		Parent
+	statistics := NewStatistics(client)
+
+	ts, err := statistics.GetPeakConnections(context.Background(), TimeDimensionMonthly, DateRange{Start: date(2024, 1, 1), End: date(2024, 2, 29)})
+	require.NoError(t, err)
+	assert.Equal(t, TimeSeries{
+		{Date: date(2024, 1, 1), Value: 50},
+		{Date: date(2024, 2, 1), Value: 70},
+	}, ts)
+}
+
+func TestGetPeakConnections_invalidTimeDimension(t *testing.T) {
+	t.Parallel()
+
+	statistics := NewStatistics(client.NewClientMock(t))
+
+	_, err := statistics.GetPeakConnections(context.Background(), "weekly", DateRange{Start: date(2024, 1, 1), End: date(2024, 1, 2)})
+	require.Error(t, err)
+}
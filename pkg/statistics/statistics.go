@@ -0,0 +1,40 @@
+// Package statistics package provides the interface for the statistics
+// service.
+// It provides the methods to interact with the sendbird API.
+// See https://sendbird.com/docs/chat/platform-api/v3/statistics/statistics-overview.
+package statistics
+
+import (
+	"context"
+
+	"github.com/yumi-ia/sendbird-go/pkg/client"
+)
+
+type Statistics interface {
+	// GetDailyActiveUsers retrieves the number of daily active users for each
+	// day of the range, with one call per day. The range is limited to 366
+	// days.
+	// See https://sendbird.com/docs/chat/platform-api/v3/statistics/daus-and-maus/get-number-of-daily-active-users
+	GetDailyActiveUsers(ctx context.Context, dateRange DateRange) (TimeSeries, error)
+	// GetMonthlyActiveUsers retrieves the number of monthly active users for
+	// each month of the range, with one call per month. The range is limited
+	// to 24 months.
+	// See https://sendbird.com/docs/chat/platform-api/v3/statistics/daus-and-maus/get-number-of-monthly-active-users
+	GetMonthlyActiveUsers(ctx context.Context, dateRange DateRange) (TimeSeries, error)
+	// GetPeakConnections retrieves the peak number of concurrent connections
+	// for each day or month of the range.
+	// See https://sendbird.com/docs/chat/platform-api/v3/statistics/get-number-of-peak-connections
+	GetPeakConnections(ctx context.Context, timeDimension TimeDimension, dateRange DateRange) (TimeSeries, error)
+	// GetDailyMessageCounts retrieves the number of messages sent for each day
+	// of the range.
+	// See https://sendbird.com/docs/chat/platform-api/v3/statistics/view-number-of-daily-messages
+	GetDailyMessageCounts(ctx context.Context, dateRange DateRange) (TimeSeries, error)
+}
+
+type statistics struct {
+	client client.Client
+}
+
+func NewStatistics(c client.Client) Statistics {
+	return &statistics{client: c}
+}
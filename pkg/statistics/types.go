@@ -0,0 +1,128 @@
+package statistics
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	// dateLayout is the layout of the dates of the sendbird API, used both for
+	// the dates sent in the requests and for the ones read from the responses.
+	dateLayout = "2006-01-02"
+	// monthLayout is the layout of the months of the sendbird API.
+	monthLayout = "2006-01"
+)
+
+// TimeDimension is the granularity of a time series.
+type TimeDimension string
+
+const (
+	TimeDimensionDaily   TimeDimension = "daily"
+	TimeDimensionMonthly TimeDimension = "monthly"
+)
+
+// DateRange is a range of dates, both inclusive. Only the year, month and day
+// of Start and End in UTC are used.
+type DateRange struct {
+	Start time.Time
+	End   time.Time
+}
+
+func (dr *DateRange) Validate() error {
+	switch {
+	case dr.Start.IsZero():
+		return errors.New("start is required")
+	case dr.End.IsZero():
+		return errors.New("end is required")
+	case truncateToDay(dr.Start).After(truncateToDay(dr.End)):
+		return errors.New("start must be before end")
+	}
+
+	return nil
+}
+
+// dayCount returns the number of days of the range.
+func (dr *DateRange) dayCount() int {
+	const secondsPerDay = 24 * 60 * 60
+
+	return int((truncateToDay(dr.End).Unix()-truncateToDay(dr.Start).Unix())/secondsPerDay) + 1
+}
+
+// monthCount returns the number of months of the range.
+func (dr *DateRange) monthCount() int {
+	start, end := dr.Start.UTC(), dr.End.UTC()
+
+	return (end.Year()-start.Year())*12 + int(end.Month()) - int(start.Month()) + 1
+}
+
+// days returns every day of the range.
+func (dr *DateRange) days() []time.Time {
+	var days []time.Time
+	for d := truncateToDay(dr.Start); !d.After(truncateToDay(dr.End)); d = d.AddDate(0, 0, 1) {
+		days = append(days, d)
+	}
+
+	return days
+}
+
+// months returns the first day of every month of the range.
+func (dr *DateRange) months() []time.Time {
+	var months []time.Time
+	for m := truncateToMonth(dr.Start); !m.After(truncateToMonth(dr.End)); m = m.AddDate(0, 1, 0) {
+		months = append(months, m)
+	}
+
+	return months
+}
+
+func truncateToDay(t time.Time) time.Time {
+	t = t.UTC()
+
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func truncateToMonth(t time.Time) time.Time {
+	t = t.UTC()
+
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// DataPoint is a value of a time series.
+type DataPoint struct {
+	// Date is the day, or the first day of the month, of the value, in UTC.
+	Date time.Time
+	// Value is the value at the date.
+	Value int
+}
+
+// TimeSeries is a list of values sorted by date.
+type TimeSeries []DataPoint
+
+// Dates returns the dates of the time series, to be used as the X axis.
+func (ts TimeSeries) Dates() []time.Time {
+	dates := make([]time.Time, 0, len(ts))
+	for _, dp := range ts {
+		dates = append(dates, dp.Date)
+	}
+
+	return dates
+}
+
+// Values returns the values of the time series, to be used as the Y axis.
+func (ts TimeSeries) Values() []int {
+	values := make([]int, 0, len(ts))
+	for _, dp := range ts {
+		values = append(values, dp.Value)
+	}
+
+	return values
+}
+
+// parseDate parses a date of the sendbird API, either a day or a month.
+func parseDate(s string) (time.Time, error) {
+	if t, err := time.Parse(dateLayout, s); err == nil {
+		return t, nil
+	}
+
+	return time.Parse(monthLayout, s)
+}
@@ -0,0 +1,60 @@
+package statistics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestDateRange_Validate(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, (&DateRange{Start: date(2024, 1, 1), End: date(2024, 1, 1)}).Validate())
+	require.Error(t, (&DateRange{End: date(2024, 1, 1)}).Validate())
+	require.Error(t, (&DateRange{Start: date(2024, 1, 1)}).Validate())
+	require.Error(t, (&DateRange{Start: date(2024, 1, 2), End: date(2024, 1, 1)}).Validate())
+}
+
+func TestDateRange_days(t *testing.T) {
+	t.Parallel()
+
+	dr := DateRange{Start: date(2024, 2, 28).Add(15 * time.Hour), End: date(2024, 3, 1)}
+
+	assert.Equal(t, []time.Time{date(2024, 2, 28), date(2024, 2, 29), date(2024, 3, 1)}, dr.days())
+	assert.Equal(t, 3, dr.dayCount())
+
+	// The count doesn't depend on the time of day nor on the time zone.
+	dr = DateRange{Start: date(2024, 1, 1).Add(23 * time.Hour), End: time.Date(2024, 12, 31, 23, 0, 0, 0, time.FixedZone("", -2*60*60))}
+	assert.Equal(t, 367, dr.dayCount())
+	assert.Len(t, dr.days(), 367)
+}
+
+func TestDateRange_months(t *testing.T) {
+	t.Parallel()
+
+	dr := DateRange{Start: date(2023, 11, 30), End: date(2024, 1, 15)}
+
+	assert.Equal(t, []time.Time{date(2023, 11, 1), date(2023, 12, 1), date(2024, 1, 1)}, dr.months())
+	assert.Equal(t, 3, dr.monthCount())
+
+	dr = DateRange{Start: date(1, 1, 1), End: date(9999, 12, 31)}
+	assert.Equal(t, 9999*12, dr.monthCount())
+}
+
+func TestTimeSeries(t *testing.T) {
+	t.Parallel()
+
+	ts := TimeSeries{
+		{Date: date(2024, 1, 1), Value: 10},
+		{Date: date(2024, 1, 2), Value: 20},
+	}
+
+	assert.Equal(t, []time.Time{date(2024, 1, 1), date(2024, 1, 2)}, ts.Dates())
+	assert.Equal(t, []int{10, 20}, ts.Values())
+}
@@ -0,0 +1,274 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/yumi-ia/sendbird-go/pkg/client"
+	"github.com/yumi-ia/sendbird-go/pkg/utils/wait"
+)
+
+const (
+	// defaultBatchConcurrency is the default number of users created or
+	// updated concurrently by the batch helpers.
+	defaultBatchConcurrency = 5
+	// defaultBatchMaxRetries is the default number of retries of a rate
+	// limited item.
+	defaultBatchMaxRetries = 3
+	// defaultBatchBackoff is the default delay of the first retry of a rate
+	// limited item.
+	defaultBatchBackoff = time.Second
+	// maxBatchBackoff is the maximum delay of a retry of a rate limited item.
+	maxBatchBackoff = time.Minute
+)
+
+// BatchOptions are the options of CreateUsers and UpdateUsers.
+type BatchOptions struct {
+	// Concurrency specifies the maximum number of users processed at the same
+	// time.
+	// Optional. (Default: 5)
+	Concurrency int
+	// MaxRetries specifies the maximum number of retries of a user whose
+	// request is rate limited, 0 disabling the retries.
+	// Optional. (Default: 3)
+	MaxRetries *int
+	// Backoff specifies the delay before retrying a rate limited request,
+	// doubled on each retry up to one minute. All the workers wait for it, so
+	// that the rate limit is not hit again right away.
+	// Optional. (Default: 1s)
+	Backoff time.Duration
+}
+
+// Validate checks that the options are not negative.
+func (bo *BatchOptions) Validate() error {
+	switch {
+	case bo.Concurrency < 0:
+		return errors.New("concurrency must be positive")
+	case bo.MaxRetries != nil && *bo.MaxRetries < 0:
+		return errors.New("max retries must be positive")
+	case bo.Backoff < 0:
+		return errors.New("backoff must be positive")
+	}
+
+	return nil
+}
+
+func (bo BatchOptions) withDefaults() BatchOptions {
+	if bo.Concurrency == 0 {
+		bo.Concurrency = defaultBatchConcurrency
+	}
+
+	if bo.MaxRetries == nil {
+		maxRetries := defaultBatchMaxRetries
+		bo.MaxRetries = &maxRetries
+	}
+
+	if bo.Backoff == 0 {
+		bo.Backoff = defaultBatchBackoff
+	}
+
+	return bo
+}
+
+// BatchStatus is the outcome of an item of a batch.
+type BatchStatus string
+
+const (
+	BatchStatusCreated       BatchStatus = "created"
+	BatchStatusAlreadyExists BatchStatus = "already_exists"
+	BatchStatusUpdated       BatchStatus = "updated"
+	BatchStatusFailed        BatchStatus = "failed"
+)
+
+// BatchResult is the result of an item of a batch.
+type BatchResult struct {
+	// UserID is the ID of the user of the item.
+	UserID string
+	// Status is the outcome of the item.
+	Status BatchStatus
+	// Attempts is the number of requests sent for the item.
+	Attempts int
+	// Err is the error of the item when Status is BatchStatusFailed.
+	Err error
+}
+
+// BatchReport is the report of a batch. Results are in the order of the
+// items of the batch.
+type BatchReport struct {
+	Results []BatchResult
+}
+
+// Failed returns the results of the failed items.
+func (br *BatchReport) Failed() []BatchResult {
+	var failed []BatchResult
+
+	for _, result := range br.Results {
+		if result.Status == BatchStatusFailed {
+			failed = append(failed, result)
+		}
+	}
+
+	return failed
+}
+
+// Err returns the errors of the failed items joined, or nil if every item
+// succeeded.
+func (br *BatchReport) Err() error {
+	var errs []error
+
+	for _, result := range br.Failed() {
+		errs = append(errs, fmt.Errorf("user %s: %w", result.UserID, result.Err))
+	}
+
+	return errors.Join(errs...)
+}
+
+// CreateUsers creates users in bulk, with at most Concurrency creations at
+// the same time. A user that already exists is reported with
+// BatchStatusAlreadyExists rather than as a failure, so that an interrupted
+// batch can be run again. Every user is attempted; the outcome of each one is
+// reported in the returned report.
+func CreateUsers(ctx context.Context, u User, createUserRequests []CreateUserRequest, batchOptions BatchOptions) (*BatchReport, error) {
+	userIDs := make([]string, len(createUserRequests))
+	for i, createUserRequest := range createUserRequests {
+		userIDs[i] = createUserRequest.UserID
+	}
+
+	return runBatch(ctx, userIDs, batchOptions, func(ctx context.Context, i int) (BatchStatus, error) {
+		_, err := u.CreateUser(ctx, createUserRequests[i])
+		if errors.Is(err, client.ErrResourceAlreadyExists) {
+			return BatchStatusAlreadyExists, nil
+		}
+
+		return BatchStatusCreated, err
+	})
+}
+
+// UserUpdate is a user to update with UpdateUsers.
+type UserUpdate struct {
+	// UserID specifies the ID of the user to update - required.
+	UserID string
+	// UpdateUserRequest specifies the update of the user - required.
+	UpdateUserRequest UpdateUserRequest
+}
+
+// UpdateUsers updates users in bulk, with at most Concurrency updates at the
+// same time. Every user is attempted; the outcome of each one is reported in
+// the returned report.
+func UpdateUsers(ctx context.Context, u User, userUpdates []UserUpdate, batchOptions BatchOptions) (*BatchReport, error) {
+	userIDs := make([]string, len(userUpdates))
+	for i, userUpdate := range userUpdates {
+		userIDs[i] = userUpdate.UserID
+	}
+
+	return runBatch(ctx, userIDs, batchOptions, func(ctx context.Context, i int) (BatchStatus, error) {
+		_, err := u.UpdateUser(ctx, userUpdates[i].UserID, userUpdates[i].UpdateUserRequest)
+
+		return BatchStatusUpdated, err
+	})
+}
+
+// runBatch calls fn for each user, retrying the rate limited calls.
+func runBatch(ctx context.Context, userIDs []string, batchOptions BatchOptions, fn func(ctx context.Context, i int) (BatchStatus, error)) (*BatchReport, error) {
+	if err := batchOptions.Validate(); err != nil {
+		return nil, fmt.Errorf("failed to validate batch options: %w", err)
+	}
+
+	batchOptions = batchOptions.withDefaults()
+
+	report := &BatchReport{Results: make([]BatchResult, len(userIDs))}
+	pause := &pauser{}
+
+	var wg sync.WaitGroup
+
+	sem := make(chan struct{}, batchOptions.Concurrency)
+
+	for i, userID := range userIDs {
+		wg.Add(1)
+
+		sem <- struct{}{}
+
+		go func(i int, userID string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			result := BatchResult{UserID: userID}
+
+			for {
+				if err := pause.wait(ctx); err != nil {
+					result.Status, result.Err = BatchStatusFailed, err
+
+					break
+				}
+
+				result.Attempts++
+
+				status, err := fn(ctx, i)
+				if err == nil {
+					result.Status = status
+
+					break
+				}
+
+				if !errors.Is(err, client.ErrAPITooManyRequests) || result.Attempts > *batchOptions.MaxRetries {
+					result.Status, result.Err = BatchStatusFailed, err
+
+					break
+				}
+
+				pause.extend(wait.Exponential(batchOptions.Backoff, result.Attempts-1, maxBatchBackoff))
+			}
+
+			// Each goroutine writes its own index only.
+			report.Results[i] = result
+		}(i, userID)
+	}
+
+	wg.Wait()
+
+	return report, nil
+}
+
+// pauser holds back all the workers of a batch after a rate limit.
+type pauser struct {
+	mu    sync.Mutex
+	until time.Time
+}
+
+// extend pauses the workers for at least d from now.
+func (p *pauser) extend(d time.Duration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if until := time.Now().Add(d); until.After(p.until) {
+		p.until = until
+	}
+}
+
+// wait waits for the end of the pause or until ctx is done.
+func (p *pauser) wait(ctx context.Context) error {
+	for {
+		p.mu.Lock()
+		d := time.Until(p.until)
+		p.mu.Unlock()
+
+		if d <= 0 {
+			return ctx.Err()
+		}
+
+		t := time.NewTimer(d)
+
+		select {
+		case <-ctx.Done():
+			t.Stop()
+
+			return ctx.Err()
+		case <-t.C:
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/yumi-ia/sendbird-go/pkg/client"
+)
+
+func TestCreateUsers(t *testing.T) {
+	t.Parallel()
+
+	errAPI := fmt.Errorf("failed to create user: %w", client.ErrInvalidValue)
+	errRateLimit := fmt.Errorf("failed to create user: %w", client.ErrRateLimitExceeded)
+	errAlreadyExists := fmt.Errorf("failed to create user: %w", client.ErrResourceAlreadyExists)
+
+	user := NewUserMock(t).
+		OnCreateUser(CreateUserRequest{UserID: "1"}).TypedReturns(&CreateUserResponse{UserID: "1"}, nil).Once().
+		OnCreateUser(CreateUserRequest{UserID: "2"}).TypedReturns(nil, errAlreadyExists).Once().
+		OnCreateUser(CreateUserRequest{UserID: "3"}).TypedReturns(nil, errAPI).Once().
+		OnCreateUser(CreateUserRequest{UserID: "4"}).TypedReturns(nil, errRateLimit).Once().
+		OnCreateUser(CreateUserRequest{UserID: "4"}).TypedReturns(&CreateUserResponse{UserID: "4"}, nil).Once().
+		Parent
+
+	report, err := CreateUsers(context.Background(), user, []CreateUserRequest{
+		{UserID: "1"},
+		{UserID: "2"},
+		{UserID: "3"},
+		{UserID: "4"},
+	}, BatchOptions{Concurrency: 2, Backoff: time.Millisecond})
+	require.NoError(t, err)
+
+	assert.Equal(t, []BatchResult{
+		{UserID: "1", Status: BatchStatusCreated, Attempts: 1},
+		{UserID: "2", Status: BatchStatusAlreadyExists, Attempts: 1},
+		{UserID: "3", Status: BatchStatusFailed, Attempts: 1, Err: errAPI},
+		{UserID: "4", Status: BatchStatusCreated, Attempts: 2},
+	}, report.Results)
+
+	assert.Equal(t, []BatchResult{{UserID: "3", Status: BatchStatusFailed, Attempts: 1, Err: errAPI}}, report.Failed())
+	require.ErrorIs(t, report.Err(), client.ErrInvalidValue)
+	assert.ErrorContains(t, report.Err(), "user 3")
+}
+
+func TestCreateUsers_rateLimitExhausted(t *testing.T) {
+	t.Parallel()
+
+	errRateLimit := fmt.Errorf("failed to create user: %w", client.ErrRateLimitExceeded)
+
+	user := NewUserMock(t).
+		OnCreateUser(CreateUserRequest{UserID: "1"}).TypedReturns(nil, errRateLimit).Times(3).
+		Parent
+
+	report, err := CreateUsers(context.Background(), user, []CreateUserRequest{{UserID: "1"}},
+		BatchOptions{MaxRetries: ptr(2), Backoff: time.Millisecond})
+	require.NoError(t, err)
+
+	require.Len(t, report.Results, 1)
+	assert.Equal(t, BatchStatusFailed, report.Results[0].Status)
+	assert.Equal(t, 3, report.Results[0].Attempts)
+	assert.ErrorIs(t, report.Err(), client.ErrAPITooManyRequests)
+}
+
+func TestCreateUsers_noRetry(t *testing.T) {
+	t.Parallel()
+
+	errRateLimit := fmt.Errorf("failed to create user: %w", client.ErrRateLimitExceeded)
+
+	user := NewUserMock(t).
+		OnCreateUser(CreateUserRequest{UserID: "1"}).TypedReturns(nil, errRateLimit).Once().
+		Parent
+
+	report, err := CreateUsers(context.Background(), user, []CreateUserRequest{{UserID: "1"}},
+		BatchOptions{MaxRetries: ptr(0)})
+	require.NoError(t, err)
+
+	require.Len(t, report.Results, 1)
+	assert.Equal(t, BatchStatusFailed, report.Results[0].Status)
+	assert.Equal(t, 1, report.Results[0].Attempts)
+}
+
+func TestCreateUsers_boundedConcurrency(t *testing.T) {
+	t.Parallel()
+
+	var running, maxRunning atomic.Int32
+
+	user := NewUserMock(t).
+		OnCreateUserRaw(mock.Anything).
+		TypedRun(func(CreateUserRequest) {
+			n := running.Add(1)
+			for {
+				m := maxRunning.Load()
+				if n <= m || maxRunning.CompareAndSwap(m, n) {
+					break
+				}
+			}
+			time.Sleep(time.Millisecond)
+			running.Add(-1)
+		}).
+		TypedReturns(&CreateUserResponse{}, nil).Times(10).
+		Parent
+
+	createUserRequests := make([]CreateUserRequest, 10)
+	for i := range createUserRequests {
+		createUserRequests[i] = CreateUserRequest{UserID: fmt.Sprint(i)}
+	}
+
+	report, err := CreateUsers(context.Background(), user, createUserRequests, BatchOptions{Concurrency: 3})
+	require.NoError(t, err)
+	require.NoError(t, report.Err())
+	assert.LessOrEqual(t, maxRunning.Load(), int32(3))
+}
+
+func TestCreateUsers_canceled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	report, err := CreateUsers(ctx, NewUserMock(t), []CreateUserRequest{{UserID: "1"}}, BatchOptions{})
+	require.NoError(t, err)
+	require.ErrorIs(t, report.Err(), context.Canceled)
+	assert.Equal(t, 0, report.Results[0].Attempts)
+}
+
+func TestUpdateUsers(t *testing.T) {
+	t.Parallel()
+
+	errAPI := errors.New("failed to update user")
+
+	user := NewUserMock(t).
+		OnUpdateUser("1", UpdateUserRequest{Nickname: "one"}).TypedReturns(&UpdateUserResponse{}, nil).Once().
+		OnUpdateUser("2", UpdateUserRequest{Nickname: "two"}).TypedReturns(nil, errAPI).Once().
+		Parent
+
+	report, err := UpdateUsers(context.Background(), user, []UserUpdate{
+		{UserID: "1", UpdateUserRequest: UpdateUserRequest{Nickname: "one"}},
+		{UserID: "2", UpdateUserRequest: UpdateUserRequest{Nickname: "two"}},
+	}, BatchOptions{})
+	require.NoError(t, err)
+
+	assert.Equal(t, []BatchResult{
+		{UserID: "1", Status: BatchStatusUpdated, Attempts: 1},
+		{UserID: "2", Status: BatchStatusFailed, Attempts: 1, Err: errAPI},
+	}, report.Results)
+}
+
+func TestBatchOptionsValidate(t *testing.T) {
+	t.Parallel()
+
+	_, err := UpdateUsers(context.Background(), NewUserMock(t), nil, BatchOptions{Concurrency: -1})
+	require.ErrorContains(t, err, "concurrency must be positive")
+
+	_, err = UpdateUsers(context.Background(), NewUserMock(t), nil, BatchOptions{MaxRetries: ptr(-1)})
+	require.ErrorContains(t, err, "max retries must be positive")
+
+	_, err = UpdateUsers(context.Background(), NewUserMock(t), nil, BatchOptions{Backoff: -1})
+	require.ErrorContains(t, err, "backoff must be positive")
+}
@@ -0,0 +1,33 @@
+package user
+
+import (
+	"context"
+	"fmt"
+)
+
+// markAllAsReadRequest is the request to mark all messages as read for a user.
+type markAllAsReadRequest struct {
+	// ChannelURLs specifies an array of one or more group channel URLs to mark
+	// as read. If not specified, all of the user's joined group channels are
+	// marked as read.
+	ChannelURLs []string `json:"channel_urls,omitempty"`
+}
+
+// MarkAllAsRead marks all messages as read in the group channels joined by a
+// user. If channelURLs is not empty, only the corresponding group channels are
+// marked as read.
+// See https://sendbird.com/docs/chat/platform-api/v3/user/marking-messages-as-read/mark-all-of-a-users-messages-as-read
+func (u *user) MarkAllAsRead(ctx context.Context, userID string, channelURLs []string) error {
+	path := fmt.Sprintf("/users/%s/mark_as_read_all", userID)
+
+	req := markAllAsReadRequest{
+		ChannelURLs: channelURLs,
+	}
+
+	_, err := u.client.Put(ctx, path, req, nil)
+	if err != nil {
+		return fmt.Errorf("failed to mark all as read: %w", err)
+	}
+
+	return nil
+}
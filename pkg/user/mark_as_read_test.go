@@ -0,0 +1,25 @@
+package user
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yumi-ia/sendbird-go/pkg/client"
+)
+
+func TestMarkAllAsRead(t *testing.T) {
+	t.Parallel()
+
+	client := client.NewClientMock(t).
+		OnPut("/users/42/mark_as_read_all", markAllAsReadRequest{}, nil).TypedReturns(nil, nil).Once().
+		OnPut("/users/42/mark_as_read_all", markAllAsReadRequest{ChannelURLs: []string{"channel-url"}}, nil).TypedReturns(nil, nil).Once().
+		Parent
+	user := NewUser(client)
+
+	err := user.MarkAllAsRead(context.Background(), "42", nil)
+	require.NoError(t, err)
+
+	err = user.MarkAllAsRead(context.Background(), "42", []string{"channel-url"})
+	require.NoError(t, err)
+}
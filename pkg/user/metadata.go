@@ -0,0 +1,18 @@
+package user
+
+import (
+	"github.com/yumi-ia/sendbird-go/pkg/utils/payload"
+)
+
+// EncodeMetadata encodes v to the Metadata of a CreateUserRequest. v must
+// encode to a JSON object whose values are strings, such as a struct of string
+// fields.
+func EncodeMetadata[T any](v T) (map[string]interface{}, error) {
+	return payload.EncodeMetadata(v)
+}
+
+// DecodeMetadata decodes the metadata of a user, such as the one of a
+// CreateUserResponse, into a T.
+func DecodeMetadata[T any](metadata map[string]interface{}) (T, error) {
+	return payload.DecodeMetadata[T](metadata)
+}
@@ -0,0 +1,28 @@
+package user
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type preferences struct {
+	Theme    string `json:"theme"`
+	Language string `json:"language,omitempty"`
+}
+
+func TestMetadata(t *testing.T) {
+	t.Parallel()
+
+	metadata, err := EncodeMetadata(preferences{Theme: "dark"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"theme": "dark"}, metadata)
+
+	p, err := DecodeMetadata[preferences](CreateUserResponse{Metadata: metadata}.Metadata)
+	require.NoError(t, err)
+	assert.Equal(t, preferences{Theme: "dark"}, p)
+
+	_, err = EncodeMetadata(map[string]bool{"verified": true})
+	require.ErrorContains(t, err, `value of "verified" must be a string`)
+}
@@ -121,10 +121,26 @@ func (_c *userCreateUserCall) OnGetSessionToken(userID string, getSessionTokenRe
 	return _c.Parent.OnGetSessionToken(userID, getSessionTokenRequest)
 }
 
+func (_c *userCreateUserCall) OnGetUnreadItemCount(userID string, getUnreadItemCountRequest GetUnreadItemCountRequest) *userGetUnreadItemCountCall {
+	return _c.Parent.OnGetUnreadItemCount(userID, getUnreadItemCountRequest)
+}
+
 func (_c *userCreateUserCall) OnGetUnreadMessagesCount(userID string, getUnreadMessagesCountRequest GetUnreadMessagesCountRequest) *userGetUnreadMessagesCountCall {
 	return _c.Parent.OnGetUnreadMessagesCount(userID, getUnreadMessagesCountRequest)
 }
 
+func (_c *userCreateUserCall) OnGetUnreadMessagesCountByChannel(userID string, getUnreadMessagesCountByChannelRequest GetUnreadMessagesCountByChannelRequest) *userGetUnreadMessagesCountByChannelCall {
+	return _c.Parent.OnGetUnreadMessagesCountByChannel(userID, getUnreadMessagesCountByChannelRequest)
+}
+
+func (_c *userCreateUserCall) OnListMyGroupChannels(userID string, listMyGroupChannelsRequest ListMyGroupChannelsRequest) *userListMyGroupChannelsCall {
+	return _c.Parent.OnListMyGroupChannels(userID, listMyGroupChannelsRequest)
+}
+
+func (_c *userCreateUserCall) OnMarkAllAsRead(userID string, channelURLs []string) *userMarkAllAsReadCall {
+	return _c.Parent.OnMarkAllAsRead(userID, channelURLs)
+}
+
 func (_c *userCreateUserCall) OnUpdateUser(userID string, updateUserRequest UpdateUserRequest) *userUpdateUserCall {
 	return _c.Parent.OnUpdateUser(userID, updateUserRequest)
 }
@@ -141,10 +157,26 @@ func (_c *userCreateUserCall) OnGetSessionTokenRaw(userID interface{}, getSessio
 	return _c.Parent.OnGetSessionTokenRaw(userID, getSessionTokenRequest)
 }
 
+func (_c *userCreateUserCall) OnGetUnreadItemCountRaw(userID interface{}, getUnreadItemCountRequest interface{}) *userGetUnreadItemCountCall {
+	return _c.Parent.OnGetUnreadItemCountRaw(userID, getUnreadItemCountRequest)
+}
+
 func (_c *userCreateUserCall) OnGetUnreadMessagesCountRaw(userID interface{}, getUnreadMessagesCountRequest interface{}) *userGetUnreadMessagesCountCall {
 	return _c.Parent.OnGetUnreadMessagesCountRaw(userID, getUnreadMessagesCountRequest)
 }
 
+func (_c *userCreateUserCall) OnGetUnreadMessagesCountByChannelRaw(userID interface{}, getUnreadMessagesCountByChannelRequest interface{}) *userGetUnreadMessagesCountByChannelCall {
+	return _c.Parent.OnGetUnreadMessagesCountByChannelRaw(userID, getUnreadMessagesCountByChannelRequest)
+}
+
+func (_c *userCreateUserCall) OnListMyGroupChannelsRaw(userID interface{}, listMyGroupChannelsRequest interface{}) *userListMyGroupChannelsCall {
+	return _c.Parent.OnListMyGroupChannelsRaw(userID, listMyGroupChannelsRequest)
+}
+
+func (_c *userCreateUserCall) OnMarkAllAsReadRaw(userID interface{}, channelURLs interface{}) *userMarkAllAsReadCall {
+	return _c.Parent.OnMarkAllAsReadRaw(userID, channelURLs)
+}
+
 func (_c *userCreateUserCall) OnUpdateUserRaw(userID interface{}, updateUserRequest interface{}) *userUpdateUserCall {
 	return _c.Parent.OnUpdateUserRaw(userID, updateUserRequest)
 }
@@ -246,10 +278,26 @@ func (_c *userGetGroupChannelCountCall) OnGetSessionToken(userID string, getSess
 	return _c.Parent.OnGetSessionToken(userID, getSessionTokenRequest)
 }
 
+func (_c *userGetGroupChannelCountCall) OnGetUnreadItemCount(userID string, getUnreadItemCountRequest GetUnreadItemCountRequest) *userGetUnreadItemCountCall {
+	return _c.Parent.OnGetUnreadItemCount(userID, getUnreadItemCountRequest)
+}
+
 func (_c *userGetGroupChannelCountCall) OnGetUnreadMessagesCount(userID string, getUnreadMessagesCountRequest GetUnreadMessagesCountRequest) *userGetUnreadMessagesCountCall {
 	return _c.Parent.OnGetUnreadMessagesCount(userID, getUnreadMessagesCountRequest)
 }
 
+func (_c *userGetGroupChannelCountCall) OnGetUnreadMessagesCountByChannel(userID string, getUnreadMessagesCountByChannelRequest GetUnreadMessagesCountByChannelRequest) *userGetUnreadMessagesCountByChannelCall {
+	return _c.Parent.OnGetUnreadMessagesCountByChannel(userID, getUnreadMessagesCountByChannelRequest)
+}
+
+func (_c *userGetGroupChannelCountCall) OnListMyGroupChannels(userID string, listMyGroupChannelsRequest ListMyGroupChannelsRequest) *userListMyGroupChannelsCall {
+	return _c.Parent.OnListMyGroupChannels(userID, listMyGroupChannelsRequest)
+}
+
+func (_c *userGetGroupChannelCountCall) OnMarkAllAsRead(userID string, channelURLs []string) *userMarkAllAsReadCall {
+	return _c.Parent.OnMarkAllAsRead(userID, channelURLs)
+}
+
 func (_c *userGetGroupChannelCountCall) OnUpdateUser(userID string, updateUserRequest UpdateUserRequest) *userUpdateUserCall {
 	return _c.Parent.OnUpdateUser(userID, updateUserRequest)
 }
@@ -266,10 +314,26 @@ func (_c *userGetGroupChannelCountCall) OnGetSessionTokenRaw(userID interface{},
 	return _c.Parent.OnGetSessionTokenRaw(userID, getSessionTokenRequest)
 }
 
+func (_c *userGetGroupChannelCountCall) OnGetUnreadItemCountRaw(userID interface{}, getUnreadItemCountRequest interface{}) *userGetUnreadItemCountCall {
+	return _c.Parent.OnGetUnreadItemCountRaw(userID, getUnreadItemCountRequest)
+}
+
 func (_c *userGetGroupChannelCountCall) OnGetUnreadMessagesCountRaw(userID interface{}, getUnreadMessagesCountRequest interface{}) *userGetUnreadMessagesCountCall {
 	return _c.Parent.OnGetUnreadMessagesCountRaw(userID, getUnreadMessagesCountRequest)
 }
 
+func (_c *userGetGroupChannelCountCall) OnGetUnreadMessagesCountByChannelRaw(userID interface{}, getUnreadMessagesCountByChannelRequest interface{}) *userGetUnreadMessagesCountByChannelCall {
+	return _c.Parent.OnGetUnreadMessagesCountByChannelRaw(userID, getUnreadMessagesCountByChannelRequest)
+}
+
+func (_c *userGetGroupChannelCountCall) OnListMyGroupChannelsRaw(userID interface{}, listMyGroupChannelsRequest interface{}) *userListMyGroupChannelsCall {
+	return _c.Parent.OnListMyGroupChannelsRaw(userID, listMyGroupChannelsRequest)
+}
+
+func (_c *userGetGroupChannelCountCall) OnMarkAllAsReadRaw(userID interface{}, channelURLs interface{}) *userMarkAllAsReadCall {
+	return _c.Parent.OnMarkAllAsReadRaw(userID, channelURLs)
+}
+
 func (_c *userGetGroupChannelCountCall) OnUpdateUserRaw(userID interface{}, updateUserRequest interface{}) *userUpdateUserCall {
 	return _c.Parent.OnUpdateUserRaw(userID, updateUserRequest)
 }
@@ -371,10 +435,26 @@ func (_c *userGetSessionTokenCall) OnGetSessionToken(userID string, getSessionTo
 	return _c.Parent.OnGetSessionToken(userID, getSessionTokenRequest)
 }
 
+func (_c *userGetSessionTokenCall) OnGetUnreadItemCount(userID string, getUnreadItemCountRequest GetUnreadItemCountRequest) *userGetUnreadItemCountCall {
+	return _c.Parent.OnGetUnreadItemCount(userID, getUnreadItemCountRequest)
+}
+
 func (_c *userGetSessionTokenCall) OnGetUnreadMessagesCount(userID string, getUnreadMessagesCountRequest GetUnreadMessagesCountRequest) *userGetUnreadMessagesCountCall {
 	return _c.Parent.OnGetUnreadMessagesCount(userID, getUnreadMessagesCountRequest)
 }
 
+func (_c *userGetSessionTokenCall) OnGetUnreadMessagesCountByChannel(userID string, getUnreadMessagesCountByChannelRequest GetUnreadMessagesCountByChannelRequest) *userGetUnreadMessagesCountByChannelCall {
+	return _c.Parent.OnGetUnreadMessagesCountByChannel(userID, getUnreadMessagesCountByChannelRequest)
+}
+
+func (_c *userGetSessionTokenCall) OnListMyGroupChannels(userID string, listMyGroupChannelsRequest ListMyGroupChannelsRequest) *userListMyGroupChannelsCall {
+	return _c.Parent.OnListMyGroupChannels(userID, listMyGroupChannelsRequest)
+}
+
+func (_c *userGetSessionTokenCall) OnMarkAllAsRead(userID string, channelURLs []string) *userMarkAllAsReadCall {
+	return _c.Parent.OnMarkAllAsRead(userID, channelURLs)
+}
+
 func (_c *userGetSessionTokenCall) OnUpdateUser(userID string, updateUserRequest UpdateUserRequest) *userUpdateUserCall {
 	return _c.Parent.OnUpdateUser(userID, updateUserRequest)
 }
@@ -391,14 +471,187 @@ func (_c *userGetSessionTokenCall) OnGetSessionTokenRaw(userID interface{}, getS
 	return _c.Parent.OnGetSessionTokenRaw(userID, getSessionTokenRequest)
 }
 
+func (_c *userGetSessionTokenCall) OnGetUnreadItemCountRaw(userID interface{}, getUnreadItemCountRequest interface{}) *userGetUnreadItemCountCall {
+	return _c.Parent.OnGetUnreadItemCountRaw(userID, getUnreadItemCountRequest)
+}
+
 func (_c *userGetSessionTokenCall) OnGetUnreadMessagesCountRaw(userID interface{}, getUnreadMessagesCountRequest interface{}) *userGetUnreadMessagesCountCall {
 	return _c.Parent.OnGetUnreadMessagesCountRaw(userID, getUnreadMessagesCountRequest)
 }
 
+func (_c *userGetSessionTokenCall) OnGetUnreadMessagesCountByChannelRaw(userID interface{}, getUnreadMessagesCountByChannelRequest interface{}) *userGetUnreadMessagesCountByChannelCall {
+	return _c.Parent.OnGetUnreadMessagesCountByChannelRaw(userID, getUnreadMessagesCountByChannelRequest)
+}
+
+func (_c *userGetSessionTokenCall) OnListMyGroupChannelsRaw(userID interface{}, listMyGroupChannelsRequest interface{}) *userListMyGroupChannelsCall {
+	return _c.Parent.OnListMyGroupChannelsRaw(userID, listMyGroupChannelsRequest)
+}
+
+func (_c *userGetSessionTokenCall) OnMarkAllAsReadRaw(userID interface{}, channelURLs interface{}) *userMarkAllAsReadCall {
+	return _c.Parent.OnMarkAllAsReadRaw(userID, channelURLs)
+}
+
 func (_c *userGetSessionTokenCall) OnUpdateUserRaw(userID interface{}, updateUserRequest interface{}) *userUpdateUserCall {
 	return _c.Parent.OnUpdateUserRaw(userID, updateUserRequest)
 }
 
+func (_m *userMock) GetUnreadItemCount(_ context.Context, userID string, getUnreadItemCountRequest GetUnreadItemCountRequest) (*GetUnreadItemCountResponse, error) {
+	_ret := _m.Called(userID, getUnreadItemCountRequest)
+
+	if _rf, ok := _ret.Get(0).(func(string, GetUnreadItemCountRequest) (*GetUnreadItemCountResponse, error)); ok {
+		return _rf(userID, getUnreadItemCountRequest)
+	}
+
+	_ra0, _ := _ret.Get(0).(*GetUnreadItemCountResponse)
+	_rb1 := _ret.Error(1)
+
+	return _ra0, _rb1
+}
+
+func (_m *userMock) OnGetUnreadItemCount(userID string, getUnreadItemCountRequest GetUnreadItemCountRequest) *userGetUnreadItemCountCall {
+	return &userGetUnreadItemCountCall{Call: _m.Mock.On("GetUnreadItemCount", userID, getUnreadItemCountRequest), Parent: _m}
+}
+
+func (_m *userMock) OnGetUnreadItemCountRaw(userID interface{}, getUnreadItemCountRequest interface{}) *userGetUnreadItemCountCall {
+	return &userGetUnreadItemCountCall{Call: _m.Mock.On("GetUnreadItemCount", userID, getUnreadItemCountRequest), Parent: _m}
+}
+
+type userGetUnreadItemCountCall struct {
+	*mock.Call
+	Parent *userMock
+}
+
+func (_c *userGetUnreadItemCountCall) Panic(msg string) *userGetUnreadItemCountCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *userGetUnreadItemCountCall) Once() *userGetUnreadItemCountCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *userGetUnreadItemCountCall) Twice() *userGetUnreadItemCountCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *userGetUnreadItemCountCall) Times(i int) *userGetUnreadItemCountCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *userGetUnreadItemCountCall) WaitUntil(w <-chan time.Time) *userGetUnreadItemCountCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *userGetUnreadItemCountCall) After(d time.Duration) *userGetUnreadItemCountCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *userGetUnreadItemCountCall) Run(fn func(args mock.Arguments)) *userGetUnreadItemCountCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *userGetUnreadItemCountCall) Maybe() *userGetUnreadItemCountCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *userGetUnreadItemCountCall) TypedReturns(a *GetUnreadItemCountResponse, b error) *userGetUnreadItemCountCall {
+	_c.Call = _c.Return(a, b)
+	return _c
+}
+
+func (_c *userGetUnreadItemCountCall) ReturnsFn(fn func(string, GetUnreadItemCountRequest) (*GetUnreadItemCountResponse, error)) *userGetUnreadItemCountCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *userGetUnreadItemCountCall) TypedRun(fn func(string, GetUnreadItemCountRequest)) *userGetUnreadItemCountCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_userID := args.String(0)
+		_getUnreadItemCountRequest, _ := args.Get(1).(GetUnreadItemCountRequest)
+		fn(_userID, _getUnreadItemCountRequest)
+	})
+	return _c
+}
+
+func (_c *userGetUnreadItemCountCall) OnCreateUser(createUserRequest CreateUserRequest) *userCreateUserCall {
+	return _c.Parent.OnCreateUser(createUserRequest)
+}
+
+func (_c *userGetUnreadItemCountCall) OnGetGroupChannelCount(userID string, getGroupChannelCountRequest GetGroupChannelCountRequest) *userGetGroupChannelCountCall {
+	return _c.Parent.OnGetGroupChannelCount(userID, getGroupChannelCountRequest)
+}
+
+func (_c *userGetUnreadItemCountCall) OnGetSessionToken(userID string, getSessionTokenRequest GetSessionTokenRequest) *userGetSessionTokenCall {
+	return _c.Parent.OnGetSessionToken(userID, getSessionTokenRequest)
+}
+
+func (_c *userGetUnreadItemCountCall) OnGetUnreadItemCount(userID string, getUnreadItemCountRequest GetUnreadItemCountRequest) *userGetUnreadItemCountCall {
+	return _c.Parent.OnGetUnreadItemCount(userID, getUnreadItemCountRequest)
+}
+
+func (_c *userGetUnreadItemCountCall) OnGetUnreadMessagesCount(userID string, getUnreadMessagesCountRequest GetUnreadMessagesCountRequest) *userGetUnreadMessagesCountCall {
+	return _c.Parent.OnGetUnreadMessagesCount(userID, getUnreadMessagesCountRequest)
+}
+
+func (_c *userGetUnreadItemCountCall) OnGetUnreadMessagesCountByChannel(userID string, getUnreadMessagesCountByChannelRequest GetUnreadMessagesCountByChannelRequest) *userGetUnreadMessagesCountByChannelCall {
+	return _c.Parent.OnGetUnreadMessagesCountByChannel(userID, getUnreadMessagesCountByChannelRequest)
+}
+
+func (_c *userGetUnreadItemCountCall) OnListMyGroupChannels(userID string, listMyGroupChannelsRequest ListMyGroupChannelsRequest) *userListMyGroupChannelsCall {
+	return _c.Parent.OnListMyGroupChannels(userID, listMyGroupChannelsRequest)
+}
+
+func (_c *userGetUnreadItemCountCall) OnMarkAllAsRead(userID string, channelURLs []string) *userMarkAllAsReadCall {
+	return _c.Parent.OnMarkAllAsRead(userID, channelURLs)
+}
+
+func (_c *userGetUnreadItemCountCall) OnUpdateUser(userID string, updateUserRequest UpdateUserRequest) *userUpdateUserCall {
+	return _c.Parent.OnUpdateUser(userID, updateUserRequest)
+}
+
+func (_c *userGetUnreadItemCountCall) OnCreateUserRaw(createUserRequest interface{}) *userCreateUserCall {
+	return _c.Parent.OnCreateUserRaw(createUserRequest)
+}
+
+func (_c *userGetUnreadItemCountCall) OnGetGroupChannelCountRaw(userID interface{}, getGroupChannelCountRequest interface{}) *userGetGroupChannelCountCall {
+	return _c.Parent.OnGetGroupChannelCountRaw(userID, getGroupChannelCountRequest)
+}
+
+func (_c *userGetUnreadItemCountCall) OnGetSessionTokenRaw(userID interface{}, getSessionTokenRequest interface{}) *userGetSessionTokenCall {
+	return _c.Parent.OnGetSessionTokenRaw(userID, getSessionTokenRequest)
+}
+
+func (_c *userGetUnreadItemCountCall) OnGetUnreadItemCountRaw(userID interface{}, getUnreadItemCountRequest interface{}) *userGetUnreadItemCountCall {
+	return _c.Parent.OnGetUnreadItemCountRaw(userID, getUnreadItemCountRequest)
+}
+
+func (_c *userGetUnreadItemCountCall) OnGetUnreadMessagesCountRaw(userID interface{}, getUnreadMessagesCountRequest interface{}) *userGetUnreadMessagesCountCall {
+	return _c.Parent.OnGetUnreadMessagesCountRaw(userID, getUnreadMessagesCountRequest)
+}
+
+func (_c *userGetUnreadItemCountCall) OnGetUnreadMessagesCountByChannelRaw(userID interface{}, getUnreadMessagesCountByChannelRequest interface{}) *userGetUnreadMessagesCountByChannelCall {
+	return _c.Parent.OnGetUnreadMessagesCountByChannelRaw(userID, getUnreadMessagesCountByChannelRequest)
+}
+
+func (_c *userGetUnreadItemCountCall) OnListMyGroupChannelsRaw(userID interface{}, listMyGroupChannelsRequest interface{}) *userListMyGroupChannelsCall {
+	return _c.Parent.OnListMyGroupChannelsRaw(userID, listMyGroupChannelsRequest)
+}
+
+func (_c *userGetUnreadItemCountCall) OnMarkAllAsReadRaw(userID interface{}, channelURLs interface{}) *userMarkAllAsReadCall {
+	return _c.Parent.OnMarkAllAsReadRaw(userID, channelURLs)
+}
+
+func (_c *userGetUnreadItemCountCall) OnUpdateUserRaw(userID interface{}, updateUserRequest interface{}) *userUpdateUserCall {
+	return _c.Parent.OnUpdateUserRaw(userID, updateUserRequest)
+}
+
 func (_m *userMock) GetUnreadMessagesCount(_ context.Context, userID string, getUnreadMessagesCountRequest GetUnreadMessagesCountRequest) (*GetUnreadMessagesCountResponse, error) {
 	_ret := _m.Called(userID, getUnreadMessagesCountRequest)
 
@@ -496,10 +749,26 @@ func (_c *userGetUnreadMessagesCountCall) OnGetSessionToken(userID string, getSe
 	return _c.Parent.OnGetSessionToken(userID, getSessionTokenRequest)
 }
 
+func (_c *userGetUnreadMessagesCountCall) OnGetUnreadItemCount(userID string, getUnreadItemCountRequest GetUnreadItemCountRequest) *userGetUnreadItemCountCall {
+	return _c.Parent.OnGetUnreadItemCount(userID, getUnreadItemCountRequest)
+}
+
 func (_c *userGetUnreadMessagesCountCall) OnGetUnreadMessagesCount(userID string, getUnreadMessagesCountRequest GetUnreadMessagesCountRequest) *userGetUnreadMessagesCountCall {
 	return _c.Parent.OnGetUnreadMessagesCount(userID, getUnreadMessagesCountRequest)
 }
 
+func (_c *userGetUnreadMessagesCountCall) OnGetUnreadMessagesCountByChannel(userID string, getUnreadMessagesCountByChannelRequest GetUnreadMessagesCountByChannelRequest) *userGetUnreadMessagesCountByChannelCall {
+	return _c.Parent.OnGetUnreadMessagesCountByChannel(userID, getUnreadMessagesCountByChannelRequest)
+}
+
+func (_c *userGetUnreadMessagesCountCall) OnListMyGroupChannels(userID string, listMyGroupChannelsRequest ListMyGroupChannelsRequest) *userListMyGroupChannelsCall {
+	return _c.Parent.OnListMyGroupChannels(userID, listMyGroupChannelsRequest)
+}
+
+func (_c *userGetUnreadMessagesCountCall) OnMarkAllAsRead(userID string, channelURLs []string) *userMarkAllAsReadCall {
+	return _c.Parent.OnMarkAllAsRead(userID, channelURLs)
+}
+
 func (_c *userGetUnreadMessagesCountCall) OnUpdateUser(userID string, updateUserRequest UpdateUserRequest) *userUpdateUserCall {
 	return _c.Parent.OnUpdateUser(userID, updateUserRequest)
 }
@@ -516,14 +785,500 @@ func (_c *userGetUnreadMessagesCountCall) OnGetSessionTokenRaw(userID interface{
 	return _c.Parent.OnGetSessionTokenRaw(userID, getSessionTokenRequest)
 }
 
+func (_c *userGetUnreadMessagesCountCall) OnGetUnreadItemCountRaw(userID interface{}, getUnreadItemCountRequest interface{}) *userGetUnreadItemCountCall {
+	return _c.Parent.OnGetUnreadItemCountRaw(userID, getUnreadItemCountRequest)
+}
+
 func (_c *userGetUnreadMessagesCountCall) OnGetUnreadMessagesCountRaw(userID interface{}, getUnreadMessagesCountRequest interface{}) *userGetUnreadMessagesCountCall {
 	return _c.Parent.OnGetUnreadMessagesCountRaw(userID, getUnreadMessagesCountRequest)
 }
 
+func (_c *userGetUnreadMessagesCountCall) OnGetUnreadMessagesCountByChannelRaw(userID interface{}, getUnreadMessagesCountByChannelRequest interface{}) *userGetUnreadMessagesCountByChannelCall {
+	return _c.Parent.OnGetUnreadMessagesCountByChannelRaw(userID, getUnreadMessagesCountByChannelRequest)
+}
+
+func (_c *userGetUnreadMessagesCountCall) OnListMyGroupChannelsRaw(userID interface{}, listMyGroupChannelsRequest interface{}) *userListMyGroupChannelsCall {
+	return _c.Parent.OnListMyGroupChannelsRaw(userID, listMyGroupChannelsRequest)
+}
+
+func (_c *userGetUnreadMessagesCountCall) OnMarkAllAsReadRaw(userID interface{}, channelURLs interface{}) *userMarkAllAsReadCall {
+	return _c.Parent.OnMarkAllAsReadRaw(userID, channelURLs)
+}
+
 func (_c *userGetUnreadMessagesCountCall) OnUpdateUserRaw(userID interface{}, updateUserRequest interface{}) *userUpdateUserCall {
 	return _c.Parent.OnUpdateUserRaw(userID, updateUserRequest)
 }
 
+func (_m *userMock) GetUnreadMessagesCountByChannel(_ context.Context, userID string, getUnreadMessagesCountByChannelRequest GetUnreadMessagesCountByChannelRequest) (*GetUnreadMessagesCountByChannelResponse, error) {
+	_ret := _m.Called(userID, getUnreadMessagesCountByChannelRequest)
+
+	if _rf, ok := _ret.Get(0).(func(string, GetUnreadMessagesCountByChannelRequest) (*GetUnreadMessagesCountByChannelResponse, error)); ok {
+		return _rf(userID, getUnreadMessagesCountByChannelRequest)
+	}
+
+	_ra0, _ := _ret.Get(0).(*GetUnreadMessagesCountByChannelResponse)
+	_rb1 := _ret.Error(1)
+
+	return _ra0, _rb1
+}
+
+func (_m *userMock) OnGetUnreadMessagesCountByChannel(userID string, getUnreadMessagesCountByChannelRequest GetUnreadMessagesCountByChannelRequest) *userGetUnreadMessagesCountByChannelCall {
+	return &userGetUnreadMessagesCountByChannelCall{Call: _m.Mock.On("GetUnreadMessagesCountByChannel", userID, getUnreadMessagesCountByChannelRequest), Parent: _m}
+}
+
+func (_m *userMock) OnGetUnreadMessagesCountByChannelRaw(userID interface{}, getUnreadMessagesCountByChannelRequest interface{}) *userGetUnreadMessagesCountByChannelCall {
+	return &userGetUnreadMessagesCountByChannelCall{Call: _m.Mock.On("GetUnreadMessagesCountByChannel", userID, getUnreadMessagesCountByChannelRequest), Parent: _m}
+}
+
+type userGetUnreadMessagesCountByChannelCall struct {
+	*mock.Call
+	Parent *userMock
+}
+
+func (_c *userGetUnreadMessagesCountByChannelCall) Panic(msg string) *userGetUnreadMessagesCountByChannelCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *userGetUnreadMessagesCountByChannelCall) Once() *userGetUnreadMessagesCountByChannelCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *userGetUnreadMessagesCountByChannelCall) Twice() *userGetUnreadMessagesCountByChannelCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *userGetUnreadMessagesCountByChannelCall) Times(i int) *userGetUnreadMessagesCountByChannelCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *userGetUnreadMessagesCountByChannelCall) WaitUntil(w <-chan time.Time) *userGetUnreadMessagesCountByChannelCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *userGetUnreadMessagesCountByChannelCall) After(d time.Duration) *userGetUnreadMessagesCountByChannelCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *userGetUnreadMessagesCountByChannelCall) Run(fn func(args mock.Arguments)) *userGetUnreadMessagesCountByChannelCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *userGetUnreadMessagesCountByChannelCall) Maybe() *userGetUnreadMessagesCountByChannelCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *userGetUnreadMessagesCountByChannelCall) TypedReturns(a *GetUnreadMessagesCountByChannelResponse, b error) *userGetUnreadMessagesCountByChannelCall {
+	_c.Call = _c.Return(a, b)
+	return _c
+}
+
+func (_c *userGetUnreadMessagesCountByChannelCall) ReturnsFn(fn func(string, GetUnreadMessagesCountByChannelRequest) (*GetUnreadMessagesCountByChannelResponse, error)) *userGetUnreadMessagesCountByChannelCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *userGetUnreadMessagesCountByChannelCall) TypedRun(fn func(string, GetUnreadMessagesCountByChannelRequest)) *userGetUnreadMessagesCountByChannelCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_userID := args.String(0)
+		_getUnreadMessagesCountByChannelRequest, _ := args.Get(1).(GetUnreadMessagesCountByChannelRequest)
+		fn(_userID, _getUnreadMessagesCountByChannelRequest)
+	})
+	return _c
+}
+
+func (_c *userGetUnreadMessagesCountByChannelCall) OnCreateUser(createUserRequest CreateUserRequest) *userCreateUserCall {
+	return _c.Parent.OnCreateUser(createUserRequest)
+}
+
+func (_c *userGetUnreadMessagesCountByChannelCall) OnGetGroupChannelCount(userID string, getGroupChannelCountRequest GetGroupChannelCountRequest) *userGetGroupChannelCountCall {
+	return _c.Parent.OnGetGroupChannelCount(userID, getGroupChannelCountRequest)
+}
+
+func (_c *userGetUnreadMessagesCountByChannelCall) OnGetSessionToken(userID string, getSessionTokenRequest GetSessionTokenRequest) *userGetSessionTokenCall {
+	return _c.Parent.OnGetSessionToken(userID, getSessionTokenRequest)
+}
+
+func (_c *userGetUnreadMessagesCountByChannelCall) OnGetUnreadItemCount(userID string, getUnreadItemCountRequest GetUnreadItemCountRequest) *userGetUnreadItemCountCall {
+	return _c.Parent.OnGetUnreadItemCount(userID, getUnreadItemCountRequest)
+}
+
+func (_c *userGetUnreadMessagesCountByChannelCall) OnGetUnreadMessagesCount(userID string, getUnreadMessagesCountRequest GetUnreadMessagesCountRequest) *userGetUnreadMessagesCountCall {
+	return _c.Parent.OnGetUnreadMessagesCount(userID, getUnreadMessagesCountRequest)
+}
+
+func (_c *userGetUnreadMessagesCountByChannelCall) OnGetUnreadMessagesCountByChannel(userID string, getUnreadMessagesCountByChannelRequest GetUnreadMessagesCountByChannelRequest) *userGetUnreadMessagesCountByChannelCall {
+	return _c.Parent.OnGetUnreadMessagesCountByChannel(userID, getUnreadMessagesCountByChannelRequest)
+}
+
+func (_c *userGetUnreadMessagesCountByChannelCall) OnListMyGroupChannels(userID string, listMyGroupChannelsRequest ListMyGroupChannelsRequest) *userListMyGroupChannelsCall {
+	return _c.Parent.OnListMyGroupChannels(userID, listMyGroupChannelsRequest)
+}
+
+func (_c *userGetUnreadMessagesCountByChannelCall) OnMarkAllAsRead(userID string, channelURLs []string) *userMarkAllAsReadCall {
+	return _c.Parent.OnMarkAllAsRead(userID, channelURLs)
+}
+
+func (_c *userGetUnreadMessagesCountByChannelCall) OnUpdateUser(userID string, updateUserRequest UpdateUserRequest) *userUpdateUserCall {
+	return _c.Parent.OnUpdateUser(userID, updateUserRequest)
+}
+
+func (_c *userGetUnreadMessagesCountByChannelCall) OnCreateUserRaw(createUserRequest interface{}) *userCreateUserCall {
+	return _c.Parent.OnCreateUserRaw(createUserRequest)
+}
+
+func (_c *userGetUnreadMessagesCountByChannelCall) OnGetGroupChannelCountRaw(userID interface{}, getGroupChannelCountRequest interface{}) *userGetGroupChannelCountCall {
+	return _c.Parent.OnGetGroupChannelCountRaw(userID, getGroupChannelCountRequest)
+}
+
+func (_c *userGetUnreadMessagesCountByChannelCall) OnGetSessionTokenRaw(userID interface{}, getSessionTokenRequest interface{}) *userGetSessionTokenCall {
+	return _c.Parent.OnGetSessionTokenRaw(userID, getSessionTokenRequest)
+}
+
+func (_c *userGetUnreadMessagesCountByChannelCall) OnGetUnreadItemCountRaw(userID interface{}, getUnreadItemCountRequest interface{}) *userGetUnreadItemCountCall {
+	return _c.Parent.OnGetUnreadItemCountRaw(userID, getUnreadItemCountRequest)
+}
+
+func (_c *userGetUnreadMessagesCountByChannelCall) OnGetUnreadMessagesCountRaw(userID interface{}, getUnreadMessagesCountRequest interface{}) *userGetUnreadMessagesCountCall {
+	return _c.Parent.OnGetUnreadMessagesCountRaw(userID, getUnreadMessagesCountRequest)
+}
+
+func (_c *userGetUnreadMessagesCountByChannelCall) OnGetUnreadMessagesCountByChannelRaw(userID interface{}, getUnreadMessagesCountByChannelRequest interface{}) *userGetUnreadMessagesCountByChannelCall {
+	return _c.Parent.OnGetUnreadMessagesCountByChannelRaw(userID, getUnreadMessagesCountByChannelRequest)
+}
+
+func (_c *userGetUnreadMessagesCountByChannelCall) OnListMyGroupChannelsRaw(userID interface{}, listMyGroupChannelsRequest interface{}) *userListMyGroupChannelsCall {
+	return _c.Parent.OnListMyGroupChannelsRaw(userID, listMyGroupChannelsRequest)
+}
+
+func (_c *userGetUnreadMessagesCountByChannelCall) OnMarkAllAsReadRaw(userID interface{}, channelURLs interface{}) *userMarkAllAsReadCall {
+	return _c.Parent.OnMarkAllAsReadRaw(userID, channelURLs)
+}
+
+func (_c *userGetUnreadMessagesCountByChannelCall) OnUpdateUserRaw(userID interface{}, updateUserRequest interface{}) *userUpdateUserCall {
+	return _c.Parent.OnUpdateUserRaw(userID, updateUserRequest)
+}
+
+func (_m *userMock) ListMyGroupChannels(_ context.Context, userID string, listMyGroupChannelsRequest ListMyGroupChannelsRequest) (*ListMyGroupChannelsResponse, error) {
+	_ret := _m.Called(userID, listMyGroupChannelsRequest)
+
+	if _rf, ok := _ret.Get(0).(func(string, ListMyGroupChannelsRequest) (*ListMyGroupChannelsResponse, error)); ok {
+		return _rf(userID, listMyGroupChannelsRequest)
+	}
+
+	_ra0, _ := _ret.Get(0).(*ListMyGroupChannelsResponse)
+	_rb1 := _ret.Error(1)
+
+	return _ra0, _rb1
+}
+
+func (_m *userMock) OnListMyGroupChannels(userID string, listMyGroupChannelsRequest ListMyGroupChannelsRequest) *userListMyGroupChannelsCall {
+	return &userListMyGroupChannelsCall{Call: _m.Mock.On("ListMyGroupChannels", userID, listMyGroupChannelsRequest), Parent: _m}
+}
+
+func (_m *userMock) OnListMyGroupChannelsRaw(userID interface{}, listMyGroupChannelsRequest interface{}) *userListMyGroupChannelsCall {
+	return &userListMyGroupChannelsCall{Call: _m.Mock.On("ListMyGroupChannels", userID, listMyGroupChannelsRequest), Parent: _m}
+}
+
+type userListMyGroupChannelsCall struct {
+	*mock.Call
+	Parent *userMock
+}
+
+func (_c *userListMyGroupChannelsCall) Panic(msg string) *userListMyGroupChannelsCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *userListMyGroupChannelsCall) Once() *userListMyGroupChannelsCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *userListMyGroupChannelsCall) Twice() *userListMyGroupChannelsCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *userListMyGroupChannelsCall) Times(i int) *userListMyGroupChannelsCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *userListMyGroupChannelsCall) WaitUntil(w <-chan time.Time) *userListMyGroupChannelsCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *userListMyGroupChannelsCall) After(d time.Duration) *userListMyGroupChannelsCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *userListMyGroupChannelsCall) Run(fn func(args mock.Arguments)) *userListMyGroupChannelsCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *userListMyGroupChannelsCall) Maybe() *userListMyGroupChannelsCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *userListMyGroupChannelsCall) TypedReturns(a *ListMyGroupChannelsResponse, b error) *userListMyGroupChannelsCall {
+	_c.Call = _c.Return(a, b)
+	return _c
+}
+
+func (_c *userListMyGroupChannelsCall) ReturnsFn(fn func(string, ListMyGroupChannelsRequest) (*ListMyGroupChannelsResponse, error)) *userListMyGroupChannelsCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *userListMyGroupChannelsCall) TypedRun(fn func(string, ListMyGroupChannelsRequest)) *userListMyGroupChannelsCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_userID := args.String(0)
+		_listMyGroupChannelsRequest, _ := args.Get(1).(ListMyGroupChannelsRequest)
+		fn(_userID, _listMyGroupChannelsRequest)
+	})
+	return _c
+}
+
+func (_c *userListMyGroupChannelsCall) OnCreateUser(createUserRequest CreateUserRequest) *userCreateUserCall {
+	return _c.Parent.OnCreateUser(createUserRequest)
+}
+
+func (_c *userListMyGroupChannelsCall) OnGetGroupChannelCount(userID string, getGroupChannelCountRequest GetGroupChannelCountRequest) *userGetGroupChannelCountCall {
+	return _c.Parent.OnGetGroupChannelCount(userID, getGroupChannelCountRequest)
+}
+
+func (_c *userListMyGroupChannelsCall) OnGetSessionToken(userID string, getSessionTokenRequest GetSessionTokenRequest) *userGetSessionTokenCall {
+	return _c.Parent.OnGetSessionToken(userID, getSessionTokenRequest)
+}
+
+func (_c *userListMyGroupChannelsCall) OnGetUnreadItemCount(userID string, getUnreadItemCountRequest GetUnreadItemCountRequest) *userGetUnreadItemCountCall {
+	return _c.Parent.OnGetUnreadItemCount(userID, getUnreadItemCountRequest)
+}
+
+func (_c *userListMyGroupChannelsCall) OnGetUnreadMessagesCount(userID string, getUnreadMessagesCountRequest GetUnreadMessagesCountRequest) *userGetUnreadMessagesCountCall {
+	return _c.Parent.OnGetUnreadMessagesCount(userID, getUnreadMessagesCountRequest)
+}
+
+func (_c *userListMyGroupChannelsCall) OnGetUnreadMessagesCountByChannel(userID string, getUnreadMessagesCountByChannelRequest GetUnreadMessagesCountByChannelRequest) *userGetUnreadMessagesCountByChannelCall {
+	return _c.Parent.OnGetUnreadMessagesCountByChannel(userID, getUnreadMessagesCountByChannelRequest)
+}
+
+func (_c *userListMyGroupChannelsCall) OnListMyGroupChannels(userID string, listMyGroupChannelsRequest ListMyGroupChannelsRequest) *userListMyGroupChannelsCall {
+	return _c.Parent.OnListMyGroupChannels(userID, listMyGroupChannelsRequest)
+}
+
+func (_c *userListMyGroupChannelsCall) OnMarkAllAsRead(userID string, channelURLs []string) *userMarkAllAsReadCall {
+	return _c.Parent.OnMarkAllAsRead(userID, channelURLs)
+}
+
+func (_c *userListMyGroupChannelsCall) OnUpdateUser(userID string, updateUserRequest UpdateUserRequest) *userUpdateUserCall {
+	return _c.Parent.OnUpdateUser(userID, updateUserRequest)
+}
+
+func (_c *userListMyGroupChannelsCall) OnCreateUserRaw(createUserRequest interface{}) *userCreateUserCall {
+	return _c.Parent.OnCreateUserRaw(createUserRequest)
+}
+
+func (_c *userListMyGroupChannelsCall) OnGetGroupChannelCountRaw(userID interface{}, getGroupChannelCountRequest interface{}) *userGetGroupChannelCountCall {
+	return _c.Parent.OnGetGroupChannelCountRaw(userID, getGroupChannelCountRequest)
+}
+
+func (_c *userListMyGroupChannelsCall) OnGetSessionTokenRaw(userID interface{}, getSessionTokenRequest interface{}) *userGetSessionTokenCall {
+	return _c.Parent.OnGetSessionTokenRaw(userID, getSessionTokenRequest)
+}
+
+func (_c *userListMyGroupChannelsCall) OnGetUnreadItemCountRaw(userID interface{}, getUnreadItemCountRequest interface{}) *userGetUnreadItemCountCall {
+	return _c.Parent.OnGetUnreadItemCountRaw(userID, getUnreadItemCountRequest)
+}
+
+func (_c *userListMyGroupChannelsCall) OnGetUnreadMessagesCountRaw(userID interface{}, getUnreadMessagesCountRequest interface{}) *userGetUnreadMessagesCountCall {
+	return _c.Parent.OnGetUnreadMessagesCountRaw(userID, getUnreadMessagesCountRequest)
+}
+
+func (_c *userListMyGroupChannelsCall) OnGetUnreadMessagesCountByChannelRaw(userID interface{}, getUnreadMessagesCountByChannelRequest interface{}) *userGetUnreadMessagesCountByChannelCall {
+	return _c.Parent.OnGetUnreadMessagesCountByChannelRaw(userID, getUnreadMessagesCountByChannelRequest)
+}
+
+func (_c *userListMyGroupChannelsCall) OnListMyGroupChannelsRaw(userID interface{}, listMyGroupChannelsRequest interface{}) *userListMyGroupChannelsCall {
+	return _c.Parent.OnListMyGroupChannelsRaw(userID, listMyGroupChannelsRequest)
+}
+
+func (_c *userListMyGroupChannelsCall) OnMarkAllAsReadRaw(userID interface{}, channelURLs interface{}) *userMarkAllAsReadCall {
+	return _c.Parent.OnMarkAllAsReadRaw(userID, channelURLs)
+}
+
+func (_c *userListMyGroupChannelsCall) OnUpdateUserRaw(userID interface{}, updateUserRequest interface{}) *userUpdateUserCall {
+	return _c.Parent.OnUpdateUserRaw(userID, updateUserRequest)
+}
+
+func (_m *userMock) MarkAllAsRead(_ context.Context, userID string, channelURLs []string) error {
+	_ret := _m.Called(userID, channelURLs)
+
+	if _rf, ok := _ret.Get(0).(func(string, []string) error); ok {
+		return _rf(userID, channelURLs)
+	}
+
+	_ra0 := _ret.Error(0)
+
+	return _ra0
+}
+
+func (_m *userMock) OnMarkAllAsRead(userID string, channelURLs []string) *userMarkAllAsReadCall {
+	return &userMarkAllAsReadCall{Call: _m.Mock.On("MarkAllAsRead", userID, channelURLs), Parent: _m}
+}
+
+func (_m *userMock) OnMarkAllAsReadRaw(userID interface{}, channelURLs interface{}) *userMarkAllAsReadCall {
+	return &userMarkAllAsReadCall{Call: _m.Mock.On("MarkAllAsRead", userID, channelURLs), Parent: _m}
+}
+
+type userMarkAllAsReadCall struct {
+	*mock.Call
+	Parent *userMock
+}
+
+func (_c *userMarkAllAsReadCall) Panic(msg string) *userMarkAllAsReadCall {
+	_c.Call = _c.Call.Panic(msg)
+	return _c
+}
+
+func (_c *userMarkAllAsReadCall) Once() *userMarkAllAsReadCall {
+	_c.Call = _c.Call.Once()
+	return _c
+}
+
+func (_c *userMarkAllAsReadCall) Twice() *userMarkAllAsReadCall {
+	_c.Call = _c.Call.Twice()
+	return _c
+}
+
+func (_c *userMarkAllAsReadCall) Times(i int) *userMarkAllAsReadCall {
+	_c.Call = _c.Call.Times(i)
+	return _c
+}
+
+func (_c *userMarkAllAsReadCall) WaitUntil(w <-chan time.Time) *userMarkAllAsReadCall {
+	_c.Call = _c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *userMarkAllAsReadCall) After(d time.Duration) *userMarkAllAsReadCall {
+	_c.Call = _c.Call.After(d)
+	return _c
+}
+
+func (_c *userMarkAllAsReadCall) Run(fn func(args mock.Arguments)) *userMarkAllAsReadCall {
+	_c.Call = _c.Call.Run(fn)
+	return _c
+}
+
+func (_c *userMarkAllAsReadCall) Maybe() *userMarkAllAsReadCall {
+	_c.Call = _c.Call.Maybe()
+	return _c
+}
+
+func (_c *userMarkAllAsReadCall) TypedReturns(a error) *userMarkAllAsReadCall {
+	_c.Call = _c.Return(a)
+	return _c
+}
+
+func (_c *userMarkAllAsReadCall) ReturnsFn(fn func(string, []string) error) *userMarkAllAsReadCall {
+	_c.Call = _c.Return(fn)
+	return _c
+}
+
+func (_c *userMarkAllAsReadCall) TypedRun(fn func(string, []string)) *userMarkAllAsReadCall {
+	_c.Call = _c.Call.Run(func(args mock.Arguments) {
+		_userID := args.String(0)
+		_channelURLs, _ := args.Get(1).([]string)
+		fn(_userID, _channelURLs)
+	})
+	return _c
+}
+
+func (_c *userMarkAllAsReadCall) OnCreateUser(createUserRequest CreateUserRequest) *userCreateUserCall {
+	return _c.Parent.OnCreateUser(createUserRequest)
+}
+
+func (_c *userMarkAllAsReadCall) OnGetGroupChannelCount(userID string, getGroupChannelCountRequest GetGroupChannelCountRequest) *userGetGroupChannelCountCall {
+	return _c.Parent.OnGetGroupChannelCount(userID, getGroupChannelCountRequest)
+}
+
+func (_c *userMarkAllAsReadCall) OnGetSessionToken(userID string, getSessionTokenRequest GetSessionTokenRequest) *userGetSessionTokenCall {
+	return _c.Parent.OnGetSessionToken(userID, getSessionTokenRequest)
+}
+
+func (_c *userMarkAllAsReadCall) OnGetUnreadItemCount(userID string, getUnreadItemCountRequest GetUnreadItemCountRequest) *userGetUnreadItemCountCall {
+	return _c.Parent.OnGetUnreadItemCount(userID, getUnreadItemCountRequest)
+}
+
+func (_c *userMarkAllAsReadCall) OnGetUnreadMessagesCount(userID string, getUnreadMessagesCountRequest GetUnreadMessagesCountRequest) *userGetUnreadMessagesCountCall {
+	return _c.Parent.OnGetUnreadMessagesCount(userID, getUnreadMessagesCountRequest)
+}
+
+func (_c *userMarkAllAsReadCall) OnGetUnreadMessagesCountByChannel(userID string, getUnreadMessagesCountByChannelRequest GetUnreadMessagesCountByChannelRequest) *userGetUnreadMessagesCountByChannelCall {
+	return _c.Parent.OnGetUnreadMessagesCountByChannel(userID, getUnreadMessagesCountByChannelRequest)
+}
+
+func (_c *userMarkAllAsReadCall) OnListMyGroupChannels(userID string, listMyGroupChannelsRequest ListMyGroupChannelsRequest) *userListMyGroupChannelsCall {
+	return _c.Parent.OnListMyGroupChannels(userID, listMyGroupChannelsRequest)
+}
+
+func (_c *userMarkAllAsReadCall) OnMarkAllAsRead(userID string, channelURLs []string) *userMarkAllAsReadCall {
+	return _c.Parent.OnMarkAllAsRead(userID, channelURLs)
+}
+
+func (_c *userMarkAllAsReadCall) OnUpdateUser(userID string, updateUserRequest UpdateUserRequest) *userUpdateUserCall {
+	return _c.Parent.OnUpdateUser(userID, updateUserRequest)
+}
+
+func (_c *userMarkAllAsReadCall) OnCreateUserRaw(createUserRequest interface{}) *userCreateUserCall {
+	return _c.Parent.OnCreateUserRaw(createUserRequest)
+}
+
+func (_c *userMarkAllAsReadCall) OnGetGroupChannelCountRaw(userID interface{}, getGroupChannelCountRequest interface{}) *userGetGroupChannelCountCall {
+	return _c.Parent.OnGetGroupChannelCountRaw(userID, getGroupChannelCountRequest)
+}
+
+func (_c *userMarkAllAsReadCall) OnGetSessionTokenRaw(userID interface{}, getSessionTokenRequest interface{}) *userGetSessionTokenCall {
+	return _c.Parent.OnGetSessionTokenRaw(userID, getSessionTokenRequest)
+}
+
+func (_c *userMarkAllAsReadCall) OnGetUnreadItemCountRaw(userID interface{}, getUnreadItemCountRequest interface{}) *userGetUnreadItemCountCall {
+	return _c.Parent.OnGetUnreadItemCountRaw(userID, getUnreadItemCountRequest)
+}
+
+func (_c *userMarkAllAsReadCall) OnGetUnreadMessagesCountRaw(userID interface{}, getUnreadMessagesCountRequest interface{}) *userGetUnreadMessagesCountCall {
+	return _c.Parent.OnGetUnreadMessagesCountRaw(userID, getUnreadMessagesCountRequest)
+}
+
+func (_c *userMarkAllAsReadCall) OnGetUnreadMessagesCountByChannelRaw(userID interface{}, getUnreadMessagesCountByChannelRequest interface{}) *userGetUnreadMessagesCountByChannelCall {
+	return _c.Parent.OnGetUnreadMessagesCountByChannelRaw(userID, getUnreadMessagesCountByChannelRequest)
+}
+
+func (_c *userMarkAllAsReadCall) OnListMyGroupChannelsRaw(userID interface{}, listMyGroupChannelsRequest interface{}) *userListMyGroupChannelsCall {
+	return _c.Parent.OnListMyGroupChannelsRaw(userID, listMyGroupChannelsRequest)
+}
+
+func (_c *userMarkAllAsReadCall) OnMarkAllAsReadRaw(userID interface{}, channelURLs interface{}) *userMarkAllAsReadCall {
+	return _c.Parent.OnMarkAllAsReadRaw(userID, channelURLs)
+}
+
+func (_c *userMarkAllAsReadCall) OnUpdateUserRaw(userID interface{}, updateUserRequest interface{}) *userUpdateUserCall {
+	return _c.Parent.OnUpdateUserRaw(userID, updateUserRequest)
+}
+
 func (_m *userMock) UpdateUser(_ context.Context, userID string, updateUserRequest UpdateUserRequest) (*UpdateUserResponse, error) {
 	_ret := _m.Called(userID, updateUserRequest)
 
@@ -621,10 +1376,26 @@ func (_c *userUpdateUserCall) OnGetSessionToken(userID string, getSessionTokenRe
 	return _c.Parent.OnGetSessionToken(userID, getSessionTokenRequest)
 }
 
+func (_c *userUpdateUserCall) OnGetUnreadItemCount(userID string, getUnreadItemCountRequest GetUnreadItemCountRequest) *userGetUnreadItemCountCall {
+	return _c.Parent.OnGetUnreadItemCount(userID, getUnreadItemCountRequest)
+}
+
 func (_c *userUpdateUserCall) OnGetUnreadMessagesCount(userID string, getUnreadMessagesCountRequest GetUnreadMessagesCountRequest) *userGetUnreadMessagesCountCall {
 	return _c.Parent.OnGetUnreadMessagesCount(userID, getUnreadMessagesCountRequest)
 }
 
+func (_c *userUpdateUserCall) OnGetUnreadMessagesCountByChannel(userID string, getUnreadMessagesCountByChannelRequest GetUnreadMessagesCountByChannelRequest) *userGetUnreadMessagesCountByChannelCall {
+	return _c.Parent.OnGetUnreadMessagesCountByChannel(userID, getUnreadMessagesCountByChannelRequest)
+}
+
+func (_c *userUpdateUserCall) OnListMyGroupChannels(userID string, listMyGroupChannelsRequest ListMyGroupChannelsRequest) *userListMyGroupChannelsCall {
+	return _c.Parent.OnListMyGroupChannels(userID, listMyGroupChannelsRequest)
+}
+
+func (_c *userUpdateUserCall) OnMarkAllAsRead(userID string, channelURLs []string) *userMarkAllAsReadCall {
+	return _c.Parent.OnMarkAllAsRead(userID, channelURLs)
+}
+
 func (_c *userUpdateUserCall) OnUpdateUser(userID string, updateUserRequest UpdateUserRequest) *userUpdateUserCall {
 	return _c.Parent.OnUpdateUser(userID, updateUserRequest)
 }
@@ -641,10 +1412,26 @@ func (_c *userUpdateUserCall) OnGetSessionTokenRaw(userID interface{}, getSessio
 	return _c.Parent.OnGetSessionTokenRaw(userID, getSessionTokenRequest)
 }
 
+func (_c *userUpdateUserCall) OnGetUnreadItemCountRaw(userID interface{}, getUnreadItemCountRequest interface{}) *userGetUnreadItemCountCall {
+	return _c.Parent.OnGetUnreadItemCountRaw(userID, getUnreadItemCountRequest)
+}
+
 func (_c *userUpdateUserCall) OnGetUnreadMessagesCountRaw(userID interface{}, getUnreadMessagesCountRequest interface{}) *userGetUnreadMessagesCountCall {
 	return _c.Parent.OnGetUnreadMessagesCountRaw(userID, getUnreadMessagesCountRequest)
 }
 
+func (_c *userUpdateUserCall) OnGetUnreadMessagesCountByChannelRaw(userID interface{}, getUnreadMessagesCountByChannelRequest interface{}) *userGetUnreadMessagesCountByChannelCall {
+	return _c.Parent.OnGetUnreadMessagesCountByChannelRaw(userID, getUnreadMessagesCountByChannelRequest)
+}
+
+func (_c *userUpdateUserCall) OnListMyGroupChannelsRaw(userID interface{}, listMyGroupChannelsRequest interface{}) *userListMyGroupChannelsCall {
+	return _c.Parent.OnListMyGroupChannelsRaw(userID, listMyGroupChannelsRequest)
+}
+
+func (_c *userUpdateUserCall) OnMarkAllAsReadRaw(userID interface{}, channelURLs interface{}) *userMarkAllAsReadCall {
+	return _c.Parent.OnMarkAllAsReadRaw(userID, channelURLs)
+}
+
 func (_c *userUpdateUserCall) OnUpdateUserRaw(userID interface{}, updateUserRequest interface{}) *userUpdateUserCall {
 	return _c.Parent.OnUpdateUserRaw(userID, updateUserRequest)
 }
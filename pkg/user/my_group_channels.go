@@ -0,0 +1,399 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/yumi-ia/sendbird-go/pkg/channel"
+	strconvSlice "github.com/yumi-ia/sendbird-go/pkg/utils/strconv"
+)
+
+// ListMyGroupChannelsRequest is the request to list the group channels of a
+// user.
+type ListMyGroupChannelsRequest struct {
+	// Token specifies a page token that indicates the starting index of a chunk
+	// of results. If not specified, the index is set as 0.
+	// Optional.
+	Token string
+	// Limit specifies the number of results to return per page. Acceptable
+	// values are 1 to 100, inclusive. (Default: 10)
+	// Optional.
+	Limit *int
+	// DistinctMode restricts the search scope to only retrieve distinct or
+	// nondistinct group channels. (Default: channel.DistinctModeAll)
+	// Optional.
+	DistinctMode channel.DistinctMode
+	// PublicMode restricts the search scope to only retrieve either public or
+	// private group channels. (Default: channel.PublicModeAll)
+	// Optional.
+	PublicMode channel.PublicMode
+	// SuperMode restricts the search scope to either Supergroup channels or
+	// non-Supergroup channels or both. (Default: SuperModeAll)
+	// Optional.
+	SuperMode SuperMode
+	// HiddenMode restricts the search scope to group channels that match a
+	// specific hidden_status and operating behavior. Acceptable values are the
+	// following:
+	// - ModeUnHiddenOnly (default): Specifies channels which the user joined
+	// with the unhidden status.
+	// - ModeHiddenOnly: Specifies all channels which the user joined with either
+	// the hidden_allow_auto_unhide or hidden_prevent_auto_unhide status.
+	// - ModeHiddenAllowAutoUnhide: Specifies channels which the user joined with
+	// the hidden_allow_auto_unhide status.
+	// - ModeHiddenPreventAutoUnhide: Specifies channels which the user joined
+	// with the hidden_prevent_auto_unhide status.
+	// - ModeAll: Specifies all channels regardless of their hidden_status.
+	// Optional.
+	HiddenMode Mode
+	// MemberStateFilter restricts the search scope to only retrieve group
+	// channels with the specified membership state of the user. Acceptable
+	// values are the following:
+	// - MemberStateFilterAll (default): All channels the user joined or has been
+	// invited to.
+	// - MemberStateFilterJoinedOnly: Channels the user joined.
+	// - MemberStateFilterInvitedOnly: Channels the user has been invited to but
+	// not joined.
+	// - MemberStateFilterInvitedByFriend: Channels the user has been invited to
+	// by a friend.
+	// - MemberStateFilterInvitedByNonFriend: Channels the user has been invited
+	// to by a non-friend.
+	// Optional.
+	MemberStateFilter MemberStateFilter
+	// UnreadFilter restricts the search scope to only retrieve group channels
+	// with one or more unread messages. Acceptable values are UnreadFilterAll
+	// (default) and UnreadFilterUnreadMessage.
+	// Optional.
+	UnreadFilter UnreadFilter
+	// CreatedAfter restricts the search scope to only retrieve group channels
+	// which have been created after the specified time, in Unix milliseconds
+	// format.
+	// Optional.
+	CreatedAfter *int
+	// CreatedBefore restricts the search scope to only retrieve group channels
+	// which have been created before the specified time, in Unix milliseconds
+	// format.
+	// Optional.
+	CreatedBefore *int
+	// ShowEmpty determines whether to include empty channels in the response.
+	// (Default: false)
+	// Optional.
+	ShowEmpty *bool
+	// ShowMember determines whether to include information about the members of
+	// each channel in the response. (Default: false)
+	// Optional.
+	ShowMember *bool
+	// ShowDeliveryReceipt determines whether to include information about the
+	// delivery receipts of each channel in the response. (Default: false)
+	// Optional.
+	ShowDeliveryReceipt *bool
+	// ShowReadReceipt determines whether to include information about the read
+	// receipts of each channel in the response. (Default: false)
+	// Optional.
+	ShowReadReceipt *bool
+	// ShowMetadata determines whether to include channel metadata in the
+	// response. (Default: false)
+	// Optional.
+	ShowMetadata *bool
+	// ShowFrozen determines whether to include frozen channels in the response.
+	// (Default: true)
+	// Optional.
+	ShowFrozen *bool
+	// Order specifies the method to sort a list of results. Acceptable values
+	// are the following:
+	// - channel.OrderChronological (default): sorts by time of channel
+	// creation, from most to least recent.
+	// - channel.OrderLatestLastMessage: sorts by the time of the last message in
+	// the channel, from most to least recent.
+	// - channel.OrderChannelNameAlphabetical: sorts by channel name in
+	// alphabetical order.
+	// - channel.OrderMetadataValueAlphabetical: sorts by a value of metadata in
+	// alphabetical order. This is available only when the metadata_order_key
+	// parameter is specified.
+	// Optional.
+	Order channel.Order
+	// MetadataOrderKey specifies the key of an item in metadata. When a value of
+	// the order parameter is set to metadata_value_alphabetical, the results are
+	// alphabetically sorted by the value of the item specified by the key.
+	// Optional.
+	MetadataOrderKey string
+	// CustomTypes specifies a list of one or more custom types to filter group
+	// channels. If not specified, all channels are returned, regardless of their
+	// custom type.
+	// Optional.
+	CustomTypes []string
+	// CustomTypeStartsWith searches for group channels with the custom type
+	// which starts with the specified value.
+	// Optional.
+	CustomTypeStartsWith string
+	// ChannelURLs specifies a list of one or more group channel URLs to restrict
+	// the search scope.
+	// Optional.
+	ChannelURLs []string
+	// Name specifies one or more group channel names.
+	// Optional.
+	Name string
+	// NameContains searches for group channels whose names contain the specified
+	// value. Note that this parameter is case-insensitive.
+	// Optional.
+	NameContains string
+	// NameStartswith searches for group channels whose names start with the
+	// specified value. Note that this parameter is case-insensitive.
+	// Optional.
+	NameStartswith string
+	// MembersExactlyIn searches for group channels with all the specified users
+	// as members.
+	// Optional.
+	MembersExactlyIn []string
+	// MembersIncludeIn searches for group channels that include one or more
+	// users as members among the specified users.
+	// Optional.
+	MembersIncludeIn []string
+	// QueryType specifies a logical condition applied to the members_include_in
+	// parameter. Acceptable values are either channel.QueryTypeAnd or
+	// channel.QueryTypeOr. (Default: AND)
+	// Optional.
+	QueryType channel.QueryType
+	// MembersNickname searches for group channels with members whose nicknames
+	// match the specified value.
+	// Optional.
+	MembersNickname string
+	// MembersNicknameContains searches for group channels with members whose
+	// nicknames contain the specified value. Note that this parameter is
+	// case-insensitive.
+	// Optional.
+	MembersNicknameContains string
+	// SearchQuery searches for group channels where the specified query string
+	// matches the channel name or the nickname of the member. This should be
+	// specified in conjunction with the search_fields parameter.
+	// Optional.
+	SearchQuery string
+	// SearchFields specifies one or more search fields to apply the query
+	// specified by the search_query parameter. Acceptable values are
+	// SearchFieldChannelName and SearchFieldMemberNickname.
+	// Optional.
+	SearchFields []SearchField
+	// MetadataKey searches for group channels with metadata containing an item
+	// with the specified value as its key.
+	// Optional.
+	MetadataKey string
+	// MetadataValues searches for group channels with metadata containing an
+	// item with the key specified by the metadata_key parameter, and the value
+	// of that item matches one or more values specified by this parameter.
+	// Optional.
+	MetadataValues []string
+	// MetadataValueStartswith searches for group channels with metadata
+	// containing an item with the key specified by the metadata_key parameter,
+	// and the values of that item that start with the specified value of this
+	// parameter.
+	// Optional.
+	MetadataValueStartswith string
+	// IncludeSortedMetaarrayInLastMessage determines whether to include the
+	// sorted_metaarray as one of the last_message’s properties in the response.
+	// Optional.
+	IncludeSortedMetaarrayInLastMessage *bool
+}
+
+// ListMyGroupChannelsResponse is the response to list the group channels of a
+// user.
+type ListMyGroupChannelsResponse struct {
+	// Channels is the list of group channel objects that match the specified
+	// optional parameters.
+	Channels []channel.ChannelResource `json:"channels"`
+	// Next is the value for the token parameter to retrieve the next page in the
+	// result.
+	Next string `json:"next"`
+}
+
+func listMyGroupChannelsRequestToMap(lmgcr ListMyGroupChannelsRequest) map[string]string {
+	m := make(map[string]string)
+
+	if lmgcr.Token != "" {
+		m["token"] = lmgcr.Token
+	}
+
+	if lmgcr.Limit != nil {
+		m["limit"] = strconv.Itoa(*lmgcr.Limit)
+	}
+
+	if lmgcr.DistinctMode != "" {
+		m["distinct_mode"] = string(lmgcr.DistinctMode)
+	}
+
+	if lmgcr.PublicMode != "" {
+		m["public_mode"] = string(lmgcr.PublicMode)
+	}
+
+	if lmgcr.SuperMode != "" {
+		m["super_mode"] = string(lmgcr.SuperMode)
+	}
+
+	if lmgcr.HiddenMode != "" {
+		m["hidden_mode"] = string(lmgcr.HiddenMode)
+	}
+
+	if lmgcr.MemberStateFilter != "" {
+		m["member_state_filter"] = string(lmgcr.MemberStateFilter)
+	}
+
+	if lmgcr.UnreadFilter != "" {
+		m["unread_filter"] = string(lmgcr.UnreadFilter)
+	}
+
+	if lmgcr.CreatedAfter != nil {
+		m["created_after"] = strconv.Itoa(*lmgcr.CreatedAfter)
+	}
+
+	if lmgcr.CreatedBefore != nil {
+		m["created_before"] = strconv.Itoa(*lmgcr.CreatedBefore)
+	}
+
+	if lmgcr.ShowEmpty != nil {
+		m["show_empty"] = strconv.FormatBool(*lmgcr.ShowEmpty)
+	}
+
+	if lmgcr.ShowMember != nil {
+		m["show_member"] = strconv.FormatBool(*lmgcr.ShowMember)
+	}
+
+	if lmgcr.ShowDeliveryReceipt != nil {
+		m["show_delivery_receipt"] = strconv.FormatBool(*lmgcr.ShowDeliveryReceipt)
+	}
+
+	if lmgcr.ShowReadReceipt != nil {
+		m["show_read_receipt"] = strconv.FormatBool(*lmgcr.ShowReadReceipt)
+	}
+
+	if lmgcr.ShowMetadata != nil {
+		m["show_metadata"] = strconv.FormatBool(*lmgcr.ShowMetadata)
+	}
+
+	if lmgcr.ShowFrozen != nil {
+		m["show_frozen"] = strconv.FormatBool(*lmgcr.ShowFrozen)
+	}
+
+	if lmgcr.Order != "" {
+		m["order"] = string(lmgcr.Order)
+	}
+
+	if lmgcr.MetadataOrderKey != "" {
+		m["metadata_order_key"] = lmgcr.MetadataOrderKey
+	}
+
+	if len(lmgcr.CustomTypes) > 0 {
+		m["custom_types"] = strconvSlice.FormatSliceToCSV(lmgcr.CustomTypes)
+	}
+
+	if lmgcr.CustomTypeStartsWith != "" {
+		m["custom_type_startswith"] = lmgcr.CustomTypeStartsWith
+	}
+
+	if len(lmgcr.ChannelURLs) > 0 {
+		m["channel_urls"] = strconvSlice.FormatSliceToCSV(lmgcr.ChannelURLs)
+	}
+
+	if lmgcr.Name != "" {
+		m["name"] = lmgcr.Name
+	}
+
+	if lmgcr.NameContains != "" {
+		m["name_contains"] = lmgcr.NameContains
+	}
+
+	if lmgcr.NameStartswith != "" {
+		m["name_startswith"] = lmgcr.NameStartswith
+	}
+
+	if len(lmgcr.MembersExactlyIn) > 0 {
+		m["members_exactly_in"] = strconvSlice.FormatSliceToCSV(lmgcr.MembersExactlyIn)
+	}
+
+	if len(lmgcr.MembersIncludeIn) > 0 {
+		m["members_include_in"] = strconvSlice.FormatSliceToCSV(lmgcr.MembersIncludeIn)
+	}
+
+	if lmgcr.QueryType != "" {
+		m["query_type"] = string(lmgcr.QueryType)
+	}
+
+	if lmgcr.MembersNickname != "" {
+		m["members_nickname"] = lmgcr.MembersNickname
+	}
+
+	if lmgcr.MembersNicknameContains != "" {
+		m["members_nickname_contains"] = lmgcr.MembersNicknameContains
+	}
+
+	if lmgcr.SearchQuery != "" {
+		m["search_query"] = lmgcr.SearchQuery
+	}
+
+	if len(lmgcr.SearchFields) > 0 {
+		searchFields := make([]string, 0, len(lmgcr.SearchFields))
+		for _, searchField := range lmgcr.SearchFields {
+			searchFields = append(searchFields, string(searchField))
+		}
+
+		m["search_fields"] = strconvSlice.FormatSliceToCSV(searchFields)
+	}
+
+	if lmgcr.MetadataKey != "" {
+		m["metadata_key"] = lmgcr.MetadataKey
+	}
+
+	if len(lmgcr.MetadataValues) > 0 {
+		m["metadata_values"] = strconvSlice.FormatSliceToCSV(lmgcr.MetadataValues)
+	}
+
+	if lmgcr.MetadataValueStartswith != "" {
+		m["metadata_value_startswith"] = lmgcr.MetadataValueStartswith
+	}
+
+	if lmgcr.IncludeSortedMetaarrayInLastMessage != nil {
+		m["include_sorted_metaarray_in_last_message"] = strconv.FormatBool(*lmgcr.IncludeSortedMetaarrayInLastMessage)
+	}
+
+	return m
+}
+
+// myGroupChannelsURL returns the URL listing the group channels of a user
+// matching the request.
+func myGroupChannelsURL(userID string, lmgcr ListMyGroupChannelsRequest) (string, error) {
+	uu, err := url.Parse(fmt.Sprintf("/users/%s/my_group_channels", userID))
+	if err != nil {
+		return "", fmt.Errorf("failed to parse URL: %w", err)
+	}
+
+	query := uu.Query()
+	for k, v := range listMyGroupChannelsRequestToMap(lmgcr) {
+		query.Set(k, v)
+	}
+
+	uu.RawQuery = query.Encode()
+
+	return uu.String(), nil
+}
+
+// ListMyGroupChannels lists the group channels joined by or inviting a user.
+// Use the Next field of the response as the Token of the following request to
+// retrieve the next page.
+// See https://sendbird.com/docs/chat/platform-api/v3/channel/listing-channels-in-an-application/list-group-channels-by-user
+func (u *user) ListMyGroupChannels(ctx context.Context, userID string, listMyGroupChannelsRequest ListMyGroupChannelsRequest) (*ListMyGroupChannelsResponse, error) {
+	uu, err := myGroupChannelsURL(userID, listMyGroupChannelsRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	lmgcr, err := u.client.Get(ctx, uu, nil, &ListMyGroupChannelsResponse{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list my group channels: %w", err)
+	}
+
+	listMyGroupChannelsResponse, ok := lmgcr.(*ListMyGroupChannelsResponse)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast body to ListMyGroupChannelsResponse: %+v", lmgcr)
+	}
+
+	return listMyGroupChannelsResponse, nil
+}
@@ -0,0 +1,106 @@
+package user
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yumi-ia/sendbird-go/pkg/channel"
+	"github.com/yumi-ia/sendbird-go/pkg/client"
+)
+
+func TestListMyGroupChannels(t *testing.T) {
+	t.Parallel()
+
+	url := "/users/42/my_group_channels"
+	url += "?channel_urls=channel-url1%2Cchannel-url2"
+	url += "&created_after=43"
+	url += "&created_before=44"
+	url += "&custom_type_startswith=custom-type-starts-with"
+	url += "&custom_types=custom-types1%2Ccustom-types2"
+	url += "&distinct_mode=all"
+	url += "&hidden_mode=all"
+	url += "&include_sorted_metaarray_in_last_message=true"
+	url += "&limit=42"
+	url += "&member_state_filter=joined_only"
+	url += "&members_exactly_in=members-exactly-in1%2Cmembers-exactly-in2"
+	url += "&members_include_in=members-include-in1%2Cmembers-include-in2"
+	url += "&members_nickname=members-nickname"
+	url += "&members_nickname_contains=members-nickname-contains"
+	url += "&metadata_key=metadata-key"
+	url += "&metadata_order_key=metadata-order-key"
+	url += "&metadata_value_startswith=metadata-value-starts-with"
+	url += "&metadata_values=metadata-value1%2Cmetadata-value2"
+	url += "&name=name"
+	url += "&name_contains=name-contains"
+	url += "&name_startswith=name-starts-with"
+	url += "&order=latest_last_message"
+	url += "&public_mode=all"
+	url += "&query_type=AND"
+	url += "&search_fields=channel_name%2Cmember_nickname"
+	url += "&search_query=search-query"
+	url += "&show_delivery_receipt=true"
+	url += "&show_empty=true"
+	url += "&show_frozen=true"
+	url += "&show_member=true"
+	url += "&show_metadata=true"
+	url += "&show_read_receipt=true"
+	url += "&super_mode=all"
+	url += "&token=token"
+	url += "&unread_filter=unread_message"
+
+	listMyGroupChannelsRequest := ListMyGroupChannelsRequest{
+		Token:                               "token",
+		Limit:                               ptr(42),
+		DistinctMode:                        channel.DistinctModeAll,
+		PublicMode:                          channel.PublicModeAll,
+		SuperMode:                           SuperModeAll,
+		HiddenMode:                          ModeAll,
+		MemberStateFilter:                   MemberStateFilterJoinedOnly,
+		UnreadFilter:                        UnreadFilterUnreadMessage,
+		CreatedAfter:                        ptr(43),
+		CreatedBefore:                       ptr(44),
+		ShowEmpty:                           ptr(true),
+		ShowMember:                          ptr(true),
+		ShowDeliveryReceipt:                 ptr(true),
+		ShowReadReceipt:                     ptr(true),
+		ShowMetadata:                        ptr(true),
+		ShowFrozen:                          ptr(true),
+		Order:                               channel.OrderLatestLastMessage,
+		MetadataOrderKey:                    "metadata-order-key",
+		CustomTypes:                         []string{"custom-types1", "custom-types2"},
+		CustomTypeStartsWith:                "custom-type-starts-with",
+		ChannelURLs:                         []string{"channel-url1", "channel-url2"},
+		Name:                                "name",
+		NameContains:                        "name-contains",
+		NameStartswith:                      "name-starts-with",
+		MembersExactlyIn:                    []string{"members-exactly-in1", "members-exactly-in2"},
+		MembersIncludeIn:                    []string{"members-include-in1", "members-include-in2"},
+		QueryType:                           channel.QueryTypeAnd,
+		MembersNickname:                     "members-nickname",
+		MembersNicknameContains:             "members-nickname-contains",
+		SearchQuery:                         "search-query",
+		SearchFields:                        []SearchField{SearchFieldChannelName, SearchFieldMemberNickname},
+		MetadataKey:                         "metadata-key",
+		MetadataValues:                      []string{"metadata-value1", "metadata-value2"},
+		MetadataValueStartswith:             "metadata-value-starts-with",
+		IncludeSortedMetaarrayInLastMessage: ptr(true),
+	}
+
+	listMyGroupChannelsResponse := &ListMyGroupChannelsResponse{
+		Channels: []channel.ChannelResource{{
+			Name: "channel-name",
+		}},
+		Next: "next",
+	}
+
+	client := client.NewClientMock(t).
+		OnGet(url, nil, &ListMyGroupChannelsResponse{}).TypedReturns(listMyGroupChannelsResponse, nil).Once().
+		Parent
+	user := NewUser(client)
+
+	cur, err := user.ListMyGroupChannels(context.Background(), "42", listMyGroupChannelsRequest)
+	require.NoError(t, err)
+	assert.Equal(t, listMyGroupChannelsResponse, cur)
+}
@@ -24,3 +24,41 @@ const (
 	SuperModeSuper    SuperMode = "super"
 	SuperModeNonSuper SuperMode = "nonsuper"
 )
+
+type ItemKey string
+
+const (
+	ItemKeyNonSuperGroupChannelUnreadMessageCount ItemKey = "non_super_group_channel_unread_message_count"
+	ItemKeySuperGroupChannelUnreadMessageCount    ItemKey = "super_group_channel_unread_message_count"
+	ItemKeyGroupChannelUnreadMessageCount         ItemKey = "group_channel_unread_message_count"
+	ItemKeyNonSuperGroupChannelUnreadMentionCount ItemKey = "non_super_group_channel_unread_mention_count"
+	ItemKeySuperGroupChannelUnreadMentionCount    ItemKey = "super_group_channel_unread_mention_count"
+	ItemKeyGroupChannelUnreadMentionCount         ItemKey = "group_channel_unread_mention_count"
+	ItemKeyNonSuperGroupChannelInvitationCount    ItemKey = "non_super_group_channel_invitation_count"
+	ItemKeySuperGroupChannelInvitationCount       ItemKey = "super_group_channel_invitation_count"
+	ItemKeyGroupChannelInvitationCount            ItemKey = "group_channel_invitation_count"
+)
+
+type UnreadFilter string
+
+const (
+	UnreadFilterAll           UnreadFilter = "all"
+	UnreadFilterUnreadMessage UnreadFilter = "unread_message"
+)
+
+type MemberStateFilter string
+
+const (
+	MemberStateFilterAll                MemberStateFilter = "all"
+	MemberStateFilterJoinedOnly         MemberStateFilter = "joined_only"
+	MemberStateFilterInvitedOnly        MemberStateFilter = "invited_only"
+	MemberStateFilterInvitedByFriend    MemberStateFilter = "invited_by_friend"
+	MemberStateFilterInvitedByNonFriend MemberStateFilter = "invited_by_non_friend"
+)
+
+type SearchField string
+
+const (
+	SearchFieldChannelName    SearchField = "channel_name"
+	SearchFieldMemberNickname SearchField = "member_nickname"
+)
@@ -0,0 +1,175 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	strconvSlice "github.com/yumi-ia/sendbird-go/pkg/utils/strconv"
+)
+
+// GetUnreadItemCountRequest is the request to get the number of unread items.
+type GetUnreadItemCountRequest struct {
+	// CustomTypes specifies a list of one or more custom types to filter group
+	// channels with the corresponding types.
+	// Optional.
+	CustomTypes []string
+	// ItemKeys specifies a list of one or more item keys to retrieve. If not
+	// specified, every item is returned.
+	// Optional.
+	ItemKeys []ItemKey
+}
+
+// GetUnreadItemCountResponse is the response to get the number of unread
+// items. Only the items requested with ItemKeys are filled.
+type GetUnreadItemCountResponse struct {
+	// NonSuperGroupChannelUnreadMessageCount is the number of unread messages
+	// in group channels excluding Supergroup channels.
+	NonSuperGroupChannelUnreadMessageCount int `json:"non_super_group_channel_unread_message_count"`
+	// SuperGroupChannelUnreadMessageCount is the number of unread messages in
+	// Supergroup channels.
+	SuperGroupChannelUnreadMessageCount int `json:"super_group_channel_unread_message_count"`
+	// GroupChannelUnreadMessageCount is the number of unread messages in all
+	// group channels.
+	GroupChannelUnreadMessageCount int `json:"group_channel_unread_message_count"`
+	// NonSuperGroupChannelUnreadMentionCount is the number of unread mentions
+	// in group channels excluding Supergroup channels.
+	NonSuperGroupChannelUnreadMentionCount int `json:"non_super_group_channel_unread_mention_count"`
+	// SuperGroupChannelUnreadMentionCount is the number of unread mentions in
+	// Supergroup channels.
+	SuperGroupChannelUnreadMentionCount int `json:"super_group_channel_unread_mention_count"`
+	// GroupChannelUnreadMentionCount is the number of unread mentions in all
+	// group channels.
+	GroupChannelUnreadMentionCount int `json:"group_channel_unread_mention_count"`
+	// NonSuperGroupChannelInvitationCount is the number of pending invitations
+	// to group channels excluding Supergroup channels.
+	NonSuperGroupChannelInvitationCount int `json:"non_super_group_channel_invitation_count"`
+	// SuperGroupChannelInvitationCount is the number of pending invitations to
+	// Supergroup channels.
+	SuperGroupChannelInvitationCount int `json:"super_group_channel_invitation_count"`
+	// GroupChannelInvitationCount is the number of pending invitations to all
+	// group channels.
+	GroupChannelInvitationCount int `json:"group_channel_invitation_count"`
+}
+
+func getUnreadItemCountRequestToMap(guicr GetUnreadItemCountRequest) map[string]string {
+	m := make(map[string]string)
+
+	if len(guicr.CustomTypes) > 0 {
+		m["custom_types"] = strconvSlice.FormatSliceToCSV(guicr.CustomTypes)
+	}
+
+	if len(guicr.ItemKeys) > 0 {
+		itemKeys := make([]string, 0, len(guicr.ItemKeys))
+		for _, itemKey := range guicr.ItemKeys {
+			itemKeys = append(itemKeys, string(itemKey))
+		}
+
+		m["item_keys"] = strconvSlice.FormatSliceToCSV(itemKeys)
+	}
+
+	return m
+}
+
+// GetUnreadItemCount retrieves the number of unread messages, unread mentions
+// and pending invitations of a user, by type of group channel.
+// See https://sendbird.com/docs/chat/platform-api/v3/user/managing-unread-count/get-number-of-unread-items
+func (u *user) GetUnreadItemCount(ctx context.Context, userID string, getUnreadItemCountRequest GetUnreadItemCountRequest) (*GetUnreadItemCountResponse, error) {
+	uu, err := url.Parse(fmt.Sprintf("/users/%s/unread_item_count", userID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse URL: %w", err)
+	}
+
+	query := uu.Query()
+	for k, v := range getUnreadItemCountRequestToMap(getUnreadItemCountRequest) {
+		query.Set(k, v)
+	}
+
+	uu.RawQuery = query.Encode()
+
+	guicr, err := u.client.Get(ctx, uu.String(), nil, &GetUnreadItemCountResponse{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get unread item count: %w", err)
+	}
+
+	getUnreadItemCountResponse, ok := guicr.(*GetUnreadItemCountResponse)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast body to GetUnreadItemCountResponse: %+v", guicr)
+	}
+
+	return getUnreadItemCountResponse, nil
+}
+
+// GetUnreadMessagesCountByChannelRequest is the request to get the number of
+// unread messages of a user in each of their group channels.
+type GetUnreadMessagesCountByChannelRequest struct {
+	// Token specifies a page token that indicates the starting index of a chunk
+	// of results. If not specified, the index is set as 0.
+	// Optional.
+	Token string
+	// Limit specifies the number of results to return per page. Acceptable
+	// values are 1 to 100, inclusive. (Default: 10)
+	// Optional.
+	Limit *int
+	// CustomTypes specifies a list of one or more custom types to filter group
+	// channels with the corresponding types.
+	// Optional.
+	CustomTypes []string
+}
+
+// ChannelUnreadCount is the unread count of a user in a group channel.
+type ChannelUnreadCount struct {
+	// ChannelURL is the URL of the group channel.
+	ChannelURL string `json:"channel_url"`
+	// UnreadMessageCount is the number of the user's unread messages in the
+	// group channel.
+	UnreadMessageCount int `json:"unread_message_count"`
+	// UnreadMentionCount is the number of the user's unread mentions in the
+	// group channel.
+	UnreadMentionCount int `json:"unread_mention_count"`
+}
+
+// GetUnreadMessagesCountByChannelResponse is the response to get the number
+// of unread messages of a user in each of their group channels.
+type GetUnreadMessagesCountByChannelResponse struct {
+	// Channels is the list of group channels which have unread messages, with
+	// their unread counts.
+	Channels []ChannelUnreadCount `json:"channels"`
+	// Next is the value for the token parameter to retrieve the next page in the
+	// result.
+	Next string `json:"next"`
+}
+
+// getUnreadMessagesCountByChannelRequestToListRequest returns the request
+// listing the group channels of a user which have unread messages.
+func getUnreadMessagesCountByChannelRequestToListRequest(gumcbcr GetUnreadMessagesCountByChannelRequest) ListMyGroupChannelsRequest {
+	return ListMyGroupChannelsRequest{
+		Token:        gumcbcr.Token,
+		Limit:        gumcbcr.Limit,
+		CustomTypes:  gumcbcr.CustomTypes,
+		UnreadFilter: UnreadFilterUnreadMessage,
+	}
+}
+
+// GetUnreadMessagesCountByChannel retrieves the number of unread messages and
+// mentions of a user in each of their group channels which have unread
+// messages.
+// See https://sendbird.com/docs/chat/platform-api/v3/channel/listing-channels-in-an-application/list-group-channels-by-user
+func (u *user) GetUnreadMessagesCountByChannel(ctx context.Context, userID string, getUnreadMessagesCountByChannelRequest GetUnreadMessagesCountByChannelRequest) (*GetUnreadMessagesCountByChannelResponse, error) {
+	uu, err := myGroupChannelsURL(userID, getUnreadMessagesCountByChannelRequestToListRequest(getUnreadMessagesCountByChannelRequest))
+	if err != nil {
+		return nil, err
+	}
+
+	gumcbcr, err := u.client.Get(ctx, uu, nil, &GetUnreadMessagesCountByChannelResponse{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get unread messages count by channel: %w", err)
+	}
+
+	getUnreadMessagesCountByChannelResponse, ok := gumcbcr.(*GetUnreadMessagesCountByChannelResponse)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast body to GetUnreadMessagesCountByChannelResponse: %+v", gumcbcr)
+	}
+
+	return getUnreadMessagesCountByChannelResponse, nil
+}
@@ -0,0 +1,75 @@
+package user
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yumi-ia/sendbird-go/pkg/client"
+)
+
+func ptr[T any](t T) *T {
+	return &t
+}
+
+func TestGetUnreadItemCount(t *testing.T) {
+	t.Parallel()
+
+	url := "/users/42/unread_item_count"
+	url += "?custom_types=custom-type1%2Ccustom-type2"
+	url += "&item_keys=group_channel_unread_message_count%2Cgroup_channel_invitation_count"
+
+	getUnreadItemCountRequest := GetUnreadItemCountRequest{
+		CustomTypes: []string{"custom-type1", "custom-type2"},
+		ItemKeys:    []ItemKey{ItemKeyGroupChannelUnreadMessageCount, ItemKeyGroupChannelInvitationCount},
+	}
+
+	getUnreadItemCountResponse := &GetUnreadItemCountResponse{
+		GroupChannelUnreadMessageCount: 42,
+		GroupChannelInvitationCount:    43,
+	}
+
+	client := client.NewClientMock(t).
+		OnGet(url, nil, &GetUnreadItemCountResponse{}).TypedReturns(getUnreadItemCountResponse, nil).Once().
+		Parent
+	user := NewUser(client)
+
+	cur, err := user.GetUnreadItemCount(context.Background(), "42", getUnreadItemCountRequest)
+	require.NoError(t, err)
+	assert.Equal(t, getUnreadItemCountResponse, cur)
+}
+
+func TestGetUnreadMessagesCountByChannel(t *testing.T) {
+	t.Parallel()
+
+	url := "/users/42/my_group_channels"
+	url += "?custom_types=custom-type"
+	url += "&limit=10"
+	url += "&token=token"
+	url += "&unread_filter=unread_message"
+
+	getUnreadMessagesCountByChannelRequest := GetUnreadMessagesCountByChannelRequest{
+		Token:       "token",
+		Limit:       ptr(10),
+		CustomTypes: []string{"custom-type"},
+	}
+
+	getUnreadMessagesCountByChannelResponse := &GetUnreadMessagesCountByChannelResponse{
+		Channels: []ChannelUnreadCount{{
+			ChannelURL:         "channel-url",
+			UnreadMessageCount: 42,
+			UnreadMentionCount: 1,
+		}},
+		Next: "next",
+	}
+
+	client := client.NewClientMock(t).
+		OnGet(url, nil, &GetUnreadMessagesCountByChannelResponse{}).TypedReturns(getUnreadMessagesCountByChannelResponse, nil).Once().
+		Parent
+	user := NewUser(client)
+
+	cur, err := user.GetUnreadMessagesCountByChannel(context.Background(), "42", getUnreadMessagesCountByChannelRequest)
+	require.NoError(t, err)
+	assert.Equal(t, getUnreadMessagesCountByChannelResponse, cur)
+}
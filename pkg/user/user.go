@@ -26,6 +26,22 @@ type User interface {
 	// GetGroupChannelCount retrieves the number of group channels of a user.
 	// https://sendbird.com/docs/chat/platform-api/v3/user/getting-group-channel-count/get-number-of-channels-by-join-status
 	GetGroupChannelCount(ctx context.Context, userID string, getGroupChannelCountRequest GetGroupChannelCountRequest) (*GetGroupChannelCountResponse, error)
+	// GetUnreadItemCount retrieves the number of unread messages, unread
+	// mentions and pending invitations of a user, by type of group channel.
+	// https://sendbird.com/docs/chat/platform-api/v3/user/managing-unread-count/get-number-of-unread-items
+	GetUnreadItemCount(ctx context.Context, userID string, getUnreadItemCountRequest GetUnreadItemCountRequest) (*GetUnreadItemCountResponse, error)
+	// GetUnreadMessagesCountByChannel retrieves the number of unread messages
+	// and mentions of a user in each of their group channels.
+	// https://sendbird.com/docs/chat/platform-api/v3/channel/listing-channels-in-an-application/list-group-channels-by-user
+	GetUnreadMessagesCountByChannel(ctx context.Context, userID string, getUnreadMessagesCountByChannelRequest GetUnreadMessagesCountByChannelRequest) (*GetUnreadMessagesCountByChannelResponse, error)
+	// MarkAllAsRead marks all messages as read in the group channels joined by
+	// a user, optionally restricted to the given channels.
+	// https://sendbird.com/docs/chat/platform-api/v3/user/marking-messages-as-read/mark-all-of-a-users-messages-as-read
+	MarkAllAsRead(ctx context.Context, userID string, channelURLs []string) error
+
+	// ListMyGroupChannels lists the group channels joined by or inviting a user.
+	// https://sendbird.com/docs/chat/platform-api/v3/channel/listing-channels-in-an-application/list-group-channels-by-user
+	ListMyGroupChannels(ctx context.Context, userID string, listMyGroupChannelsRequest ListMyGroupChannelsRequest) (*ListMyGroupChannelsResponse, error)
 }
 
 type user struct {
@@ -0,0 +1,80 @@
+// Package payload converts the custom data and metadata of the resources to
+// and from caller-provided types.
+package payload
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// ErrEmptyData is returned when decoding an empty data.
+var ErrEmptyData = errors.New("data is empty")
+
+// EncodeData encodes v to JSON, to be used as the data of a resource.
+func EncodeData[T any](v T) (string, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode data from %T: %w", v, err)
+	}
+
+	return string(b), nil
+}
+
+// DecodeData decodes the JSON data of a resource into a T.
+func DecodeData[T any](data string) (T, error) {
+	var v T
+
+	if data == "" {
+		return v, fmt.Errorf("failed to decode data into %T: %w", v, ErrEmptyData)
+	}
+
+	if err := json.Unmarshal([]byte(data), &v); err != nil {
+		return v, fmt.Errorf("failed to decode data into %T: %w", v, err)
+	}
+
+	return v, nil
+}
+
+// EncodeMetadata encodes v, which must encode to a JSON object of strings, to
+// the metadata of a resource.
+func EncodeMetadata[T any](v T) (map[string]interface{}, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode metadata from %T: %w", v, err)
+	}
+
+	var metadata map[string]interface{}
+	if err := json.Unmarshal(b, &metadata); err != nil {
+		return nil, fmt.Errorf("failed to encode metadata from %T: not a JSON object: %w", v, err)
+	}
+
+	for key, value := range metadata {
+		if _, ok := value.(string); !ok {
+			return nil, fmt.Errorf("failed to encode metadata from %T: value of %q must be a string, got %T", v, key, value)
+		}
+	}
+
+	return metadata, nil
+}
+
+// DecodeMetadata decodes the metadata of a resource into a T. A nil metadata
+// decodes into the zero value of T.
+func DecodeMetadata[T any](metadata map[string]interface{}) (T, error) {
+	var v T
+
+	if metadata == nil {
+		return v, nil
+	}
+
+	b, err := json.Marshal(metadata)
+	if err != nil {
+		return v, fmt.Errorf("failed to decode metadata into %T: %w", v, err)
+	}
+
+	if err := json.Unmarshal(b, &v); err != nil {
+		return v, fmt.Errorf("failed to decode metadata into %T: %w", v, err)
+	}
+
+	return v, nil
+}
@@ -0,0 +1,71 @@
+package payload
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type style struct {
+	FontSize string `json:"font-size"`
+}
+
+func ExampleDecodeData() {
+	s, err := DecodeData[style](`{"font-size": "24px"}`)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(s.FontSize)
+	// Output: 24px
+}
+
+func TestData(t *testing.T) {
+	t.Parallel()
+
+	data, err := EncodeData(style{FontSize: "24px"})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"font-size": "24px"}`, data)
+
+	s, err := DecodeData[style](data)
+	require.NoError(t, err)
+	assert.Equal(t, style{FontSize: "24px"}, s)
+
+	_, err = DecodeData[style]("")
+	require.ErrorIs(t, err, ErrEmptyData)
+
+	_, err = DecodeData[style]("not json")
+	require.EqualError(t, err, "failed to decode data into payload.style: invalid character 'o' in literal null (expecting 'u')")
+
+	_, err = EncodeData(make(chan int))
+	require.ErrorContains(t, err, "failed to encode data from chan int")
+}
+
+func TestMetadata(t *testing.T) {
+	t.Parallel()
+
+	metadata, err := EncodeMetadata(style{FontSize: "24px"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"font-size": "24px"}, metadata)
+
+	s, err := DecodeMetadata[style](metadata)
+	require.NoError(t, err)
+	assert.Equal(t, style{FontSize: "24px"}, s)
+
+	s, err = DecodeMetadata[style](nil)
+	require.NoError(t, err)
+	assert.Equal(t, style{}, s)
+
+	_, err = DecodeMetadata[style](map[string]interface{}{"font-size": 24})
+	require.ErrorContains(t, err, "failed to decode metadata into payload.style")
+
+	_, err = EncodeMetadata(struct {
+		Size int `json:"size"`
+	}{Size: 24})
+	require.ErrorContains(t, err, `value of "size" must be a string, got float64`)
+
+	_, err = EncodeMetadata([]string{"24px"})
+	require.ErrorContains(t, err, "not a JSON object")
+}
@@ -0,0 +1,41 @@
+// Package peek reads the beginning of HTTP bodies without consuming them.
+package peek
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+)
+
+// Body reads up to limit bytes of the body, or all of it if limit is
+// negative, and puts them back so that the body can still be read in full and
+// closed. It reports whether the body is longer than limit, in which case only
+// limit bytes are read and returned.
+func Body(body *io.ReadCloser, limit int64) ([]byte, bool, error) {
+	if *body == nil || *body == http.NoBody {
+		return nil, false, nil
+	}
+
+	r := io.Reader(*body)
+	if limit >= 0 {
+		r = io.LimitReader(r, limit+1)
+	}
+
+	b, err := io.ReadAll(r)
+
+	*body = readCloser{
+		Reader: io.MultiReader(bytes.NewReader(b), *body),
+		Closer: *body,
+	}
+
+	if limit >= 0 && int64(len(b)) > limit {
+		return b[:limit], true, err
+	}
+
+	return b, false, err
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
@@ -0,0 +1,73 @@
+package peek
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type closeRecorder struct {
+	io.Reader
+	closed bool
+}
+
+func (cr *closeRecorder) Close() error {
+	cr.closed = true
+
+	return nil
+}
+
+func TestBody(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name              string
+		content           string
+		limit             int64
+		expected          string
+		expectedTruncated bool
+	}{
+		{name: "shorter than limit", content: "hello", limit: 10, expected: "hello"},
+		{name: "exactly limit", content: "hello", limit: 5, expected: "hello"},
+		{name: "longer than limit", content: "hello world", limit: 5, expected: "hello", expectedTruncated: true},
+		{name: "no limit", content: "hello world", limit: -1, expected: "hello world"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			cr := &closeRecorder{Reader: strings.NewReader(test.content)}
+			body := io.ReadCloser(cr)
+
+			b, truncated, err := Body(&body, test.limit)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, string(b))
+			assert.Equal(t, test.expectedTruncated, truncated)
+
+			// The body is put back in full.
+			all, err := io.ReadAll(body)
+			require.NoError(t, err)
+			assert.Equal(t, test.content, string(all))
+
+			require.NoError(t, body.Close())
+			assert.True(t, cr.closed)
+		})
+	}
+}
+
+func TestBody_noBody(t *testing.T) {
+	t.Parallel()
+
+	body := io.ReadCloser(http.NoBody)
+
+	b, truncated, err := Body(&body, 10)
+	require.NoError(t, err)
+	assert.Nil(t, b)
+	assert.False(t, truncated)
+	assert.Equal(t, http.NoBody, body)
+}
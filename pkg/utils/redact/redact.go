@@ -0,0 +1,52 @@
+// Package redact redacts the values of sensitive fields of JSON bodies.
+package redact
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// Redacted replaces the redacted values.
+const Redacted = "[REDACTED]"
+
+// JSON returns the JSON body, compacted, with the values of the given fields
+// replaced by Redacted at any depth. Numbers are kept as is. An error is
+// returned if the body isn't JSON.
+func JSON(body []byte, fields map[string]struct{}) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return nil, fmt.Errorf("failed to decode body: %w", err)
+	}
+
+	b, err := json.Marshal(value(v, fields))
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode body: %w", err)
+	}
+
+	return b, nil
+}
+
+func value(v any, fields map[string]struct{}) any {
+	switch vv := v.(type) {
+	case map[string]any:
+		for k, val := range vv {
+			if _, ok := fields[k]; ok {
+				vv[k] = Redacted
+
+				continue
+			}
+
+			vv[k] = value(val, fields)
+		}
+	case []any:
+		for i, val := range vv {
+			vv[i] = value(val, fields)
+		}
+	}
+
+	return v
+}
@@ -0,0 +1,62 @@
+package redact
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSON(t *testing.T) {
+	t.Parallel()
+
+	fields := map[string]struct{}{"password": {}, "session_tokens": {}}
+
+	tests := []struct {
+		name        string
+		body        string
+		expected    string
+		expectedErr string
+	}{
+		{
+			name:     "nested",
+			body:     `{"a": [{"password": "p", "b": 1}]}`,
+			expected: `{"a":[{"b":1,"password":"[REDACTED]"}]}`,
+		},
+		{
+			name:     "object value",
+			body:     `{"session_tokens":[{"session_token":"t"}]}`,
+			expected: `{"session_tokens":"[REDACTED]"}`,
+		},
+		{
+			name:     "large number",
+			body:     `{"message_id":9007199254740993}`,
+			expected: `{"message_id":9007199254740993}`,
+		},
+		{
+			name:        "not JSON",
+			body:        "foo",
+			expectedErr: "failed to decode body",
+		},
+		{
+			name:        "empty",
+			expectedErr: "failed to decode body",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			b, err := JSON([]byte(test.body), fields)
+			if test.expectedErr != "" {
+				require.ErrorContains(t, err, test.expectedErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, string(b))
+		})
+	}
+}
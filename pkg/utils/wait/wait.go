@@ -0,0 +1,69 @@
+// Package wait provides helpers to wait between the attempts of an operation.
+package wait
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Exponential returns the delay before the given attempt, starting at 0:
+// base doubled on each attempt, capped at limit so that it can't overflow.
+func Exponential(base time.Duration, attempt int, limit time.Duration) time.Duration {
+	d := base
+
+	for range attempt {
+		if d >= limit/2 {
+			return limit
+		}
+
+		d *= 2
+	}
+
+	return min(d, limit)
+}
+
+// Sleep waits for the given duration or until the context is done, in which
+// case the error of the context is returned.
+func Sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// Poll calls fn every interval until it reports done or returns an error, and
+// returns its last result. It returns early with the error of ctx if ctx is
+// done. The interval must be positive.
+func Poll[T any](ctx context.Context, interval time.Duration, fn func(ctx context.Context) (T, bool, error)) (T, error) {
+	var zero T
+
+	if interval <= 0 {
+		return zero, fmt.Errorf("interval must be positive, got %s", interval)
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		v, done, err := fn(ctx)
+		if err != nil {
+			return zero, err
+		}
+
+		if done {
+			return v, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return zero, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
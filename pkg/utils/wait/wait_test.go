@@ -0,0 +1,88 @@
+package wait
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExponential(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		base     time.Duration
+		attempt  int
+		limit    time.Duration
+		expected time.Duration
+	}{
+		{name: "first attempt", base: time.Second, attempt: 0, limit: time.Minute, expected: time.Second},
+		{name: "doubled", base: time.Second, attempt: 3, limit: time.Minute, expected: 8 * time.Second},
+		{name: "capped", base: time.Second, attempt: 10, limit: time.Minute, expected: time.Minute},
+		{name: "no overflow", base: time.Second, attempt: math.MaxInt32, limit: time.Duration(math.MaxInt64), expected: time.Duration(math.MaxInt64)},
+		{name: "base above limit", base: time.Hour, attempt: 0, limit: time.Minute, expected: time.Minute},
+		{name: "zero base", base: 0, attempt: 5, limit: time.Minute, expected: 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, Exponential(test.base, test.attempt, test.limit))
+		})
+	}
+}
+
+func TestSleep(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, Sleep(context.Background(), time.Millisecond))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	require.ErrorIs(t, Sleep(ctx, time.Hour), context.Canceled)
+}
+
+func TestPoll(t *testing.T) {
+	t.Parallel()
+
+	calls := 0
+
+	v, err := Poll(context.Background(), time.Millisecond, func(context.Context) (int, bool, error) {
+		calls++
+
+		return calls, calls == 3, nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 3, v)
+
+	errPoll := errors.New("poll failed")
+
+	_, err = Poll(context.Background(), time.Millisecond, func(context.Context) (int, bool, error) {
+		return 0, false, errPoll
+	})
+	require.ErrorIs(t, err, errPoll)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = Poll(ctx, time.Hour, func(context.Context) (int, bool, error) {
+		return 0, false, nil
+	})
+	require.ErrorIs(t, err, context.Canceled)
+
+	for _, interval := range []time.Duration{0, -time.Second} {
+		_, err = Poll(context.Background(), interval, func(context.Context) (int, bool, error) {
+			t.Fatal("unexpected call")
+
+			return 0, false, nil
+		})
+		require.ErrorContains(t, err, "interval must be positive")
+	}
+}
@@ -0,0 +1,19 @@
+package webhook_test
+
+import (
+	"github.com/yumi-ia/sendbird-go/pkg/client"
+	"github.com/yumi-ia/sendbird-go/pkg/webhook"
+)
+
+func ExampleNewWebhook() {
+	// Initialize a client.
+	opts := []client.Option{}
+	c := client.NewClient(opts...)
+
+	// Initialize a webhook service.
+	w := webhook.NewWebhook(c)
+
+	// the webhook client is ready to be used.
+	_ = w
+	// w.DoWork()
+}
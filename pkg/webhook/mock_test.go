@@ -0,0 +1,4 @@
+package webhook
+
+// https://github.com/traefik/mocktail
+// mocktail:Webhook
@@ -0,0 +1,104 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+)
+
+// webhookSettingsResponse is the response holding the webhook settings.
+type webhookSettingsResponse struct {
+	Webhook struct {
+		WebhookSettings
+
+		AllWebhookCategories []Event `json:"all_webhook_categories"`
+	} `json:"webhook"`
+}
+
+// GetWebhookSettingsResponse is the response to get the webhook settings.
+type GetWebhookSettingsResponse WebhookSettings
+
+// UpdateWebhookSettingsRequest is the request to update the webhook settings.
+// Only the specified settings are updated.
+type UpdateWebhookSettingsRequest struct {
+	// Enabled determines whether to turn webhooks on.
+	// Optional.
+	Enabled *bool `json:"enabled,omitempty"`
+	// URL specifies the URL the webhook events are sent to.
+	// Optional.
+	URL string `json:"url,omitempty"`
+	// EnabledEvents specifies the events to subscribe to, replacing the
+	// subscribed ones. Use EventAll to subscribe to every event, and an empty
+	// slice to unsubscribe from every event.
+	// Optional.
+	EnabledEvents *[]Event `json:"enabled_events,omitempty"`
+	// IncludeMembers determines whether to include the members of the channel
+	// in the payloads.
+	// Optional.
+	IncludeMembers *bool `json:"include_members,omitempty"`
+	// IncludeUnreadCount determines whether to include the unread message
+	// count of the members in the payloads.
+	// Optional.
+	IncludeUnreadCount *bool `json:"include_unread_count,omitempty"`
+}
+
+// UpdateWebhookSettingsResponse is the response to update the webhook
+// settings.
+type UpdateWebhookSettingsResponse WebhookSettings
+
+func (w *webhook) getWebhookSettings(ctx context.Context, path string) (*webhookSettingsResponse, error) {
+	wsr, err := w.client.Get(ctx, path, nil, &webhookSettingsResponse{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhook settings: %w", err)
+	}
+
+	settingsResponse, ok := wsr.(*webhookSettingsResponse)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast body to webhookSettingsResponse: %+v", wsr)
+	}
+
+	return settingsResponse, nil
+}
+
+// GetWebhookSettings retrieves the webhook settings of the application.
+// See https://sendbird.com/docs/chat/platform-api/v3/webhook/configuring-webhook/retrieve-a-list-of-subscribed-events
+func (w *webhook) GetWebhookSettings(ctx context.Context) (*GetWebhookSettingsResponse, error) {
+	settingsResponse, err := w.getWebhookSettings(ctx, "/applications/settings/webhook")
+	if err != nil {
+		return nil, err
+	}
+
+	getWebhookSettingsResponse := GetWebhookSettingsResponse(settingsResponse.Webhook.WebhookSettings)
+
+	return &getWebhookSettingsResponse, nil
+}
+
+// ListWebhookCategories lists all the webhook events which can be subscribed
+// to.
+// See https://sendbird.com/docs/chat/platform-api/v3/webhook/configuring-webhook/retrieve-a-list-of-subscribed-events
+func (w *webhook) ListWebhookCategories(ctx context.Context) ([]Event, error) {
+	settingsResponse, err := w.getWebhookSettings(ctx, "/applications/settings/webhook?display_all_webhook_categories=true")
+	if err != nil {
+		return nil, err
+	}
+
+	return settingsResponse.Webhook.AllWebhookCategories, nil
+}
+
+// UpdateWebhookSettings updates the webhook settings of the application. Only
+// the specified settings are updated.
+// See https://sendbird.com/docs/chat/platform-api/v3/webhook/configuring-webhook/choose-which-events-to-subscribe-to
+func (w *webhook) UpdateWebhookSettings(ctx context.Context, updateWebhookSettingsRequest UpdateWebhookSettingsRequest) (*UpdateWebhookSettingsResponse, error) {
+	wsr, err := w.client.Put(ctx, "/applications/settings/webhook", updateWebhookSettingsRequest, &webhookSettingsResponse{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update webhook settings: %w", err)
+	}
+
+	settingsResponse, ok := wsr.(*webhookSettingsResponse)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast body to webhookSettingsResponse: %+v", wsr)
+	}
+
+	updateWebhookSettingsResponse := UpdateWebhookSettingsResponse(settingsResponse.Webhook.WebhookSettings)
+
+	return &updateWebhookSettingsResponse, nil
+}
@@ -0,0 +1,94 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yumi-ia/sendbird-go/pkg/client"
+)
+
+func newWebhookSettingsResponse(settings WebhookSettings, categories []Event) *webhookSettingsResponse {
+	wsr := &webhookSettingsResponse{}
+	wsr.Webhook.WebhookSettings = settings
+	wsr.Webhook.AllWebhookCategories = categories
+
+	return wsr
+}
+
+func TestGetWebhookSettings(t *testing.T) {
+	t.Parallel()
+
+	settings := WebhookSettings{
+		Enabled:        true,
+		URL:            "https://example.com/webhook",
+		EnabledEvents:  []Event{EventGroupChannelMessageSend, EventUserBlock},
+		IncludeMembers: true,
+	}
+
+	client := client.NewClientMock(t).
+		OnGet("/applications/settings/webhook", nil, &webhookSettingsResponse{}).TypedReturns(newWebhookSettingsResponse(settings, nil), nil).Once().
+		Parent
+	webhook := NewWebhook(client)
+
+	gwsr, err := webhook.GetWebhookSettings(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, GetWebhookSettingsResponse(settings), *gwsr)
+}
+
+func TestListWebhookCategories(t *testing.T) {
+	t.Parallel()
+
+	categories := []Event{EventGroupChannelCreate, EventOpenChannelEnter, EventAlertAll}
+
+	client := client.NewClientMock(t).
+		OnGet("/applications/settings/webhook?display_all_webhook_categories=true", nil, &webhookSettingsResponse{}).TypedReturns(newWebhookSettingsResponse(WebhookSettings{}, categories), nil).Once().
+		Parent
+	webhook := NewWebhook(client)
+
+	events, err := webhook.ListWebhookCategories(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, categories, events)
+}
+
+func TestUpdateWebhookSettings(t *testing.T) {
+	t.Parallel()
+
+	enabled, includeUnreadCount := true, true
+	updateWebhookSettingsRequest := UpdateWebhookSettingsRequest{
+		Enabled:            &enabled,
+		URL:                "https://example.com/webhook",
+		EnabledEvents:      &[]Event{EventAll},
+		IncludeUnreadCount: &includeUnreadCount,
+	}
+
+	settings := WebhookSettings{
+		Enabled:            true,
+		URL:                "https://example.com/webhook",
+		EnabledEvents:      []Event{EventAll},
+		IncludeUnreadCount: true,
+	}
+
+	client := client.NewClientMock(t).
+		OnPut("/applications/settings/webhook", updateWebhookSettingsRequest, &webhookSettingsResponse{}).TypedReturns(newWebhookSettingsResponse(settings, nil), nil).Once().
+		Parent
+	webhook := NewWebhook(client)
+
+	uwsr, err := webhook.UpdateWebhookSettings(context.Background(), updateWebhookSettingsRequest)
+	require.NoError(t, err)
+	assert.Equal(t, UpdateWebhookSettingsResponse(settings), *uwsr)
+}
+
+func TestUpdateWebhookSettingsRequest_enabledEvents(t *testing.T) {
+	t.Parallel()
+
+	b, err := json.Marshal(UpdateWebhookSettingsRequest{})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{}`, string(b))
+
+	b, err = json.Marshal(UpdateWebhookSettingsRequest{EnabledEvents: &[]Event{}})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"enabled_events":[]}`, string(b))
+}
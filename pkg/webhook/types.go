@@ -0,0 +1,52 @@
+package webhook
+
+// Event is a webhook event, in the category:action format.
+// See https://sendbird.com/docs/chat/platform-api/v3/webhook/webhook-overview#2-webhook-events
+type Event string
+
+const (
+	// EventAll subscribes to every event.
+	EventAll Event = "*"
+
+	EventGroupChannelAll           Event = "group_channel:*"
+	EventGroupChannelCreate        Event = "group_channel:create"
+	EventGroupChannelChanged       Event = "group_channel:changed"
+	EventGroupChannelRemove        Event = "group_channel:remove"
+	EventGroupChannelInvite        Event = "group_channel:invite"
+	EventGroupChannelJoin          Event = "group_channel:join"
+	EventGroupChannelLeave         Event = "group_channel:leave"
+	EventGroupChannelMessageSend   Event = "group_channel:message_send"
+	EventGroupChannelMessageRead   Event = "group_channel:message_read"
+	EventGroupChannelMessageUpdate Event = "group_channel:message_update"
+	EventGroupChannelMessageDelete Event = "group_channel:message_delete"
+
+	EventOpenChannelAll           Event = "open_channel:*"
+	EventOpenChannelCreate        Event = "open_channel:create"
+	EventOpenChannelRemove        Event = "open_channel:remove"
+	EventOpenChannelEnter         Event = "open_channel:enter"
+	EventOpenChannelExit          Event = "open_channel:exit"
+	EventOpenChannelMessageSend   Event = "open_channel:message_send"
+	EventOpenChannelMessageUpdate Event = "open_channel:message_update"
+	EventOpenChannelMessageDelete Event = "open_channel:message_delete"
+
+	EventUserAll   Event = "user:*"
+	EventUserBlock Event = "user:block"
+
+	EventAlertAll Event = "alert:*"
+)
+
+// WebhookSettings is the webhook settings of the application.
+type WebhookSettings struct {
+	// Enabled indicates whether webhooks are turned on.
+	Enabled bool `json:"enabled"`
+	// URL is the URL the webhook events are sent to.
+	URL string `json:"url"`
+	// EnabledEvents is the list of the subscribed events.
+	EnabledEvents []Event `json:"enabled_events"`
+	// IncludeMembers indicates whether to include the members of the channel
+	// in the payloads.
+	IncludeMembers bool `json:"include_members"`
+	// IncludeUnreadCount indicates whether to include the unread message count
+	// of the members in the payloads.
+	IncludeUnreadCount bool `json:"include_unread_count"`
+}
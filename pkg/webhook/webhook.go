@@ -0,0 +1,32 @@
+// Package webhook package provides the interface for the webhook service.
+// It provides the methods to interact with the sendbird API.
+// See https://sendbird.com/docs/chat/platform-api/v3/webhook/webhook-overview.
+package webhook
+
+import (
+	"context"
+
+	"github.com/yumi-ia/sendbird-go/pkg/client"
+)
+
+type Webhook interface {
+	// GetWebhookSettings retrieves the webhook settings of the application.
+	// See https://sendbird.com/docs/chat/platform-api/v3/webhook/configuring-webhook/retrieve-a-list-of-subscribed-events
+	GetWebhookSettings(ctx context.Context) (*GetWebhookSettingsResponse, error)
+	// UpdateWebhookSettings updates the webhook settings of the application.
+	// Only the specified settings are updated.
+	// See https://sendbird.com/docs/chat/platform-api/v3/webhook/configuring-webhook/choose-which-events-to-subscribe-to
+	UpdateWebhookSettings(ctx context.Context, updateWebhookSettingsRequest UpdateWebhookSettingsRequest) (*UpdateWebhookSettingsResponse, error)
+	// ListWebhookCategories lists all the webhook events which can be
+	// subscribed to.
+	// See https://sendbird.com/docs/chat/platform-api/v3/webhook/configuring-webhook/retrieve-a-list-of-subscribed-events
+	ListWebhookCategories(ctx context.Context) ([]Event, error)
+}
+
+type webhook struct {
+	client client.Client
+}
+
+func NewWebhook(c client.Client) Webhook {
+	return &webhook{client: c}
+}